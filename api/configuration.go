@@ -42,6 +42,22 @@ type Configuration struct {
 	// Used for mDNS txt record: SHIP - Requirements for Installation Process V1.0.0
 	deviceSerialNumber string
 
+	// Software revision of the device, optional
+	//
+	// Used for the manufacturer data of the device: SPINE - Resource Specification 4.3.2
+	softwareRevision string
+
+	// Hardware revision of the device, optional
+	//
+	// Used for the manufacturer data of the device: SPINE - Resource Specification 4.3.2
+	hardwareRevision string
+
+	// Do not set the manufacturer data of the device on setup, optional
+	//
+	// The manufacturer data is then empty, including the default data of the SPINE device,
+	// until the application sets its own: SPINE - Resource Specification 4.3.2
+	manufacturerDataDisabled bool
+
 	// Device categories of the device model, required
 	//
 	// Used for mDNS txt record: SHIP - Requirements for Installation Process V1.0.0
@@ -199,6 +215,39 @@ func (s *Configuration) DeviceSerialNumber() string {
 	return s.deviceSerialNumber
 }
 
+// set the software revision of the device
+func (s *Configuration) SetSoftwareRevision(revision string) {
+	s.softwareRevision = revision
+}
+
+// Returns the configuration software revision
+func (s *Configuration) SoftwareRevision() string {
+	return s.softwareRevision
+}
+
+// set the hardware revision of the device
+func (s *Configuration) SetHardwareRevision(revision string) {
+	s.hardwareRevision = revision
+}
+
+// Returns the configuration hardware revision
+func (s *Configuration) HardwareRevision() string {
+	return s.hardwareRevision
+}
+
+// set if the service should not set the manufacturer data of the device
+// on setup, e.g. because the application sets its own
+//
+// if disabled, the manufacturer data of the device is empty until the application sets it
+func (s *Configuration) SetManufacturerDataDisabled(disabled bool) {
+	s.manufacturerDataDisabled = disabled
+}
+
+// Returns if the service does not set the manufacturer data of the device
+func (s *Configuration) ManufacturerDataDisabled() bool {
+	return s.manufacturerDataDisabled
+}
+
 // Returns the configuration device categories
 func (c *Configuration) DeviceCategories() []shipapi.DeviceCategoryType {
 	return c.deviceCategories
@@ -153,6 +153,18 @@ func (s *ConfigurationSuite) Test_Configuration() {
 	serialValue := config.DeviceSerialNumber()
 	assert.Equal(s.T(), serial, serialValue)
 
+	assert.Equal(s.T(), "", config.SoftwareRevision())
+	config.SetSoftwareRevision("1.2.3")
+	assert.Equal(s.T(), "1.2.3", config.SoftwareRevision())
+
+	assert.Equal(s.T(), "", config.HardwareRevision())
+	config.SetHardwareRevision("rev2")
+	assert.Equal(s.T(), "rev2", config.HardwareRevision())
+
+	assert.False(s.T(), config.ManufacturerDataDisabled())
+	config.SetManufacturerDataDisabled(true)
+	assert.True(s.T(), config.ManufacturerDataDisabled())
+
 	categoryValue := config.DeviceCategories()
 	assert.Equal(s.T(), categories, categoryValue)
 
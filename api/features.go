@@ -129,6 +129,45 @@ type ElectricalConnectionCommonInterface interface {
 	) ([]model.ElectricalConnectionCharacteristicDataType, error)
 }
 
+// Common interface for HvacClientInterface and HvacServerInterface
+type HvacCommonInterface interface {
+	// Get the overrun description for a given overrunId
+	//
+	// Will return nil if no matching description is found
+	GetOverrunDescriptionForId(overrunId model.HvacOverrunIdType) (*model.HvacOverrunDescriptionDataType, error)
+
+	// Get the overrun descriptions for a given filter
+	//
+	// Returns an error if no matching description is found
+	GetOverrunDescriptionsForFilter(
+		filter model.HvacOverrunDescriptionDataType,
+	) ([]model.HvacOverrunDescriptionDataType, error)
+
+	// Get the overrun data for a given overrunId
+	//
+	// Will return nil if no data is available
+	GetOverrunDataForId(overrunId model.HvacOverrunIdType) (*model.HvacOverrunDataType, error)
+
+	// Get the overrun data for a given filter
+	//
+	// Will return nil if no data is available
+	GetOverrunDataForFilter(filter model.HvacOverrunDescriptionDataType) ([]model.HvacOverrunDataType, error)
+
+	// Get the system function descriptions for a given filter
+	//
+	// Returns an error if no matching description is found
+	GetSystemFunctionDescriptionsForFilter(
+		filter model.HvacSystemFunctionDescriptionDataType,
+	) ([]model.HvacSystemFunctionDescriptionDataType, error)
+
+	// Get the system function setpoint relations for a given filter
+	//
+	// Returns an error if no matching relation is found
+	GetSystemFunctionSetpointRelationsForFilter(
+		filter model.HvacSystemFunctionSetpointRelationDataType,
+	) ([]model.HvacSystemFunctionSetpointRelationDataType, error)
+}
+
 // Common interface for LoadControlClientInterface and LoadControlServerInterface
 type LoadControlCommonInterface interface {
 	// check if spine.EventPayload Data contains data for a given filter
@@ -225,6 +264,53 @@ type IncentiveTableCommonInterface interface {
 	GetData() ([]model.IncentiveTableType, error)
 }
 
+// Common interface for SetpointClientInterface and SetpointServerInterface
+type SetpointCommonInterface interface {
+	// check if spine.EventPayload Data contains data for a given filter
+	//
+	// data type will be checked for model.SetpointListDataType,
+	// filter type will be checked for model.SetpointDescriptionDataType
+	CheckEventPayloadDataForFilter(payloadData any, filter any) bool
+
+	// Get the description for a given setpointId
+	//
+	// Returns an error if no matching description is found
+	GetDescriptionForId(
+		setpointId model.SetpointIdType,
+	) (*model.SetpointDescriptionDataType, error)
+
+	// Get the descriptions for a given filter
+	//
+	// Returns an error if no matching description is found
+	GetDescriptionsForFilter(
+		filter model.SetpointDescriptionDataType,
+	) ([]model.SetpointDescriptionDataType, error)
+
+	// Get the constraints for a given setpointId
+	//
+	// Returns an error if no matching constraint is found
+	GetConstraintsForId(
+		setpointId model.SetpointIdType,
+	) (*model.SetpointConstraintsDataType, error)
+
+	// Get the constraints for a given filter
+	//
+	// Returns an error if no matching constraint is found
+	GetConstraintsForFilter(
+		filter model.SetpointConstraintsDataType,
+	) ([]model.SetpointConstraintsDataType, error)
+
+	// Get the setpoint data for a given setpointId
+	//
+	// Will return nil if no data is available
+	GetDataForId(setpointId model.SetpointIdType) (*model.SetpointDataType, error)
+
+	// Get the setpoint data for a given filter
+	//
+	// Will return nil if no data is available
+	GetDataForFilter(filter model.SetpointDescriptionDataType) ([]model.SetpointDataType, error)
+}
+
 // Common interface for SmartEnergyManagementPsClientInterface and SmartEnergyManagementPsServerInterface
 type SmartEnergyManagementPsCommonInterface interface {
 	// return current data for FunctionTypeSmartEnergyManagementPsData
@@ -61,6 +61,41 @@ type ElectricalConnectionClientInterface interface {
 	) (*model.MsgCounterType, error)
 }
 
+type HvacClientInterface interface {
+	HvacCommonInterface
+
+	// request FunctionTypeHvacOverrunDescriptionListData from a remote entity
+	RequestOverrunDescriptions(
+		selector *model.HvacOverrunDescriptionListDataSelectorsType,
+		elements *model.HvacOverrunDescriptionDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// request FunctionTypeHvacOverrunListData from a remote entity
+	RequestOverrunData(
+		selector *model.HvacOverrunListDataSelectorsType,
+		elements *model.HvacOverrunDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// write overrun data
+	// returns an error if this failed
+	WriteOverrunData(data []model.HvacOverrunDataType) (*model.MsgCounterType, error)
+
+	// request FunctionTypeHvacSystemFunctionDescriptionListData from a remote entity
+	//
+	// returns ErrFunctionNotSupported if the SPINE function data can not hold the
+	// description list, as the reply could not be processed then
+	RequestSystemFunctionDescriptions(
+		selector *model.HvacSystemFunctionDescriptionListDataSelectorsType,
+		elements *model.HvacSystemFunctionDescriptionDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// request FunctionTypeHvacSystemFunctionSetPointRelationListData from a remote entity
+	RequestSystemFunctionSetpointRelations(
+		selector *model.HvacSystemFunctionSetpointRelationListDataSelectorsType,
+		elements *model.HvacSystemFunctionSetpointRelationDataElementsType,
+	) (*model.MsgCounterType, error)
+}
+
 type IdentificationClientInterface interface {
 	// request FunctionTypeIdentificationListData from a remote entity
 	RequestValues() (*model.MsgCounterType, error)
@@ -133,6 +168,32 @@ type MeasurementClientInterface interface {
 	) (*model.MsgCounterType, error)
 }
 
+type SetpointClientInterface interface {
+	SetpointCommonInterface
+
+	// request FunctionTypeSetpointDescriptionListData from a remote entity
+	RequestDescriptions(
+		selector *model.SetpointDescriptionListDataSelectorsType,
+		elements *model.SetpointDescriptionDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// request FunctionTypeSetpointConstraintsListData from a remote entity
+	RequestConstraints(
+		selector *model.SetpointConstraintsListDataSelectorsType,
+		elements *model.SetpointConstraintsDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// request FunctionTypeSetpointListData from a remote entity
+	RequestData(
+		selector *model.SetpointListDataSelectorsType,
+		elements *model.SetpointDataElementsType,
+	) (*model.MsgCounterType, error)
+
+	// write setpoint data
+	// returns an error if this failed
+	WriteData(data []model.SetpointDataType) (*model.MsgCounterType, error)
+}
+
 type SmartEnergyManagementPsClientInterface interface {
 	// request FunctionTypeSmartEnergyManagementPsData from a remote entity
 	RequestData() (*model.MsgCounterType, error)
@@ -1,8 +1,22 @@
 package api
 
-import "github.com/enbility/spine-go/model"
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
 
 type DeviceClassificationServerInterface interface {
+	DeviceClassificationCommonInterface
+
+	// set the manufacturer data of the device or entity
+	//
+	// empty values are not provided
+	SetManufacturerData(data ManufacturerData)
+
+	// set the user data of the device or entity
+	//
+	// the user data function is added to the feature if it is not yet supported
+	SetUserData(data *model.DeviceClassificationUserDataType)
 }
 
 type DeviceConfigurationServerInterface interface {
@@ -40,6 +54,10 @@ type DeviceDiagnosisServerInterface interface {
 
 	// set the local device diagnosis operating state
 	SetLocalOperatingState(operatingState model.DeviceDiagnosisOperatingStateType)
+
+	// set the local device diagnosis operating state and the last error code,
+	// an empty lastErrorCode is not provided
+	SetLocalOperatingStateWithLastErrorCode(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string)
 }
 
 type ElectricalConnectionPermittedValueSetForID struct {
@@ -189,14 +207,336 @@ type MeasurementServerInterface interface {
 	) error
 }
 
+type HvacServerInterface interface {
+	HvacCommonInterface
+
+	// Add a new overrun description data set and return the overrunId
+	//
+	// NOTE: the overrunId may not be provided
+	//
+	// will return nil if the data set could not be added
+	AddOverrunDescription(
+		description model.HvacOverrunDescriptionDataType,
+	) *model.HvacOverrunIdType
+
+	// Set or update the overrun data set for an overrunId
+	// Elements provided in deleteElements will be removed from the data set before the update
+	//
+	// Will return an error if the data set could not be updated
+	UpdateOverrunDataForId(
+		data model.HvacOverrunDataType,
+		deleteElements *model.HvacOverrunDataElementsType,
+		overrunId model.HvacOverrunIdType,
+	) error
+}
+
 type IdentificationServerInterface interface {
+	IdentificationCommonInterface
+
+	// Add a new identification and return the identificationId
+	//
+	// subscribers are notified about the change
+	//
+	// will return nil if the value is empty, the identification already exists
+	// or the data set could not be added
+	AddIdentification(
+		identificationType model.IdentificationTypeType,
+		value string,
+	) *model.IdentificationIdType
+
+	// Replace all identifications with the given ones
+	//
+	// the identificationIds are assigned in the given order, starting at 0,
+	// duplicates are only added once and subscribers are notified once about the change
+	//
+	// Will return an error if an identification has no type or an empty value,
+	// or the data could not be set
+	SetIdentifications(identifications []model.IdentificationDataType) error
+
+	// Add the MAC address of an EV, e.g. the EVCCID, and return the identificationId
+	//
+	// the type is EUI-48 or EUI-64, depending on the length of the address
+	//
+	// will return nil if the address is invalid or could not be added
+	AddMacAddress(address string) *model.IdentificationIdType
+
+	// Add a user RFID tag and return the identificationId
+	//
+	// will return nil if the tag is empty or could not be added
+	AddRfidTag(tag string) *model.IdentificationIdType
+
+	// Remove the identification with the given identificationId
+	//
+	// subscribers are notified about the change
+	//
+	// Will return an error if the identification does not exist or could not be removed
+	RemoveIdentification(identificationId model.IdentificationIdType) error
+
+	// Remove all identifications of the given type
+	//
+	// subscribers are notified about the change
+	//
+	// Will return an error if no identification of this type exists or they could not be removed
+	RemoveIdentificationsOfType(identificationType model.IdentificationTypeType) error
+
+	// Remove all identifications
+	//
+	// subscribers are notified about the change
+	ClearIdentifications()
 }
 
 type IncentiveTableServerInterface interface {
+	IncentiveTableCommonInterface
+
+	// Add a new tariff description and return the tariffId
+	//
+	// NOTE: the tariffId may not be provided
+	//
+	// will return nil if the data set could not be added
+	AddTariffDescription(
+		description model.TariffDescriptionDataType,
+	) *model.TariffIdType
+
+	// Update the description of a tariff, the tiers of the tariff are kept
+	//
+	// NOTE: the tariffId has to be provided
+	//
+	// Will return an error if the tariff does not exist or the data set could not be updated
+	UpdateTariffDescription(description model.TariffDescriptionDataType) error
+
+	// Replace the tiers of a tariff, including their boundaries and incentives
+	//
+	// Will return an error if the tariff does not exist, a tier, boundary or incentive
+	// has no id, or the data set could not be updated
+	SetTiers(
+		tariffId model.TariffIdType,
+		tiers []model.IncentiveTableDescriptionTierType,
+	) error
+
+	// Add a new tier to a tariff and return the tierId
+	//
+	// NOTE: the tierId may not be provided
+	//
+	// will return nil if the tariff does not exist or the data set could not be added
+	AddTier(
+		tariffId model.TariffIdType,
+		description model.TierDescriptionDataType,
+	) *model.TierIdType
+
+	// Add a new boundary to a tier of a tariff and return the boundaryId
+	//
+	// NOTE: the boundaryId may not be provided
+	//
+	// will return nil if the tier does not exist or the data set could not be added
+	AddBoundary(
+		tariffId model.TariffIdType,
+		tierId model.TierIdType,
+		description model.TierBoundaryDescriptionDataType,
+	) *model.TierBoundaryIdType
+
+	// Add a new incentive to a tier of a tariff and return the incentiveId
+	//
+	// NOTE: the incentiveId may not be provided
+	//
+	// will return nil if the tier does not exist or the data set could not be added
+	AddIncentive(
+		tariffId model.TariffIdType,
+		tierId model.TierIdType,
+		description model.IncentiveDescriptionDataType,
+	) *model.IncentiveIdType
+
+	// Set the constraints of the tariffs, replacing all existing constraints
+	//
+	// NOTE: the tariffId has to be provided for each item
+	//
+	// Will return an error if a tariff does not exist or the data set could not be updated
+	SetConstraints(data []model.IncentiveTableConstraintsType) error
+
+	// Set the incentive slots of a tariff, replacing the existing slots of the tariff
+	//
+	// Will return an error if the tariff does not exist, the slots do not match
+	// the tariff descriptions or the data set could not be updated
+	SetIncentiveSlots(
+		tariffId model.TariffIdType,
+		slots []model.IncentiveTableIncentiveSlotType,
+	) error
+
+	// Check written tariff descriptions against the current descriptions and constraints
+	//
+	// This is intended to be used in a write approval callback
+	ValidateDescriptionsWrite(data *model.IncentiveTableDescriptionDataType) error
+
+	// Check written incentives against the current descriptions and constraints
+	//
+	// This is intended to be used in a write approval callback
+	ValidateIncentivesWrite(data *model.IncentiveTableDataType) error
+
+	// Add a callback which is invoked for incoming writes of incentive table data
+	//
+	// The callback has to approve or deny each write using ApproveOrDenyWrite,
+	// otherwise the write is denied once the approval timeout is reached
+	AddWriteApprovalCallback(function spineapi.WriteApprovalCallbackFunc) error
+
+	// Approve or deny an incoming write
+	//
+	// The write is approved if err is nil, otherwise it is denied
+	// with the error message as description
+	ApproveOrDenyWrite(msg *spineapi.Message, err error)
+}
+
+type SetpointServerInterface interface {
+	SetpointCommonInterface
+
+	// Add a new description data set and return the setpointId
+	//
+	// NOTE: the setpointId may not be provided
+	//
+	// will return nil if the data set could not be added
+	AddDescription(
+		description model.SetpointDescriptionDataType,
+	) *model.SetpointIdType
+
+	// Set or update the constraints data set of a setpoint
+	//
+	// NOTE: the setpointId has to be provided
+	//
+	// Will return an error if the setpoint does not exist or the data set could not be updated
+	UpdateConstraints(data model.SetpointConstraintsDataType) error
+
+	// Set or update the data set of a setpoint
+	// Elements provided in deleteElements will be removed from the data set before the update
+	//
+	// NOTE: the setpointId has to be provided
+	//
+	// Will return an error if the setpoint does not exist or the data set could not be updated
+	UpdateSetpoint(
+		data model.SetpointDataType,
+		deleteElements *model.SetpointDataElementsType,
+	) error
 }
 
 type SmartEnergyManagementPsServerInterface interface {
+	SmartEnergyManagementPsCommonInterface
+
+	// Set the node schedule information
+	//
+	// Will return an error if the data set could not be updated
+	SetNodeScheduleInformation(data model.PowerSequenceNodeScheduleInformationDataType) error
+
+	// Add a new alternative with the provided power sequences and return the alternativesId
+	//
+	// NOTE: the alternativesId and the sequenceIds may not be provided,
+	// they are set automatically on all data sets of the power sequences
+	//
+	// will return nil if the data set could not be added
+	AddAlternative(powerSequences []model.SmartEnergyManagementPsPowerSequenceType) *model.AlternativesIdType
+
+	// Remove an alternative including all of its power sequences
+	//
+	// Will return an error if the alternative does not exist or the data set could not be updated
+	RemoveAlternative(alternativesId model.AlternativesIdType) error
+
+	// Set or update the state of a power sequence
+	//
+	// NOTE: the sequenceId has to be provided
+	//
+	// Will return an error if the power sequence does not exist or the data set could not be updated
+	UpdateSequenceState(data model.PowerSequenceStateDataType) error
+
+	// Set or update the schedule of a power sequence
+	//
+	// NOTE: the sequenceId has to be provided
+	//
+	// Will return an error if the power sequence does not exist or the data set could not be updated
+	UpdateSequenceSchedule(data model.PowerSequenceScheduleDataType) error
+
+	// Set or update the schedule constraints of a power sequence
+	//
+	// NOTE: the sequenceId has to be provided
+	//
+	// Will return an error if the power sequence does not exist or the data set could not be updated
+	UpdateSequenceScheduleConstraints(data model.PowerSequenceScheduleConstraintsDataType) error
+
+	// Replace the time slots of a power sequence
+	//
+	// NOTE: the sequenceId of the slots is set automatically
+	//
+	// Will return an error if the power sequence does not exist or the data set could not be updated
+	UpdateSequenceTimeSlots(sequenceId model.PowerSequenceIdType, slots []model.SmartEnergyManagementPsPowerTimeSlotType) error
+
+	// Replace the complete data set
+	//
+	// Will return an error if the data set could not be updated
+	SetData(data *model.SmartEnergyManagementPsDataType) error
+
+	// Apply the schedules of written data onto the current data set
+	// and return the resulting complete data set, without storing it
+	//
+	// This is intended to be used in a write approval callback, as the
+	// data type does not support partial updates, the result can be stored
+	// using SetData
+	//
+	// Will return an error if a schedule refers to an unknown power sequence
+	// or a power sequence that can not be controlled remotely
+	ApplyScheduleWrite(data *model.SmartEnergyManagementPsDataType) (*model.SmartEnergyManagementPsDataType, error)
+}
+
+type TimeSeriesDataForID struct {
+	Data model.TimeSeriesDataType
+	Id   model.TimeSeriesIdType
+}
+
+type TimeSeriesDataForFilter struct {
+	Data   model.TimeSeriesDataType
+	Filter model.TimeSeriesDescriptionDataType
 }
 
 type TimeSeriesServerInterface interface {
+	TimeSeriesCommonInterface
+
+	// Add a new description data set and return the timeSeriesId
+	//
+	// NOTE: the timeSeriesId may not be provided
+	//
+	// will return nil if the data set could not be added
+	AddDescription(
+		description model.TimeSeriesDescriptionDataType,
+	) *model.TimeSeriesIdType
+
+	// Set the constraints of the time series, replacing all existing constraints
+	//
+	// NOTE: the timeSeriesId has to be provided for each item
+	//
+	// Will return an error if a time series does not exist or the data set could not be updated
+	SetConstraints(data []model.TimeSeriesConstraintsDataType) error
+
+	// Set or update data set for a timeSeriesId
+	//
+	// Will return an error if the data set could not be updated
+	UpdateDataForIds(
+		data []TimeSeriesDataForID,
+	) error
+
+	// Set or update data set for a filter
+	// deleteSelector will trigger removal of matching items from the data set before the update
+	// deleteElement will limit the fields to be removed using Id
+	//
+	// Will return an error if the data set could not be updated
+	UpdateDataForFilters(
+		data []TimeSeriesDataForFilter,
+		deleteSelector *model.TimeSeriesListDataSelectorsType,
+		deleteElements *model.TimeSeriesDataElementsType,
+	) error
+
+	// Add a callback which is invoked for incoming writes of time series data
+	//
+	// The callback has to approve or deny each write using ApproveOrDenyWrite,
+	// otherwise the write is denied once the approval timeout is reached
+	AddWriteApprovalCallback(function spineapi.WriteApprovalCallbackFunc) error
+
+	// Approve or deny an incoming write
+	//
+	// The write is approved if err is nil, otherwise it is denied
+	// with the error message as description
+	ApproveOrDenyWrite(msg *spineapi.Message, err error)
 }
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type Hvac struct {
+	*Feature
+
+	*internal.HvacCommon
+}
+
+// Get a new Hvac features helper
+//
+// - The feature on the local entity has to be of role client
+// - The feature on the remote entity has to be of role server
+func NewHvac(
+	localEntity spineapi.EntityLocalInterface,
+	remoteEntity spineapi.EntityRemoteInterface) (*Hvac, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeHvac, localEntity, remoteEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Hvac{
+		Feature:    feature,
+		HvacCommon: internal.NewRemoteHvac(feature.featureRemote),
+	}
+
+	return h, nil
+}
+
+var _ api.HvacClientInterface = (*Hvac)(nil)
+
+// request FunctionTypeHvacOverrunDescriptionListData from a remote entity
+func (h *Hvac) RequestOverrunDescriptions(
+	selector *model.HvacOverrunDescriptionListDataSelectorsType,
+	elements *model.HvacOverrunDescriptionDataElementsType,
+) (*model.MsgCounterType, error) {
+	return h.requestData(model.FunctionTypeHvacOverrunDescriptionListData, selector, elements)
+}
+
+// request FunctionTypeHvacOverrunListData from a remote entity
+func (h *Hvac) RequestOverrunData(
+	selector *model.HvacOverrunListDataSelectorsType,
+	elements *model.HvacOverrunDataElementsType,
+) (*model.MsgCounterType, error) {
+	return h.requestData(model.FunctionTypeHvacOverrunListData, selector, elements)
+}
+
+// request FunctionTypeHvacSystemFunctionDescriptionListData from a remote entity
+//
+// returns ErrFunctionNotSupported if the SPINE function data can not hold the
+// description list, as the reply could not be processed then
+func (h *Hvac) RequestSystemFunctionDescriptions(
+	selector *model.HvacSystemFunctionDescriptionListDataSelectorsType,
+	elements *model.HvacSystemFunctionDescriptionDataElementsType,
+) (*model.MsgCounterType, error) {
+	function := model.FunctionTypeHvacSystemFunctionDescriptionListData
+	if _, ok := h.featureRemote.DataCopy(function).(*model.HvacSystemFunctionDescriptionListDataType); !ok {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	return h.requestData(model.FunctionTypeHvacSystemFunctionDescriptionListData, selector, elements)
+}
+
+// request FunctionTypeHvacSystemFunctionSetPointRelationListData from a remote entity
+func (h *Hvac) RequestSystemFunctionSetpointRelations(
+	selector *model.HvacSystemFunctionSetpointRelationListDataSelectorsType,
+	elements *model.HvacSystemFunctionSetpointRelationDataElementsType,
+) (*model.MsgCounterType, error) {
+	return h.requestData(model.FunctionTypeHvacSystemFunctionSetPointRelationListData, selector, elements)
+}
+
+// write overrun data
+// returns an error if this failed
+func (h *Hvac) WriteOverrunData(data []model.HvacOverrunDataType) (*model.MsgCounterType, error) {
+	if len(data) == 0 {
+		return nil, api.ErrMissingData
+	}
+
+	filters := []model.FilterType{*model.NewFilterTypePartial()}
+
+	// does the remote server feature not support partials?
+	operation := h.featureRemote.Operations()[model.FunctionTypeHvacOverrunListData]
+	if operation == nil || !operation.WritePartial() {
+		filters = nil
+		// we need to send all data
+		updateData := &model.HvacOverrunListDataType{
+			HvacOverrunData: data,
+		}
+
+		if mergedData, err := h.featureRemote.UpdateData(false, model.FunctionTypeHvacOverrunListData, updateData, nil, nil); err == nil {
+			data = mergedData.([]model.HvacOverrunDataType)
+		}
+	}
+
+	cmd := model.CmdType{
+		HvacOverrunListData: &model.HvacOverrunListDataType{
+			HvacOverrunData: data,
+		},
+	}
+
+	if filters != nil {
+		cmd.Filter = filters
+		cmd.Function = util.Ptr(model.FunctionTypeHvacOverrunListData)
+	}
+
+	return h.remoteDevice.Sender().Write(h.featureLocal.Address(), h.featureRemote.Address(), cmd)
+}
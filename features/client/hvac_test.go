@@ -0,0 +1,174 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/enbility/eebus-go/api"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHvacSuite(t *testing.T) {
+	suite.Run(t, new(HvacSuite))
+}
+
+type HvacSuite struct {
+	suite.Suite
+
+	localEntity        spineapi.EntityLocalInterface
+	localEntityPartial spineapi.EntityLocalInterface
+
+	remoteEntity        spineapi.EntityRemoteInterface
+	remoteEntityPartial spineapi.EntityRemoteInterface
+
+	hvac        *Hvac
+	hvacPartial *Hvac
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*HvacSuite)(nil)
+
+func (s *HvacSuite) WriteShipMessageWithPayload([]byte) {}
+
+func (s *HvacSuite) BeforeTest(suiteName, testName string) {
+	s.localEntity, s.remoteEntity = setupFeatures(
+		s.T(),
+		s,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeHvac,
+				functions: []model.FunctionType{
+					model.FunctionTypeHvacOverrunDescriptionListData,
+					model.FunctionTypeHvacOverrunListData,
+					model.FunctionTypeHvacSystemFunctionDescriptionListData,
+					model.FunctionTypeHvacSystemFunctionSetPointRelationListData,
+				},
+				partial: false,
+			},
+		},
+	)
+
+	s.localEntityPartial, s.remoteEntityPartial = setupFeatures(
+		s.T(),
+		s,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeHvac,
+				functions: []model.FunctionType{
+					model.FunctionTypeHvacOverrunDescriptionListData,
+					model.FunctionTypeHvacOverrunListData,
+					model.FunctionTypeHvacSystemFunctionDescriptionListData,
+					model.FunctionTypeHvacSystemFunctionSetPointRelationListData,
+				},
+				partial: true,
+			},
+		},
+	)
+
+	var err error
+	s.hvac, err = NewHvac(s.localEntity, nil)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), s.hvac)
+
+	s.hvac, err = NewHvac(s.localEntity, s.remoteEntity)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.hvac)
+
+	s.hvacPartial, err = NewHvac(s.localEntityPartial, s.remoteEntityPartial)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.hvacPartial)
+}
+
+func (s *HvacSuite) Test_RequestOverrunDescriptions() {
+	counter, err := s.hvac.RequestOverrunDescriptions(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.hvac.RequestOverrunDescriptions(
+		&model.HvacOverrunDescriptionListDataSelectorsType{},
+		&model.HvacOverrunDescriptionDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *HvacSuite) Test_RequestOverrunData() {
+	counter, err := s.hvac.RequestOverrunData(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.hvac.RequestOverrunData(
+		&model.HvacOverrunListDataSelectorsType{},
+		&model.HvacOverrunDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *HvacSuite) Test_RequestSystemFunctionDescriptions() {
+	// the SPINE function data of this version holds a single description instead of the list
+	counter, err := s.hvac.RequestSystemFunctionDescriptions(nil, nil)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+	assert.Nil(s.T(), counter)
+
+	counter, err = s.hvac.RequestSystemFunctionDescriptions(
+		&model.HvacSystemFunctionDescriptionListDataSelectorsType{},
+		&model.HvacSystemFunctionDescriptionDataElementsType{},
+	)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+	assert.Nil(s.T(), counter)
+}
+
+func (s *HvacSuite) Test_RequestSystemFunctionSetpointRelations() {
+	counter, err := s.hvac.RequestSystemFunctionSetpointRelations(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.hvac.RequestSystemFunctionSetpointRelations(
+		&model.HvacSystemFunctionSetpointRelationListDataSelectorsType{},
+		&model.HvacSystemFunctionSetpointRelationDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *HvacSuite) Test_WriteOverrunData() {
+	counter, err := s.hvac.WriteOverrunData(nil)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	rF := s.remoteEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	defaultData := &model.HvacOverrunListDataType{
+		HvacOverrunData: []model.HvacOverrunDataType{
+			{
+				OverrunId:                 util.Ptr(model.HvacOverrunIdType(0)),
+				OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeInactive),
+				IsOverrunStatusChangeable: util.Ptr(true),
+			},
+			{
+				OverrunId:                 util.Ptr(model.HvacOverrunIdType(1)),
+				OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeInactive),
+				IsOverrunStatusChangeable: util.Ptr(true),
+			},
+		},
+	}
+	_, err1 := rF.UpdateData(true, model.FunctionTypeHvacOverrunListData, defaultData, nil, nil)
+	assert.Nil(s.T(), err1)
+
+	data := []model.HvacOverrunDataType{
+		{
+			OverrunId:     util.Ptr(model.HvacOverrunIdType(1)),
+			OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeActive),
+		},
+	}
+	counter, err = s.hvac.WriteOverrunData(data)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.hvacPartial.WriteOverrunData(data)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
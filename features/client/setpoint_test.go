@@ -0,0 +1,157 @@
+package client
+
+import (
+	"testing"
+
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSetpointSuite(t *testing.T) {
+	suite.Run(t, new(SetpointSuite))
+}
+
+type SetpointSuite struct {
+	suite.Suite
+
+	localEntity        spineapi.EntityLocalInterface
+	localEntityPartial spineapi.EntityLocalInterface
+
+	remoteEntity        spineapi.EntityRemoteInterface
+	remoteEntityPartial spineapi.EntityRemoteInterface
+
+	setpoint        *Setpoint
+	setpointPartial *Setpoint
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*SetpointSuite)(nil)
+
+func (s *SetpointSuite) WriteShipMessageWithPayload([]byte) {}
+
+func (s *SetpointSuite) BeforeTest(suiteName, testName string) {
+	s.localEntity, s.remoteEntity = setupFeatures(
+		s.T(),
+		s,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeSetpoint,
+				functions: []model.FunctionType{
+					model.FunctionTypeSetpointDescriptionListData,
+					model.FunctionTypeSetpointConstraintsListData,
+					model.FunctionTypeSetpointListData,
+				},
+				partial: false,
+			},
+		},
+	)
+
+	s.localEntityPartial, s.remoteEntityPartial = setupFeatures(
+		s.T(),
+		s,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeSetpoint,
+				functions: []model.FunctionType{
+					model.FunctionTypeSetpointDescriptionListData,
+					model.FunctionTypeSetpointConstraintsListData,
+					model.FunctionTypeSetpointListData,
+				},
+				partial: true,
+			},
+		},
+	)
+
+	var err error
+	s.setpoint, err = NewSetpoint(s.localEntity, nil)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), s.setpoint)
+
+	s.setpoint, err = NewSetpoint(s.localEntity, s.remoteEntity)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.setpoint)
+
+	s.setpointPartial, err = NewSetpoint(s.localEntityPartial, s.remoteEntityPartial)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.setpointPartial)
+}
+
+func (s *SetpointSuite) Test_RequestDescriptions() {
+	counter, err := s.setpoint.RequestDescriptions(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.setpoint.RequestDescriptions(
+		&model.SetpointDescriptionListDataSelectorsType{},
+		&model.SetpointDescriptionDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *SetpointSuite) Test_RequestConstraints() {
+	counter, err := s.setpoint.RequestConstraints(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.setpoint.RequestConstraints(
+		&model.SetpointConstraintsListDataSelectorsType{},
+		&model.SetpointConstraintsDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *SetpointSuite) Test_RequestData() {
+	counter, err := s.setpoint.RequestData(nil, nil)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.setpoint.RequestData(
+		&model.SetpointListDataSelectorsType{},
+		&model.SetpointDataElementsType{},
+	)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
+
+func (s *SetpointSuite) Test_WriteData() {
+	counter, err := s.setpoint.WriteData(nil)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	rF := s.remoteEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	defaultData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(50),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(1)),
+				Value:                model.NewScaledNumberType(40),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+		},
+	}
+	_, err1 := rF.UpdateData(true, model.FunctionTypeSetpointListData, defaultData, nil, nil)
+	assert.Nil(s.T(), err1)
+
+	data := []model.SetpointDataType{
+		{
+			SetpointId: util.Ptr(model.SetpointIdType(1)),
+			Value:      model.NewScaledNumberType(45),
+		},
+	}
+	counter, err = s.setpoint.WriteData(data)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.setpointPartial.WriteData(data)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type HvacCommon struct {
+	featureLocal  spineapi.FeatureLocalInterface
+	featureRemote spineapi.FeatureRemoteInterface
+}
+
+func NewLocalHvac(featureLocal spineapi.FeatureLocalInterface) *HvacCommon {
+	return &HvacCommon{
+		featureLocal: featureLocal,
+	}
+}
+
+func NewRemoteHvac(featureRemote spineapi.FeatureRemoteInterface) *HvacCommon {
+	return &HvacCommon{
+		featureRemote: featureRemote,
+	}
+}
+
+var _ api.HvacCommonInterface = (*HvacCommon)(nil)
+
+// Get the overrun description for a given overrunId
+//
+// Will return nil if no matching description is found
+func (h *HvacCommon) GetOverrunDescriptionForId(overrunId model.HvacOverrunIdType) (
+	*model.HvacOverrunDescriptionDataType, error) {
+	data, err := h.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{OverrunId: &overrunId})
+
+	if err != nil || len(data) != 1 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return &data[0], nil
+}
+
+// Get the overrun descriptions for a given filter
+//
+// Returns an error if no matching description is found
+func (h *HvacCommon) GetOverrunDescriptionsForFilter(
+	filter model.HvacOverrunDescriptionDataType,
+) ([]model.HvacOverrunDescriptionDataType, error) {
+	function := model.FunctionTypeHvacOverrunDescriptionListData
+
+	data, err := featureDataCopyOfType[model.HvacOverrunDescriptionListDataType](h.featureLocal, h.featureRemote, function)
+	if err != nil || data == nil || data.HvacOverrunDescriptionData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	result := searchFilterInList[model.HvacOverrunDescriptionDataType](data.HvacOverrunDescriptionData, filter)
+	return result, nil
+}
+
+// Get the overrun data for a given overrunId
+//
+// Will return nil if no data is available
+func (h *HvacCommon) GetOverrunDataForId(overrunId model.HvacOverrunIdType) (*model.HvacOverrunDataType, error) {
+	result, err := h.GetOverrunDataForFilter(model.HvacOverrunDescriptionDataType{OverrunId: &overrunId})
+	if err != nil || len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return &result[0], nil
+}
+
+// Get the overrun data for a given filter
+//
+// Will return nil if no data is available
+func (h *HvacCommon) GetOverrunDataForFilter(filter model.HvacOverrunDescriptionDataType) ([]model.HvacOverrunDataType, error) {
+	function := model.FunctionTypeHvacOverrunListData
+
+	descriptions, err := h.GetOverrunDescriptionsForFilter(filter)
+	if err != nil || len(descriptions) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	data, err := featureDataCopyOfType[model.HvacOverrunListDataType](h.featureLocal, h.featureRemote, function)
+	if err != nil || data == nil || data.HvacOverrunData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	var result []model.HvacOverrunDataType
+
+	for _, desc := range descriptions {
+		filter2 := model.HvacOverrunDataType{
+			OverrunId: desc.OverrunId,
+		}
+
+		elements := searchFilterInList[model.HvacOverrunDataType](data.HvacOverrunData, filter2)
+		result = append(result, elements...)
+	}
+
+	return result, nil
+}
+
+// Get the system function descriptions for a given filter
+//
+// Returns an error if no matching description is found
+func (h *HvacCommon) GetSystemFunctionDescriptionsForFilter(
+	filter model.HvacSystemFunctionDescriptionDataType,
+) ([]model.HvacSystemFunctionDescriptionDataType, error) {
+	function := model.FunctionTypeHvacSystemFunctionDescriptionListData
+
+	data, err := featureDataCopyOfType[model.HvacSystemFunctionDescriptionListDataType](h.featureLocal, h.featureRemote, function)
+	if err != nil || data == nil || data.HvacSystemFunctionDescriptionData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	result := searchFilterInList[model.HvacSystemFunctionDescriptionDataType](data.HvacSystemFunctionDescriptionData, filter)
+	return result, nil
+}
+
+// Get the system function setpoint relations for a given filter
+//
+// Returns an error if no matching relation is found
+func (h *HvacCommon) GetSystemFunctionSetpointRelationsForFilter(
+	filter model.HvacSystemFunctionSetpointRelationDataType,
+) ([]model.HvacSystemFunctionSetpointRelationDataType, error) {
+	function := model.FunctionTypeHvacSystemFunctionSetPointRelationListData
+
+	data, err := featureDataCopyOfType[model.HvacSystemFunctionSetpointRelationListDataType](h.featureLocal, h.featureRemote, function)
+	if err != nil || data == nil || data.HvacSystemFunctionSetpointRelationData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	result := searchFilterInList[model.HvacSystemFunctionSetpointRelationDataType](data.HvacSystemFunctionSetpointRelationData, filter)
+	return result, nil
+}
@@ -0,0 +1,313 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/enbility/eebus-go/features/internal"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHvacSuite(t *testing.T) {
+	suite.Run(t, new(HvacSuite))
+}
+
+type HvacSuite struct {
+	suite.Suite
+
+	localEntity  spineapi.EntityLocalInterface
+	remoteEntity spineapi.EntityRemoteInterface
+
+	localFeature  spineapi.FeatureLocalInterface
+	remoteFeature spineapi.FeatureRemoteInterface
+
+	localSut,
+	remoteSut *internal.HvacCommon
+}
+
+func (s *HvacSuite) BeforeTest(suiteName, testName string) {
+	mockWriter := shipmocks.NewShipConnectionDataWriterInterface(s.T())
+	mockWriter.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+
+	s.localEntity, s.remoteEntity = setupFeatures(
+		s.T(),
+		mockWriter,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeHvac,
+				functions: []model.FunctionType{
+					model.FunctionTypeHvacOverrunDescriptionListData,
+					model.FunctionTypeHvacOverrunListData,
+					model.FunctionTypeHvacSystemFunctionSetPointRelationListData,
+				},
+			},
+		},
+	)
+
+	s.localFeature = s.localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	assert.NotNil(s.T(), s.localFeature)
+	s.localSut = internal.NewLocalHvac(s.localFeature)
+	assert.NotNil(s.T(), s.localSut)
+
+	s.remoteFeature = s.remoteEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	assert.NotNil(s.T(), s.remoteFeature)
+	s.remoteSut = internal.NewRemoteHvac(s.remoteFeature)
+	assert.NotNil(s.T(), s.remoteSut)
+}
+
+func (s *HvacSuite) Test_GetOverrunDescriptionForId() {
+	overrunId := model.HvacOverrunIdType(0)
+	data, err := s.localSut.GetOverrunDescriptionForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDescriptionForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetOverrunDescriptionForId(overrunId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDescriptionForId(overrunId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+
+	overrunId = model.HvacOverrunIdType(10)
+	data, err = s.localSut.GetOverrunDescriptionForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDescriptionForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *HvacSuite) Test_GetOverrunDescriptionsForFilter() {
+	filter := model.HvacOverrunDescriptionDataType{
+		OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition1),
+	}
+
+	data, err := s.localSut.GetOverrunDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetOverrunDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	data, err = s.remoteSut.GetOverrunDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+
+	filter.OverrunType = util.Ptr(model.HvacOverrunTypeTypeParty)
+	data, err = s.localSut.GetOverrunDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *HvacSuite) Test_GetOverrunDataForFilter() {
+	filter := model.HvacOverrunDescriptionDataType{}
+	data, err := s.localSut.GetOverrunDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetOverrunDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addData()
+
+	data, err = s.localSut.GetOverrunDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+	data, err = s.remoteSut.GetOverrunDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+
+	filter.OverrunType = util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition4)
+	data, err = s.localSut.GetOverrunDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeActive, *data[0].OverrunStatus)
+	data, err = s.remoteSut.GetOverrunDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeActive, *data[0].OverrunStatus)
+}
+
+func (s *HvacSuite) Test_GetOverrunDataForId() {
+	overrunId := model.HvacOverrunIdType(0)
+	data, err := s.localSut.GetOverrunDataForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDataForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+	s.addData()
+
+	data, err = s.localSut.GetOverrunDataForId(overrunId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDataForId(overrunId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+
+	overrunId = model.HvacOverrunIdType(10)
+	data, err = s.localSut.GetOverrunDataForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetOverrunDataForId(overrunId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *HvacSuite) Test_GetSystemFunctionDescriptionsForFilter() {
+	filter := model.HvacSystemFunctionDescriptionDataType{
+		SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeHeating),
+	}
+	data, err := s.localSut.GetSystemFunctionDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetSystemFunctionDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	// the SPINE function data of this version can not hold the description list,
+	// so it is provided by a mocked feature
+	fData := &model.HvacSystemFunctionDescriptionListDataType{
+		HvacSystemFunctionDescriptionData: []model.HvacSystemFunctionDescriptionDataType{
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(1)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeHeating),
+			},
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(2)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeCooling),
+			},
+		},
+	}
+	mockFeature := spinemocks.NewFeatureRemoteInterface(s.T())
+	mockFeature.EXPECT().DataCopy(model.FunctionTypeHvacSystemFunctionDescriptionListData).Return(fData)
+	sut := internal.NewRemoteHvac(mockFeature)
+
+	data, err = sut.GetSystemFunctionDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.HvacSystemFunctionIdType(1), *data[0].SystemFunctionId)
+
+	filter.SystemFunctionType = util.Ptr(model.HvacSystemFunctionTypeTypeDhw)
+	data, err = sut.GetSystemFunctionDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(data))
+}
+
+func (s *HvacSuite) Test_GetSystemFunctionSetpointRelationsForFilter() {
+	filter := model.HvacSystemFunctionSetpointRelationDataType{
+		SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(2)),
+	}
+	data, err := s.localSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addSetpointRelations()
+
+	data, err = s.localSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.SetpointIdType(4), *data[0].SetpointId)
+	data, err = s.remoteSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.SetpointIdType(4), *data[0].SetpointId)
+
+	filter.SystemFunctionId = util.Ptr(model.HvacSystemFunctionIdType(10))
+	data, err = s.localSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(data))
+	data, err = s.remoteSut.GetSystemFunctionSetpointRelationsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(data))
+}
+
+// helper
+
+func (s *HvacSuite) addDescription() {
+	fData := &model.HvacOverrunDescriptionListDataType{
+		HvacOverrunDescriptionData: []model.HvacOverrunDescriptionDataType{
+			{
+				OverrunId:   util.Ptr(model.HvacOverrunIdType(0)),
+				OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition1),
+			},
+			{
+				OverrunId:   util.Ptr(model.HvacOverrunIdType(1)),
+				OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition4),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeHvacOverrunDescriptionListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, fData, nil, nil)
+}
+
+func (s *HvacSuite) addData() {
+	fData := &model.HvacOverrunListDataType{
+		HvacOverrunData: []model.HvacOverrunDataType{
+			{
+				OverrunId:                 util.Ptr(model.HvacOverrunIdType(0)),
+				OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeInactive),
+				IsOverrunStatusChangeable: util.Ptr(true),
+			},
+			{
+				OverrunId:                 util.Ptr(model.HvacOverrunIdType(1)),
+				OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeActive),
+				IsOverrunStatusChangeable: util.Ptr(true),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeHvacOverrunListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeHvacOverrunListData, fData, nil, nil)
+}
+
+func (s *HvacSuite) addSetpointRelations() {
+	fData := &model.HvacSystemFunctionSetpointRelationListDataType{
+		HvacSystemFunctionSetpointRelationData: []model.HvacSystemFunctionSetpointRelationDataType{
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(1)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(3)),
+			},
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(2)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(4)),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeHvacSystemFunctionSetPointRelationListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeHvacSystemFunctionSetPointRelationListData, fData, nil, nil)
+}
@@ -37,6 +37,10 @@ func (i *IncentiveTableCommon) GetDescriptionsForFilter(
 
 	var result []model.IncentiveTableDescriptionType
 	for _, item := range data.IncentiveTableDescription {
+		if item.TariffDescription == nil {
+			continue
+		}
+
 		match := searchFilterInItem[model.TariffDescriptionDataType](*item.TariffDescription, filter)
 
 		if match {
@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type SetpointCommon struct {
+	featureLocal  spineapi.FeatureLocalInterface
+	featureRemote spineapi.FeatureRemoteInterface
+}
+
+func NewLocalSetpoint(featureLocal spineapi.FeatureLocalInterface) *SetpointCommon {
+	return &SetpointCommon{
+		featureLocal: featureLocal,
+	}
+}
+
+func NewRemoteSetpoint(featureRemote spineapi.FeatureRemoteInterface) *SetpointCommon {
+	return &SetpointCommon{
+		featureRemote: featureRemote,
+	}
+}
+
+var _ api.SetpointCommonInterface = (*SetpointCommon)(nil)
+
+// check if spine.EventPayload Data contains data for a given filter
+//
+// data type will be checked for model.SetpointListDataType,
+// filter type will be checked for model.SetpointDescriptionDataType
+func (s *SetpointCommon) CheckEventPayloadDataForFilter(payloadData any, filter any) bool {
+	if payloadData == nil {
+		return false
+	}
+
+	data, ok := payloadData.(*model.SetpointListDataType)
+	filterData, ok2 := filter.(model.SetpointDescriptionDataType)
+	if !ok || !ok2 {
+		return false
+	}
+
+	descs, err := s.GetDescriptionsForFilter(filterData)
+	if err != nil {
+		return false
+	}
+	for _, desc := range descs {
+		if desc.SetpointId == nil {
+			continue
+		}
+
+		for _, item := range data.SetpointData {
+			if item.SetpointId != nil &&
+				*item.SetpointId == *desc.SetpointId &&
+				item.Value != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Get the description for a given setpointId
+//
+// Returns an error if no matching description is found
+func (s *SetpointCommon) GetDescriptionForId(
+	setpointId model.SetpointIdType,
+) (*model.SetpointDescriptionDataType, error) {
+	data, err := s.GetDescriptionsForFilter(model.SetpointDescriptionDataType{SetpointId: &setpointId})
+
+	if err != nil || len(data) != 1 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return &data[0], nil
+}
+
+// Get the descriptions for a given filter
+//
+// Returns an error if no matching description is found
+func (s *SetpointCommon) GetDescriptionsForFilter(
+	filter model.SetpointDescriptionDataType,
+) ([]model.SetpointDescriptionDataType, error) {
+	function := model.FunctionTypeSetpointDescriptionListData
+
+	data, err := featureDataCopyOfType[model.SetpointDescriptionListDataType](s.featureLocal, s.featureRemote, function)
+	if err != nil || data == nil || data.SetpointDescriptionData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	result := searchFilterInList[model.SetpointDescriptionDataType](data.SetpointDescriptionData, filter)
+	return result, nil
+}
+
+// Get the constraints for a given setpointId
+//
+// Returns an error if no matching constraint is found
+func (s *SetpointCommon) GetConstraintsForId(
+	setpointId model.SetpointIdType,
+) (*model.SetpointConstraintsDataType, error) {
+	data, err := s.GetConstraintsForFilter(model.SetpointConstraintsDataType{SetpointId: &setpointId})
+
+	if err != nil || len(data) != 1 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return &data[0], nil
+}
+
+// Get the constraints for a given filter
+//
+// Returns an error if no matching constraint is found
+func (s *SetpointCommon) GetConstraintsForFilter(
+	filter model.SetpointConstraintsDataType,
+) ([]model.SetpointConstraintsDataType, error) {
+	function := model.FunctionTypeSetpointConstraintsListData
+
+	data, err := featureDataCopyOfType[model.SetpointConstraintsListDataType](s.featureLocal, s.featureRemote, function)
+	if err != nil || data == nil || data.SetpointConstraintsData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	result := searchFilterInList[model.SetpointConstraintsDataType](data.SetpointConstraintsData, filter)
+	return result, nil
+}
+
+// Get the setpoint data for a given setpointId
+//
+// Will return nil if no data is available
+func (s *SetpointCommon) GetDataForId(setpointId model.SetpointIdType) (*model.SetpointDataType, error) {
+	result, err := s.GetDataForFilter(model.SetpointDescriptionDataType{SetpointId: &setpointId})
+	if err != nil || len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return &result[0], nil
+}
+
+// Get the setpoint data for a given filter
+//
+// Will return nil if no data is available
+func (s *SetpointCommon) GetDataForFilter(filter model.SetpointDescriptionDataType) ([]model.SetpointDataType, error) {
+	function := model.FunctionTypeSetpointListData
+
+	descriptions, err := s.GetDescriptionsForFilter(filter)
+	if err != nil || len(descriptions) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	data, err := featureDataCopyOfType[model.SetpointListDataType](s.featureLocal, s.featureRemote, function)
+	if err != nil || data == nil || data.SetpointData == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	var result []model.SetpointDataType
+
+	for _, desc := range descriptions {
+		filter2 := model.SetpointDataType{
+			SetpointId: desc.SetpointId,
+		}
+
+		elements := searchFilterInList[model.SetpointDataType](data.SetpointData, filter2)
+		result = append(result, elements...)
+	}
+
+	return result, nil
+}
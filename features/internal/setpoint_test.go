@@ -0,0 +1,311 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/enbility/eebus-go/features/internal"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSetpointSuite(t *testing.T) {
+	suite.Run(t, new(SetpointSuite))
+}
+
+type SetpointSuite struct {
+	suite.Suite
+
+	localEntity  spineapi.EntityLocalInterface
+	remoteEntity spineapi.EntityRemoteInterface
+
+	localFeature  spineapi.FeatureLocalInterface
+	remoteFeature spineapi.FeatureRemoteInterface
+
+	localSut,
+	remoteSut *internal.SetpointCommon
+}
+
+func (s *SetpointSuite) BeforeTest(suiteName, testName string) {
+	mockWriter := shipmocks.NewShipConnectionDataWriterInterface(s.T())
+	mockWriter.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+
+	s.localEntity, s.remoteEntity = setupFeatures(
+		s.T(),
+		mockWriter,
+		[]featureFunctions{
+			{
+				featureType: model.FeatureTypeTypeSetpoint,
+				functions: []model.FunctionType{
+					model.FunctionTypeSetpointDescriptionListData,
+					model.FunctionTypeSetpointConstraintsListData,
+					model.FunctionTypeSetpointListData,
+				},
+			},
+		},
+	)
+
+	s.localFeature = s.localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	assert.NotNil(s.T(), s.localFeature)
+	s.localSut = internal.NewLocalSetpoint(s.localFeature)
+	assert.NotNil(s.T(), s.localSut)
+
+	s.remoteFeature = s.remoteEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	assert.NotNil(s.T(), s.remoteFeature)
+	s.remoteSut = internal.NewRemoteSetpoint(s.remoteFeature)
+	assert.NotNil(s.T(), s.remoteSut)
+}
+
+func (s *SetpointSuite) Test_CheckEventPayloadDataForFilter() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	exists := s.localSut.CheckEventPayloadDataForFilter(nil, filter)
+	assert.False(s.T(), exists)
+	exists = s.remoteSut.CheckEventPayloadDataForFilter(nil, filter)
+	assert.False(s.T(), exists)
+
+	payloadData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId: util.Ptr(model.SetpointIdType(0)),
+				Value:      model.NewScaledNumberType(50),
+			},
+		},
+	}
+
+	exists = s.localSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.False(s.T(), exists)
+	exists = s.remoteSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.False(s.T(), exists)
+
+	s.addDescription()
+
+	exists = s.localSut.CheckEventPayloadDataForFilter(payloadData, "invalid")
+	assert.False(s.T(), exists)
+	exists = s.remoteSut.CheckEventPayloadDataForFilter(payloadData, "invalid")
+	assert.False(s.T(), exists)
+
+	exists = s.localSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.True(s.T(), exists)
+	exists = s.remoteSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.True(s.T(), exists)
+
+	filter.SetpointType = util.Ptr(model.SetpointTypeTypeValueRelative)
+	exists = s.localSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.False(s.T(), exists)
+	exists = s.remoteSut.CheckEventPayloadDataForFilter(payloadData, filter)
+	assert.False(s.T(), exists)
+}
+
+func (s *SetpointSuite) Test_GetDescriptionForId() {
+	setpointId := model.SetpointIdType(0)
+	data, err := s.localSut.GetDescriptionForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDescriptionForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetDescriptionForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	data, err = s.remoteSut.GetDescriptionForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+
+	setpointId = model.SetpointIdType(10)
+	data, err = s.localSut.GetDescriptionForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDescriptionForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *SetpointSuite) Test_GetDescriptionsForFilter() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	data, err := s.localSut.GetDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	data, err = s.remoteSut.GetDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+}
+
+func (s *SetpointSuite) Test_GetConstraints() {
+	setpointId := model.SetpointIdType(1)
+	data, err := s.localSut.GetConstraintsForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetConstraintsForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addConstraints()
+
+	data, err = s.localSut.GetConstraintsForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 30.0, data.SetpointRangeMin.GetValue())
+	data, err = s.remoteSut.GetConstraintsForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 30.0, data.SetpointRangeMin.GetValue())
+
+	list, err := s.localSut.GetConstraintsForFilter(model.SetpointConstraintsDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(list))
+	list, err = s.remoteSut.GetConstraintsForFilter(model.SetpointConstraintsDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(list))
+
+	setpointId = model.SetpointIdType(10)
+	data, err = s.localSut.GetConstraintsForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetConstraintsForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *SetpointSuite) Test_GetDataForFilter() {
+	filter := model.SetpointDescriptionDataType{}
+	data, err := s.localSut.GetDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+
+	data, err = s.localSut.GetDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDataForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addData()
+
+	data, err = s.localSut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+	data, err = s.remoteSut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+
+	filter.SetpointType = util.Ptr(model.SetpointTypeTypeValueRelative)
+	data, err = s.localSut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 5.0, data[0].Value.GetValue())
+	data, err = s.remoteSut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 5.0, data[0].Value.GetValue())
+}
+
+func (s *SetpointSuite) Test_GetDataForId() {
+	setpointId := model.SetpointIdType(0)
+	data, err := s.localSut.GetDataForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDataForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.addDescription()
+	s.addData()
+
+	data, err = s.localSut.GetDataForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.0, data.Value.GetValue())
+	data, err = s.remoteSut.GetDataForId(setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.0, data.Value.GetValue())
+
+	setpointId = model.SetpointIdType(10)
+	data, err = s.localSut.GetDataForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+	data, err = s.remoteSut.GetDataForId(setpointId)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+// helper
+
+func (s *SetpointSuite) addDescription() {
+	fData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(1)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueRelative),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeSetpointDescriptionListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, fData, nil, nil)
+}
+
+func (s *SetpointSuite) addConstraints() {
+	fData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(20),
+				SetpointRangeMax: model.NewScaledNumberType(60),
+			},
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(1)),
+				SetpointRangeMin: model.NewScaledNumberType(30),
+				SetpointRangeMax: model.NewScaledNumberType(70),
+				SetpointStepSize: model.NewScaledNumberType(0.5),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeSetpointConstraintsListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, fData, nil, nil)
+}
+
+func (s *SetpointSuite) addData() {
+	fData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(50),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(1)),
+				Value:                model.NewScaledNumberType(5),
+				IsSetpointChangeable: util.Ptr(false),
+			},
+		},
+	}
+	_ = s.localFeature.UpdateData(model.FunctionTypeSetpointListData, fData, nil, nil)
+	_, _ = s.remoteFeature.UpdateData(true, model.FunctionTypeSetpointListData, fData, nil, nil)
+}
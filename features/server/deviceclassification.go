@@ -0,0 +1,70 @@
+package server
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type DeviceClassification struct {
+	*Feature
+
+	*internal.DeviceClassificationCommon
+}
+
+func NewDeviceClassification(localEntity spineapi.EntityLocalInterface) (*DeviceClassification, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeDeviceClassification, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	dc := &DeviceClassification{
+		Feature:                    feature,
+		DeviceClassificationCommon: internal.NewLocalDeviceClassification(feature.featureLocal),
+	}
+
+	return dc, nil
+}
+
+var _ api.DeviceClassificationServerInterface = (*DeviceClassification)(nil)
+
+// set the manufacturer data of the device or entity
+//
+// empty values are not provided
+func (d *DeviceClassification) SetManufacturerData(data api.ManufacturerData) {
+	manufacturerData := &model.DeviceClassificationManufacturerDataType{
+		DeviceName:                     ptrIfNotEmpty[model.DeviceClassificationStringType](data.DeviceName),
+		DeviceCode:                     ptrIfNotEmpty[model.DeviceClassificationStringType](data.DeviceCode),
+		SerialNumber:                   ptrIfNotEmpty[model.DeviceClassificationStringType](data.SerialNumber),
+		SoftwareRevision:               ptrIfNotEmpty[model.DeviceClassificationStringType](data.SoftwareRevision),
+		HardwareRevision:               ptrIfNotEmpty[model.DeviceClassificationStringType](data.HardwareRevision),
+		VendorName:                     ptrIfNotEmpty[model.DeviceClassificationStringType](data.VendorName),
+		VendorCode:                     ptrIfNotEmpty[model.DeviceClassificationStringType](data.VendorCode),
+		BrandName:                      ptrIfNotEmpty[model.DeviceClassificationStringType](data.BrandName),
+		PowerSource:                    ptrIfNotEmpty[model.PowerSourceType](data.PowerSource),
+		ManufacturerNodeIdentification: ptrIfNotEmpty[model.DeviceClassificationStringType](data.ManufacturerNodeIdentification),
+		ManufacturerLabel:              ptrIfNotEmpty[model.LabelType](data.ManufacturerLabel),
+		ManufacturerDescription:        ptrIfNotEmpty[model.DescriptionType](data.ManufacturerDescription),
+	}
+
+	d.featureLocal.SetData(model.FunctionTypeDeviceClassificationManufacturerData, manufacturerData)
+}
+
+// set the user data of the device or entity
+//
+// the user data function is added to the feature if it is not yet supported
+func (d *DeviceClassification) SetUserData(data *model.DeviceClassificationUserDataType) {
+	d.featureLocal.AddFunctionType(model.FunctionTypeDeviceClassificationUserData, true, false)
+	d.featureLocal.SetData(model.FunctionTypeDeviceClassificationUserData, data)
+}
+
+// return a pointer to the value, or nil if the value is empty
+func ptrIfNotEmpty[T ~string](v string) *T {
+	if v == "" {
+		return nil
+	}
+
+	value := T(v)
+	return &value
+}
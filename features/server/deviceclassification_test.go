@@ -0,0 +1,100 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDeviceClassificationSuite(t *testing.T) {
+	suite.Run(t, new(DeviceClassificationSuite))
+}
+
+type DeviceClassificationSuite struct {
+	suite.Suite
+
+	sut *server.DeviceClassification
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *DeviceClassificationSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	_, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewDeviceClassification(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewDeviceClassification(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+func (s *DeviceClassificationSuite) Test_ManufacturerData() {
+	data, err := s.sut.GetManufacturerDetails()
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	s.sut.SetManufacturerData(api.ManufacturerData{
+		DeviceName:   "deviceName",
+		SerialNumber: "serialNumber",
+		PowerSource:  string(model.PowerSourceTypeMains3Phase),
+	})
+
+	data, err = s.sut.GetManufacturerDetails()
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("deviceName"), *data.DeviceName)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("serialNumber"), *data.SerialNumber)
+	assert.Equal(s.T(), model.PowerSourceTypeMains3Phase, *data.PowerSource)
+	assert.Nil(s.T(), data.DeviceCode)
+	assert.Nil(s.T(), data.ManufacturerLabel)
+}
+
+func (s *DeviceClassificationSuite) Test_UserData() {
+	// the device entity only supports manufacturer data by default
+	deviceEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeDeviceInformation)
+	sut, err := server.NewDeviceClassification(deviceEntity)
+	assert.Nil(s.T(), err)
+
+	fct := model.FunctionTypeDeviceClassificationUserData
+	feature := deviceEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	assert.Nil(s.T(), feature.Operations()[fct])
+
+	userData := &model.DeviceClassificationUserDataType{
+		UserLabel: util.Ptr(model.LabelType("label")),
+	}
+	sut.SetUserData(userData)
+	assert.NotNil(s.T(), feature.Operations()[fct])
+
+	data := feature.DataCopy(fct)
+	assert.Equal(s.T(), userData, data)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/enbility/eebus-go/features/internal"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
 )
 
 type DeviceDiagnosis struct {
@@ -41,3 +42,19 @@ func (d *DeviceDiagnosis) SetLocalOperatingState(operatingState model.DeviceDiag
 	}
 	d.SetLocalState(stateData)
 }
+
+// set the local device diagnosis operating state and the last error code
+//
+// an empty lastErrorCode is not provided
+func (d *DeviceDiagnosis) SetLocalOperatingStateWithLastErrorCode(
+	operatingState model.DeviceDiagnosisOperatingStateType,
+	lastErrorCode string,
+) {
+	stateData := &model.DeviceDiagnosisStateDataType{
+		OperatingState: &operatingState,
+	}
+	if lastErrorCode != "" {
+		stateData.LastErrorCode = util.Ptr(model.LastErrorCodeType(lastErrorCode))
+	}
+	d.SetLocalState(stateData)
+}
@@ -88,3 +88,19 @@ func (s *DeviceDiagnosisSuite) Test_SetState() {
 func (s *DeviceDiagnosisSuite) Test_SetLocalOperatingState() {
 	s.sut.SetLocalOperatingState(model.DeviceDiagnosisOperatingStateTypeNormalOperation)
 }
+
+func (s *DeviceDiagnosisSuite) Test_SetLocalOperatingStateWithLastErrorCode() {
+	s.sut.SetLocalOperatingStateWithLastErrorCode(model.DeviceDiagnosisOperatingStateTypeFailure, "error")
+
+	data, err := s.sut.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeFailure, *data.OperatingState)
+	assert.Equal(s.T(), model.LastErrorCodeType("error"), *data.LastErrorCode)
+
+	s.sut.SetLocalOperatingStateWithLastErrorCode(model.DeviceDiagnosisOperatingStateTypeNormalOperation, "")
+
+	data, err = s.sut.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, *data.OperatingState)
+	assert.Nil(s.T(), data.LastErrorCode)
+}
@@ -57,6 +57,31 @@ func setupFeatures(
 	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
 	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
 	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(11, localEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeSmartEnergyManagementPsData, true, true)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(12, localEntity, model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeHvacOverrunDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeHvacOverrunListData, true, true)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(13, localEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeSetpointDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeSetpointConstraintsListData, true, false)
+	f.AddFunctionType(model.FunctionTypeSetpointListData, true, true)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(14, localEntity, model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesConstraintsListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesListData, true, true)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(15, localEntity, model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableDescriptionData, true, true)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableConstraintsData, true, false)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableData, true, true)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(16, localEntity, model.FeatureTypeTypeIdentification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIdentificationListData, true, false)
+	localEntity.AddFeature(f)
 
 	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
 	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
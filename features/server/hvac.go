@@ -0,0 +1,110 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type Hvac struct {
+	*Feature
+
+	*internal.HvacCommon
+}
+
+func NewHvac(localEntity spineapi.EntityLocalInterface) (*Hvac, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeHvac, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Hvac{
+		Feature:    feature,
+		HvacCommon: internal.NewLocalHvac(feature.featureLocal),
+	}
+
+	return h, nil
+}
+
+var _ api.HvacServerInterface = (*Hvac)(nil)
+
+// Add a new overrun description data set and return the overrunId
+//
+// NOTE: the overrunId may not be provided
+//
+// will return nil if the data set could not be added
+func (h *Hvac) AddOverrunDescription(
+	description model.HvacOverrunDescriptionDataType,
+) *model.HvacOverrunIdType {
+	if description.OverrunId != nil {
+		return nil
+	}
+
+	data, err := h.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		data = []model.HvacOverrunDescriptionDataType{}
+	}
+
+	maxId := model.HvacOverrunIdType(0)
+
+	for _, item := range data {
+		if item.OverrunId != nil && *item.OverrunId >= maxId {
+			maxId = *item.OverrunId + 1
+		}
+	}
+
+	overrunId := util.Ptr(maxId)
+	description.OverrunId = overrunId
+
+	partial := model.NewFilterTypePartial()
+	datalist := &model.HvacOverrunDescriptionListDataType{
+		HvacOverrunDescriptionData: []model.HvacOverrunDescriptionDataType{description},
+	}
+
+	if err := h.featureLocal.UpdateData(model.FunctionTypeHvacOverrunDescriptionListData, datalist, partial, nil); err != nil {
+		return nil
+	}
+
+	return overrunId
+}
+
+// Set or update the overrun data set for an overrunId
+// Elements provided in deleteElements will be removed from the data set before the update
+//
+// Will return an error if the data set could not be updated
+func (h *Hvac) UpdateOverrunDataForId(
+	data model.HvacOverrunDataType,
+	deleteElements *model.HvacOverrunDataElementsType,
+	overrunId model.HvacOverrunIdType,
+) error {
+	if _, err := h.GetOverrunDescriptionForId(overrunId); err != nil {
+		return err
+	}
+
+	data.OverrunId = util.Ptr(overrunId)
+
+	datalist := &model.HvacOverrunListDataType{
+		HvacOverrunData: []model.HvacOverrunDataType{data},
+	}
+
+	partial := model.NewFilterTypePartial()
+	var deleteFilter *model.FilterType
+	if deleteElements != nil {
+		deleteFilter = &model.FilterType{
+			HvacOverrunListDataSelectors: &model.HvacOverrunListDataSelectorsType{
+				OverrunId: util.Ptr(overrunId),
+			},
+			HvacOverrunDataElements: deleteElements,
+		}
+	}
+
+	if err := h.featureLocal.UpdateData(model.FunctionTypeHvacOverrunListData, datalist, partial, deleteFilter); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
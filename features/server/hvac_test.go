@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestHvacSuite(t *testing.T) {
+	suite.Run(t, new(HvacSuite))
+}
+
+type HvacSuite struct {
+	suite.Suite
+
+	sut *server.Hvac
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *HvacSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	_, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewHvac(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewHvac(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+func (s *HvacSuite) Test_OverrunDescription() {
+	filter := model.HvacOverrunDescriptionDataType{
+		OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition1),
+	}
+
+	data, err := s.sut.GetOverrunDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	desc := model.HvacOverrunDescriptionDataType{
+		OverrunId:   util.Ptr(model.HvacOverrunIdType(5)),
+		OverrunType: filter.OverrunType,
+	}
+	overrunId := s.sut.AddOverrunDescription(desc)
+	assert.Nil(s.T(), overrunId)
+
+	desc.OverrunId = nil
+	overrunId = s.sut.AddOverrunDescription(desc)
+	assert.NotNil(s.T(), overrunId)
+	assert.Equal(s.T(), model.HvacOverrunIdType(0), *overrunId)
+
+	data, err = s.sut.GetOverrunDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), *overrunId, *data[0].OverrunId)
+
+	desc.OverrunType = util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition3)
+	overrunId = s.sut.AddOverrunDescription(desc)
+	assert.NotNil(s.T(), overrunId)
+	assert.Equal(s.T(), model.HvacOverrunIdType(1), *overrunId)
+
+	result, err := s.sut.GetOverrunDescriptionForId(*overrunId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.HvacOverrunTypeTypeSgReadyCondition3, *result.OverrunType)
+}
+
+func (s *HvacSuite) Test_OverrunData() {
+	data := model.HvacOverrunDataType{
+		OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeInactive),
+		TimeTableId:               util.Ptr(model.TimeTableIdType(1)),
+		IsOverrunStatusChangeable: util.Ptr(true),
+	}
+
+	err := s.sut.UpdateOverrunDataForId(data, nil, model.HvacOverrunIdType(0))
+	assert.NotNil(s.T(), err)
+
+	overrunId := s.sut.AddOverrunDescription(model.HvacOverrunDescriptionDataType{
+		OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition4),
+	})
+	assert.NotNil(s.T(), overrunId)
+
+	err = s.sut.UpdateOverrunDataForId(data, nil, *overrunId)
+	assert.Nil(s.T(), err)
+
+	result, err := s.sut.GetOverrunDataForId(*overrunId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), *overrunId, *result.OverrunId)
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeInactive, *result.OverrunStatus)
+	assert.NotNil(s.T(), result.TimeTableId)
+
+	data = model.HvacOverrunDataType{
+		OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeActive),
+	}
+	deleteElements := &model.HvacOverrunDataElementsType{
+		TimeTableId: &model.ElementTagType{},
+	}
+	err = s.sut.UpdateOverrunDataForId(data, deleteElements, *overrunId)
+	assert.Nil(s.T(), err)
+
+	result, err = s.sut.GetOverrunDataForId(*overrunId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeActive, *result.OverrunStatus)
+	assert.Nil(s.T(), result.TimeTableId)
+	assert.True(s.T(), *result.IsOverrunStatusChangeable)
+}
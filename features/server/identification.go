@@ -0,0 +1,216 @@
+package server
+
+import (
+	"errors"
+	"net"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type Identification struct {
+	*Feature
+
+	*internal.IdentificationCommon
+}
+
+func NewIdentification(localEntity spineapi.EntityLocalInterface) (*Identification, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeIdentification, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &Identification{
+		Feature:              feature,
+		IdentificationCommon: internal.NewLocalIdentification(feature.featureLocal),
+	}
+
+	return i, nil
+}
+
+var _ api.IdentificationServerInterface = (*Identification)(nil)
+
+// Add a new identification and return the identificationId
+//
+// subscribers are notified about the change
+//
+// will return nil if the value is empty, the identification already exists
+// or the data set could not be added
+func (i *Identification) AddIdentification(
+	identificationType model.IdentificationTypeType,
+	value string,
+) *model.IdentificationIdType {
+	if value == "" {
+		return nil
+	}
+
+	data, err := i.GetDataForFilter(model.IdentificationDataType{})
+	if err != nil {
+		data = []model.IdentificationDataType{}
+	}
+
+	maxId := model.IdentificationIdType(0)
+
+	for _, item := range data {
+		if item.IdentificationType != nil && *item.IdentificationType == identificationType &&
+			item.IdentificationValue != nil && string(*item.IdentificationValue) == value {
+			return nil
+		}
+
+		if item.IdentificationId != nil && *item.IdentificationId >= maxId {
+			maxId = *item.IdentificationId + 1
+		}
+	}
+
+	identificationId := util.Ptr(maxId)
+
+	partial := model.NewFilterTypePartial()
+	datalist := &model.IdentificationListDataType{
+		IdentificationData: []model.IdentificationDataType{
+			{
+				IdentificationId:    identificationId,
+				IdentificationType:  util.Ptr(identificationType),
+				IdentificationValue: util.Ptr(model.IdentificationValueType(value)),
+			},
+		},
+	}
+
+	if err := i.featureLocal.UpdateData(model.FunctionTypeIdentificationListData, datalist, partial, nil); err != nil {
+		return nil
+	}
+
+	return identificationId
+}
+
+// Replace all identifications with the given ones
+//
+// the identificationIds are assigned in the given order, starting at 0,
+// duplicates are only added once and subscribers are notified once about the change
+//
+// Will return an error if an identification has no type or an empty value,
+// or the data could not be set
+func (i *Identification) SetIdentifications(identifications []model.IdentificationDataType) error {
+	datalist := &model.IdentificationListDataType{}
+
+	for _, item := range identifications {
+		if item.IdentificationType == nil || item.IdentificationValue == nil || *item.IdentificationValue == "" {
+			return api.ErrMissingData
+		}
+
+		duplicate := false
+		for _, existing := range datalist.IdentificationData {
+			if *existing.IdentificationType == *item.IdentificationType &&
+				*existing.IdentificationValue == *item.IdentificationValue {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+
+		datalist.IdentificationData = append(datalist.IdentificationData, model.IdentificationDataType{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(len(datalist.IdentificationData))),
+			IdentificationType:  util.Ptr(*item.IdentificationType),
+			IdentificationValue: util.Ptr(*item.IdentificationValue),
+		})
+	}
+
+	if err := i.featureLocal.UpdateData(model.FunctionTypeIdentificationListData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Add the MAC address of an EV, e.g. the EVCCID, and return the identificationId
+//
+// the type is EUI-48 or EUI-64, depending on the length of the address
+//
+// will return nil if the address is invalid or could not be added
+func (i *Identification) AddMacAddress(address string) *model.IdentificationIdType {
+	mac, err := net.ParseMAC(address)
+	if err != nil {
+		return nil
+	}
+
+	switch len(mac) {
+	case 6:
+		return i.AddIdentification(model.IdentificationTypeTypeEui48, address)
+	case 8:
+		return i.AddIdentification(model.IdentificationTypeTypeEui64, address)
+	}
+
+	return nil
+}
+
+// Add a user RFID tag and return the identificationId
+//
+// will return nil if the tag is empty or could not be added
+func (i *Identification) AddRfidTag(tag string) *model.IdentificationIdType {
+	return i.AddIdentification(model.IdentificationTypeTypeUserrfidtag, tag)
+}
+
+// Remove the identification with the given identificationId
+//
+// subscribers are notified about the change
+//
+// Will return an error if the identification does not exist or could not be removed
+func (i *Identification) RemoveIdentification(identificationId model.IdentificationIdType) error {
+	filter := model.IdentificationDataType{
+		IdentificationId: util.Ptr(identificationId),
+	}
+	if data, err := i.GetDataForFilter(filter); err != nil || len(data) == 0 {
+		return api.ErrDataNotAvailable
+	}
+
+	return i.removeIdentifications(&model.IdentificationListDataSelectorsType{
+		IdentificationId: util.Ptr(identificationId),
+	})
+}
+
+// Remove all identifications of the given type
+//
+// subscribers are notified about the change
+//
+// Will return an error if no identification of this type exists or they could not be removed
+func (i *Identification) RemoveIdentificationsOfType(identificationType model.IdentificationTypeType) error {
+	filter := model.IdentificationDataType{
+		IdentificationType: util.Ptr(identificationType),
+	}
+	if data, err := i.GetDataForFilter(filter); err != nil || len(data) == 0 {
+		return api.ErrDataNotAvailable
+	}
+
+	return i.removeIdentifications(&model.IdentificationListDataSelectorsType{
+		IdentificationType: util.Ptr(identificationType),
+	})
+}
+
+// Remove all identifications
+//
+// subscribers are notified about the change
+func (i *Identification) ClearIdentifications() {
+	i.featureLocal.SetData(model.FunctionTypeIdentificationListData, &model.IdentificationListDataType{})
+}
+
+// remove all identifications matching the selector
+func (i *Identification) removeIdentifications(selector *model.IdentificationListDataSelectorsType) error {
+	deleteFilter := &model.FilterType{
+		IdentificationListDataSelectors: selector,
+	}
+
+	if err := i.featureLocal.UpdateData(
+		model.FunctionTypeIdentificationListData,
+		&model.IdentificationListDataType{},
+		nil,
+		deleteFilter,
+	); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestIdentificationSuite(t *testing.T) {
+	suite.Run(t, new(IdentificationSuite))
+}
+
+type IdentificationSuite struct {
+	suite.Suite
+
+	sut *server.Identification
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *IdentificationSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	_, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewIdentification(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewIdentification(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+func (s *IdentificationSuite) Test_Add() {
+	data, err := s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	id := s.sut.AddIdentification(model.IdentificationTypeTypeEui48, "")
+	assert.Nil(s.T(), id)
+
+	id = s.sut.AddMacAddress("invalid")
+	assert.Nil(s.T(), id)
+
+	// IP over InfiniBand addresses are valid, but not supported
+	id = s.sut.AddMacAddress("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01")
+	assert.Nil(s.T(), id)
+
+	id = s.sut.AddMacAddress("00:1a:2b:3c:4d:5e")
+	assert.NotNil(s.T(), id)
+	assert.Equal(s.T(), model.IdentificationIdType(0), *id)
+
+	// duplicates are not added
+	id = s.sut.AddMacAddress("00:1a:2b:3c:4d:5e")
+	assert.Nil(s.T(), id)
+
+	id = s.sut.AddMacAddress("00:1a:2b:3c:4d:5e:6f:70")
+	assert.NotNil(s.T(), id)
+	assert.Equal(s.T(), model.IdentificationIdType(1), *id)
+
+	id = s.sut.AddRfidTag("")
+	assert.Nil(s.T(), id)
+
+	id = s.sut.AddRfidTag("04A2B3C4")
+	assert.NotNil(s.T(), id)
+	assert.Equal(s.T(), model.IdentificationIdType(2), *id)
+
+	data, err = s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.IdentificationDataType{
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(0)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeEui48),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("00:1a:2b:3c:4d:5e")),
+		},
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(1)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeEui64),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("00:1a:2b:3c:4d:5e:6f:70")),
+		},
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(2)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeUserrfidtag),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("04A2B3C4")),
+		},
+	}, data)
+}
+
+func (s *IdentificationSuite) Test_Set() {
+	err := s.sut.SetIdentifications([]model.IdentificationDataType{
+		{
+			IdentificationType: util.Ptr(model.IdentificationTypeTypeUserrfidtag),
+		},
+	})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.SetIdentifications([]model.IdentificationDataType{
+		{
+			IdentificationValue: util.Ptr(model.IdentificationValueType("04A2B3C4")),
+		},
+	})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	id := s.sut.AddRfidTag("04A2B3C4")
+	assert.NotNil(s.T(), id)
+
+	// the existing identifications are replaced
+	err = s.sut.SetIdentifications([]model.IdentificationDataType{
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(5)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeEui48),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("00:1a:2b:3c:4d:5e")),
+		},
+		{
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeEui48),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("00:1a:2b:3c:4d:5e")),
+		},
+		{
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeUserrfidtag),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("04A2B3C5")),
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	data, err := s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.IdentificationDataType{
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(0)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeEui48),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("00:1a:2b:3c:4d:5e")),
+		},
+		{
+			IdentificationId:    util.Ptr(model.IdentificationIdType(1)),
+			IdentificationType:  util.Ptr(model.IdentificationTypeTypeUserrfidtag),
+			IdentificationValue: util.Ptr(model.IdentificationValueType("04A2B3C5")),
+		},
+	}, data)
+
+	err = s.sut.SetIdentifications(nil)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
+
+func (s *IdentificationSuite) Test_Remove() {
+	err := s.sut.RemoveIdentification(0)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.RemoveIdentificationsOfType(model.IdentificationTypeTypeUserrfidtag)
+	assert.NotNil(s.T(), err)
+
+	macId := s.sut.AddMacAddress("00:1a:2b:3c:4d:5e")
+	assert.NotNil(s.T(), macId)
+	rfidId := s.sut.AddRfidTag("04A2B3C4")
+	assert.NotNil(s.T(), rfidId)
+	otherRfidId := s.sut.AddRfidTag("04A2B3C5")
+	assert.NotNil(s.T(), otherRfidId)
+
+	err = s.sut.RemoveIdentification(10)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.RemoveIdentification(*macId)
+	assert.Nil(s.T(), err)
+
+	data, err := s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+
+	err = s.sut.RemoveIdentificationsOfType(model.IdentificationTypeTypeEui48)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.RemoveIdentificationsOfType(model.IdentificationTypeTypeUserrfidtag)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	// ids of removed identifications are reused
+	macId = s.sut.AddMacAddress("00:1a:2b:3c:4d:5e")
+	assert.NotNil(s.T(), macId)
+	assert.Equal(s.T(), model.IdentificationIdType(0), *macId)
+
+	s.sut.ClearIdentifications()
+
+	data, err = s.sut.GetDataForFilter(model.IdentificationDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+}
@@ -0,0 +1,601 @@
+package server
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type IncentiveTable struct {
+	*Feature
+
+	*internal.IncentiveTableCommon
+}
+
+func NewIncentiveTable(localEntity spineapi.EntityLocalInterface) (*IncentiveTable, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeIncentiveTable, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &IncentiveTable{
+		Feature:              feature,
+		IncentiveTableCommon: internal.NewLocalIncentiveTable(feature.featureLocal),
+	}
+
+	return i, nil
+}
+
+var _ api.IncentiveTableServerInterface = (*IncentiveTable)(nil)
+
+// Add a new tariff description and return the tariffId
+//
+// NOTE: the tariffId may not be provided
+//
+// will return nil if the data set could not be added
+func (i *IncentiveTable) AddTariffDescription(
+	description model.TariffDescriptionDataType,
+) *model.TariffIdType {
+	if description.TariffId != nil {
+		return nil
+	}
+
+	data := i.descriptions()
+
+	maxId := model.TariffIdType(0)
+
+	for _, item := range data {
+		if item.TariffDescription != nil && item.TariffDescription.TariffId != nil &&
+			*item.TariffDescription.TariffId >= maxId {
+			maxId = *item.TariffDescription.TariffId + 1
+		}
+	}
+
+	tariffId := util.Ptr(maxId)
+	description.TariffId = tariffId
+
+	data = append(data, model.IncentiveTableDescriptionType{
+		TariffDescription: &description,
+	})
+
+	if err := i.setDescriptions(data); err != nil {
+		return nil
+	}
+
+	return tariffId
+}
+
+// Update the description of a tariff, the tiers of the tariff are kept
+//
+// NOTE: the tariffId has to be provided
+//
+// Will return an error if the tariff does not exist or the data set could not be updated
+func (i *IncentiveTable) UpdateTariffDescription(description model.TariffDescriptionDataType) error {
+	if description.TariffId == nil {
+		return errors.New("missing id data")
+	}
+
+	data := i.descriptions()
+
+	index := tariffIndex(data, *description.TariffId)
+	if index < 0 {
+		return api.ErrDataNotAvailable
+	}
+
+	data[index].TariffDescription = &description
+
+	return i.setDescriptions(data)
+}
+
+// Replace the tiers of a tariff, including their boundaries and incentives
+//
+// Will return an error if the tariff does not exist, a tier, boundary or incentive
+// has no id, or the data set could not be updated
+func (i *IncentiveTable) SetTiers(
+	tariffId model.TariffIdType,
+	tiers []model.IncentiveTableDescriptionTierType,
+) error {
+	for _, tier := range tiers {
+		if tier.TierDescription == nil || tier.TierDescription.TierId == nil {
+			return errors.New("missing id data")
+		}
+
+		for _, boundary := range tier.BoundaryDescription {
+			if boundary.BoundaryId == nil {
+				return errors.New("missing id data")
+			}
+		}
+
+		for _, incentive := range tier.IncentiveDescription {
+			if incentive.IncentiveId == nil {
+				return errors.New("missing id data")
+			}
+		}
+	}
+
+	data := i.descriptions()
+
+	index := tariffIndex(data, tariffId)
+	if index < 0 {
+		return api.ErrDataNotAvailable
+	}
+
+	data[index].Tier = tiers
+
+	return i.setDescriptions(data)
+}
+
+// Add a new tier to a tariff and return the tierId
+//
+// NOTE: the tierId may not be provided
+//
+// will return nil if the tariff does not exist or the data set could not be added
+func (i *IncentiveTable) AddTier(
+	tariffId model.TariffIdType,
+	description model.TierDescriptionDataType,
+) *model.TierIdType {
+	if description.TierId != nil {
+		return nil
+	}
+
+	data := i.descriptions()
+
+	index := tariffIndex(data, tariffId)
+	if index < 0 {
+		return nil
+	}
+
+	maxId := model.TierIdType(0)
+
+	for _, tier := range data[index].Tier {
+		if tier.TierDescription != nil && tier.TierDescription.TierId != nil &&
+			*tier.TierDescription.TierId >= maxId {
+			maxId = *tier.TierDescription.TierId + 1
+		}
+	}
+
+	tierId := util.Ptr(maxId)
+	description.TierId = tierId
+
+	// the copy still references the current data
+	data[index].Tier = append(slices.Clone(data[index].Tier), model.IncentiveTableDescriptionTierType{
+		TierDescription: &description,
+	})
+
+	if err := i.setDescriptions(data); err != nil {
+		return nil
+	}
+
+	return tierId
+}
+
+// Add a new boundary to a tier of a tariff and return the boundaryId
+//
+// NOTE: the boundaryId may not be provided
+//
+// will return nil if the tier does not exist or the data set could not be added
+func (i *IncentiveTable) AddBoundary(
+	tariffId model.TariffIdType,
+	tierId model.TierIdType,
+	description model.TierBoundaryDescriptionDataType,
+) *model.TierBoundaryIdType {
+	if description.BoundaryId != nil {
+		return nil
+	}
+
+	data := i.descriptions()
+
+	index, tierIndex := tierIndex(data, tariffId, tierId)
+	if tierIndex < 0 {
+		return nil
+	}
+
+	// the ids are unique within a tariff
+	maxId := model.TierBoundaryIdType(0)
+
+	for _, tier := range data[index].Tier {
+		for _, boundary := range tier.BoundaryDescription {
+			if boundary.BoundaryId != nil && *boundary.BoundaryId >= maxId {
+				maxId = *boundary.BoundaryId + 1
+			}
+		}
+	}
+
+	boundaryId := util.Ptr(maxId)
+	description.BoundaryId = boundaryId
+
+	// the copy still references the current data
+	tiers := slices.Clone(data[index].Tier)
+	tiers[tierIndex].BoundaryDescription = append(slices.Clone(tiers[tierIndex].BoundaryDescription), description)
+	data[index].Tier = tiers
+
+	if err := i.setDescriptions(data); err != nil {
+		return nil
+	}
+
+	return boundaryId
+}
+
+// Add a new incentive to a tier of a tariff and return the incentiveId
+//
+// NOTE: the incentiveId may not be provided
+//
+// will return nil if the tier does not exist or the data set could not be added
+func (i *IncentiveTable) AddIncentive(
+	tariffId model.TariffIdType,
+	tierId model.TierIdType,
+	description model.IncentiveDescriptionDataType,
+) *model.IncentiveIdType {
+	if description.IncentiveId != nil {
+		return nil
+	}
+
+	data := i.descriptions()
+
+	index, tierIndex := tierIndex(data, tariffId, tierId)
+	if tierIndex < 0 {
+		return nil
+	}
+
+	// the ids are unique within a tariff
+	maxId := model.IncentiveIdType(0)
+
+	for _, tier := range data[index].Tier {
+		for _, incentive := range tier.IncentiveDescription {
+			if incentive.IncentiveId != nil && *incentive.IncentiveId >= maxId {
+				maxId = *incentive.IncentiveId + 1
+			}
+		}
+	}
+
+	incentiveId := util.Ptr(maxId)
+	description.IncentiveId = incentiveId
+
+	// the copy still references the current data
+	tiers := slices.Clone(data[index].Tier)
+	tiers[tierIndex].IncentiveDescription = append(slices.Clone(tiers[tierIndex].IncentiveDescription), description)
+	data[index].Tier = tiers
+
+	if err := i.setDescriptions(data); err != nil {
+		return nil
+	}
+
+	return incentiveId
+}
+
+// Set the constraints of the tariffs, replacing all existing constraints
+//
+// NOTE: the tariffId has to be provided for each item
+//
+// Will return an error if a tariff does not exist or the data set could not be updated
+func (i *IncentiveTable) SetConstraints(data []model.IncentiveTableConstraintsType) error {
+	descriptions := i.descriptions()
+
+	for _, item := range data {
+		if item.Tariff == nil || item.Tariff.TariffId == nil {
+			return errors.New("missing id data")
+		}
+
+		if tariffIndex(descriptions, *item.Tariff.TariffId) < 0 {
+			return api.ErrDataNotAvailable
+		}
+	}
+
+	datalist := &model.IncentiveTableConstraintsDataType{
+		IncentiveTableConstraints: data,
+	}
+
+	if err := i.featureLocal.UpdateData(model.FunctionTypeIncentiveTableConstraintsData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Set the incentive slots of a tariff, replacing the existing slots of the tariff
+//
+// Will return an error if the tariff does not exist, the slots do not match
+// the tariff descriptions or the data set could not be updated
+func (i *IncentiveTable) SetIncentiveSlots(
+	tariffId model.TariffIdType,
+	slots []model.IncentiveTableIncentiveSlotType,
+) error {
+	table := model.IncentiveTableType{
+		Tariff: &model.TariffDataType{
+			TariffId: util.Ptr(tariffId),
+		},
+		IncentiveSlot: slots,
+	}
+
+	if err := i.validateIncentiveTable(table, false); err != nil {
+		return err
+	}
+
+	current, err := i.GetData()
+	if err != nil {
+		current = nil
+	}
+
+	var data []model.IncentiveTableType
+	for _, item := range current {
+		if item.Tariff != nil && item.Tariff.TariffId != nil && *item.Tariff.TariffId == tariffId {
+			continue
+		}
+		data = append(data, item)
+	}
+	data = append(data, table)
+
+	datalist := &model.IncentiveTableDataType{
+		IncentiveTable: data,
+	}
+
+	if err := i.featureLocal.UpdateData(model.FunctionTypeIncentiveTableData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Check written tariff descriptions against the current descriptions and constraints
+//
+// Only existing and writeable tariffs may be written, and the number of tiers,
+// boundaries and incentives has to match the tariff constraints
+//
+// This is intended to be used in a write approval callback
+func (i *IncentiveTable) ValidateDescriptionsWrite(data *model.IncentiveTableDescriptionDataType) error {
+	if data == nil || len(data.IncentiveTableDescription) == 0 {
+		return errors.New("no tariff provided")
+	}
+
+	descriptions := i.descriptions()
+
+	for _, item := range data.IncentiveTableDescription {
+		if item.TariffDescription == nil || item.TariffDescription.TariffId == nil {
+			return errors.New("missing tariff id")
+		}
+
+		tariffId := *item.TariffDescription.TariffId
+
+		index := tariffIndex(descriptions, tariffId)
+		if index < 0 {
+			return errors.New("unknown tariff")
+		}
+
+		current := descriptions[index].TariffDescription
+		if current.TariffWriteable == nil || !*current.TariffWriteable {
+			return errors.New("tariff is not writeable")
+		}
+
+		constraints := i.tariffConstraints(tariffId)
+
+		var boundaryCount int
+		for _, tier := range item.Tier {
+			if tier.TierDescription == nil || tier.TierDescription.TierId == nil {
+				return errors.New("missing tier id")
+			}
+
+			if constraints != nil && constraints.MaxBoundariesPerTier != nil &&
+				len(tier.BoundaryDescription) > int(*constraints.MaxBoundariesPerTier) {
+				return errors.New("too many boundaries per tier")
+			}
+
+			if constraints != nil && constraints.MaxIncentivesPerTier != nil &&
+				len(tier.IncentiveDescription) > int(*constraints.MaxIncentivesPerTier) {
+				return errors.New("too many incentives per tier")
+			}
+
+			boundaryCount += len(tier.BoundaryDescription)
+		}
+
+		if constraints == nil {
+			continue
+		}
+
+		if constraints.MaxTiersPerTariff != nil && len(item.Tier) > int(*constraints.MaxTiersPerTariff) {
+			return errors.New("too many tiers per tariff")
+		}
+
+		if constraints.MaxBoundariesPerTariff != nil && boundaryCount > int(*constraints.MaxBoundariesPerTariff) {
+			return errors.New("too many boundaries per tariff")
+		}
+	}
+
+	return nil
+}
+
+// Check written incentives against the current descriptions and constraints
+//
+// Only described tariffs, tiers, boundaries and incentives may be used, and the
+// number of slots has to match the incentive slot constraints of the tariff
+//
+// This is intended to be used in a write approval callback
+func (i *IncentiveTable) ValidateIncentivesWrite(data *model.IncentiveTableDataType) error {
+	if data == nil || len(data.IncentiveTable) == 0 {
+		return errors.New("no incentives provided")
+	}
+
+	for _, item := range data.IncentiveTable {
+		if err := i.validateIncentiveTable(item, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Add a callback which is invoked for incoming writes of incentive table data
+//
+// The callback has to approve or deny each write using ApproveOrDenyWrite,
+// otherwise the write is denied once the approval timeout is reached
+func (i *IncentiveTable) AddWriteApprovalCallback(function spineapi.WriteApprovalCallbackFunc) error {
+	return i.featureLocal.AddWriteApprovalCallback(function)
+}
+
+// Approve or deny an incoming write
+//
+// The write is approved if err is nil, otherwise it is denied
+// with the error message as description
+func (i *IncentiveTable) ApproveOrDenyWrite(msg *spineapi.Message, err error) {
+	if msg == nil || msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil {
+		return
+	}
+
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if err != nil {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(err.Error()))
+	}
+
+	i.featureLocal.ApproveOrDenyWrite(msg, result)
+}
+
+// return all tariff descriptions
+func (i *IncentiveTable) descriptions() []model.IncentiveTableDescriptionType {
+	data, err := i.GetDescriptionsForFilter(model.TariffDescriptionDataType{})
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// store the tariff descriptions
+//
+// the data type does not support partial updates, so the complete list has to be set
+func (i *IncentiveTable) setDescriptions(data []model.IncentiveTableDescriptionType) error {
+	datalist := &model.IncentiveTableDescriptionDataType{
+		IncentiveTableDescription: data,
+	}
+
+	if err := i.featureLocal.UpdateData(model.FunctionTypeIncentiveTableDescriptionData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// return the overall constraints of a tariff, nil if none are set
+func (i *IncentiveTable) tariffConstraints(tariffId model.TariffIdType) *model.TariffOverallConstraintsDataType {
+	return i.constraintsForTariff(tariffId, func(item model.IncentiveTableConstraintsType) bool {
+		return item.TariffConstraints != nil
+	}).TariffConstraints
+}
+
+// return the incentive slot constraints of a tariff, nil if none are set
+func (i *IncentiveTable) slotConstraints(tariffId model.TariffIdType) *model.TimeTableConstraintsDataType {
+	return i.constraintsForTariff(tariffId, func(item model.IncentiveTableConstraintsType) bool {
+		return item.IncentiveSlotConstraints != nil
+	}).IncentiveSlotConstraints
+}
+
+// return the first constraints of a tariff matching the check
+func (i *IncentiveTable) constraintsForTariff(
+	tariffId model.TariffIdType,
+	check func(model.IncentiveTableConstraintsType) bool,
+) model.IncentiveTableConstraintsType {
+	constraints, err := i.GetConstraints()
+	if err != nil {
+		return model.IncentiveTableConstraintsType{}
+	}
+
+	for _, item := range constraints {
+		if item.Tariff != nil && item.Tariff.TariffId != nil &&
+			*item.Tariff.TariffId == tariffId && check(item) {
+			return item
+		}
+	}
+
+	return model.IncentiveTableConstraintsType{}
+}
+
+// check incentives of a tariff against its description and constraints
+func (i *IncentiveTable) validateIncentiveTable(data model.IncentiveTableType, checkConstraints bool) error {
+	if data.Tariff == nil || data.Tariff.TariffId == nil {
+		return errors.New("missing tariff id")
+	}
+
+	tariffId := *data.Tariff.TariffId
+
+	descriptions := i.descriptions()
+	index := tariffIndex(descriptions, tariffId)
+	if index < 0 {
+		return errors.New("unknown tariff")
+	}
+	description := descriptions[index]
+
+	if constraints := i.slotConstraints(tariffId); checkConstraints && constraints != nil {
+		if constraints.SlotCountMin != nil && len(data.IncentiveSlot) < int(*constraints.SlotCountMin) {
+			return errors.New("too few slots provided")
+		}
+
+		if constraints.SlotCountMax != nil && len(data.IncentiveSlot) > int(*constraints.SlotCountMax) {
+			return errors.New("too many slots provided")
+		}
+	}
+
+	for _, slot := range data.IncentiveSlot {
+		for _, tier := range slot.Tier {
+			if tier.Tier == nil || tier.Tier.TierId == nil {
+				return errors.New("missing tier id")
+			}
+
+			tierDescIndex := slices.IndexFunc(description.Tier, func(item model.IncentiveTableDescriptionTierType) bool {
+				return item.TierDescription != nil && item.TierDescription.TierId != nil &&
+					*item.TierDescription.TierId == *tier.Tier.TierId
+			})
+			if tierDescIndex < 0 {
+				return errors.New("unknown tier")
+			}
+			tierDesc := description.Tier[tierDescIndex]
+
+			for _, boundary := range tier.Boundary {
+				if boundary.BoundaryId == nil ||
+					!slices.ContainsFunc(tierDesc.BoundaryDescription, func(item model.TierBoundaryDescriptionDataType) bool {
+						return item.BoundaryId != nil && *item.BoundaryId == *boundary.BoundaryId
+					}) {
+					return errors.New("unknown boundary")
+				}
+			}
+
+			for _, incentive := range tier.Incentive {
+				if incentive.IncentiveId == nil ||
+					!slices.ContainsFunc(tierDesc.IncentiveDescription, func(item model.IncentiveDescriptionDataType) bool {
+						return item.IncentiveId != nil && *item.IncentiveId == *incentive.IncentiveId
+					}) {
+					return errors.New("unknown incentive")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// return the index of a tariff in the descriptions, -1 if it does not exist
+func tariffIndex(data []model.IncentiveTableDescriptionType, tariffId model.TariffIdType) int {
+	return slices.IndexFunc(data, func(item model.IncentiveTableDescriptionType) bool {
+		return item.TariffDescription != nil && item.TariffDescription.TariffId != nil &&
+			*item.TariffDescription.TariffId == tariffId
+	})
+}
+
+// return the index of a tariff and the index of a tier in its tiers, -1 if they do not exist
+func tierIndex(data []model.IncentiveTableDescriptionType, tariffId model.TariffIdType, tierId model.TierIdType) (int, int) {
+	index := tariffIndex(data, tariffId)
+	if index < 0 {
+		return -1, -1
+	}
+
+	return index, slices.IndexFunc(data[index].Tier, func(item model.IncentiveTableDescriptionTierType) bool {
+		return item.TierDescription != nil && item.TierDescription.TierId != nil &&
+			*item.TierDescription.TierId == tierId
+	})
+}
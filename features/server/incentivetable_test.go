@@ -0,0 +1,489 @@
+package server_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestIncentiveTableSuite(t *testing.T) {
+	suite.Run(t, new(IncentiveTableSuite))
+}
+
+type IncentiveTableSuite struct {
+	suite.Suite
+
+	sut *server.IncentiveTable
+
+	service api.ServiceInterface
+
+	localEntity  spineapi.EntityLocalInterface
+	remoteDevice spineapi.DeviceRemoteInterface
+}
+
+func (s *IncentiveTableSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	s.remoteDevice, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewIncentiveTable(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewIncentiveTable(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+// add a writeable tariff with one tier, boundary and incentive
+func (s *IncentiveTableSuite) addTariff() model.TariffIdType {
+	tariffId := s.sut.AddTariffDescription(model.TariffDescriptionDataType{
+		TariffWriteable: util.Ptr(true),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeSimpleIncentiveTable),
+	})
+	assert.NotNil(s.T(), tariffId)
+
+	tierId := s.sut.AddTier(*tariffId, model.TierDescriptionDataType{
+		TierType: util.Ptr(model.TierTypeTypeDynamicCost),
+	})
+	assert.NotNil(s.T(), tierId)
+
+	boundaryId := s.sut.AddBoundary(*tariffId, *tierId, model.TierBoundaryDescriptionDataType{
+		BoundaryType: util.Ptr(model.TierBoundaryTypeTypePowerBoundary),
+		BoundaryUnit: util.Ptr(model.UnitOfMeasurementTypeW),
+	})
+	assert.NotNil(s.T(), boundaryId)
+
+	incentiveId := s.sut.AddIncentive(*tariffId, *tierId, model.IncentiveDescriptionDataType{
+		IncentiveType: util.Ptr(model.IncentiveTypeTypeAbsoluteCost),
+		Currency:      util.Ptr(model.CurrencyTypeEur),
+	})
+	assert.NotNil(s.T(), incentiveId)
+
+	return *tariffId
+}
+
+func (s *IncentiveTableSuite) Test_Description() {
+	data, err := s.sut.GetDescriptionsForFilter(model.TariffDescriptionDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	tariffId := s.sut.AddTariffDescription(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(model.TariffIdType(5)),
+	})
+	assert.Nil(s.T(), tariffId)
+
+	tierId := s.sut.AddTier(0, model.TierDescriptionDataType{})
+	assert.Nil(s.T(), tierId)
+
+	boundaryId := s.sut.AddBoundary(0, 0, model.TierBoundaryDescriptionDataType{})
+	assert.Nil(s.T(), boundaryId)
+
+	incentiveId := s.sut.AddIncentive(0, 0, model.IncentiveDescriptionDataType{})
+	assert.Nil(s.T(), incentiveId)
+
+	firstId := s.addTariff()
+	assert.Equal(s.T(), model.TariffIdType(0), firstId)
+
+	tariffId = s.sut.AddTariffDescription(model.TariffDescriptionDataType{})
+	assert.NotNil(s.T(), tariffId)
+	assert.Equal(s.T(), model.TariffIdType(1), *tariffId)
+
+	tierId = s.sut.AddTier(firstId, model.TierDescriptionDataType{TierId: util.Ptr(model.TierIdType(1))})
+	assert.Nil(s.T(), tierId)
+	tierId = s.sut.AddTier(firstId, model.TierDescriptionDataType{})
+	assert.NotNil(s.T(), tierId)
+	assert.Equal(s.T(), model.TierIdType(1), *tierId)
+
+	boundaryId = s.sut.AddBoundary(firstId, 5, model.TierBoundaryDescriptionDataType{})
+	assert.Nil(s.T(), boundaryId)
+	boundaryId = s.sut.AddBoundary(firstId, *tierId, model.TierBoundaryDescriptionDataType{
+		BoundaryId: util.Ptr(model.TierBoundaryIdType(1)),
+	})
+	assert.Nil(s.T(), boundaryId)
+	boundaryId = s.sut.AddBoundary(firstId, *tierId, model.TierBoundaryDescriptionDataType{})
+	assert.NotNil(s.T(), boundaryId)
+	assert.Equal(s.T(), model.TierBoundaryIdType(1), *boundaryId)
+
+	incentiveId = s.sut.AddIncentive(firstId, *tierId, model.IncentiveDescriptionDataType{
+		IncentiveId: util.Ptr(model.IncentiveIdType(1)),
+	})
+	assert.Nil(s.T(), incentiveId)
+	incentiveId = s.sut.AddIncentive(firstId, *tierId, model.IncentiveDescriptionDataType{})
+	assert.NotNil(s.T(), incentiveId)
+	assert.Equal(s.T(), model.IncentiveIdType(1), *incentiveId)
+
+	data, err = s.sut.GetDescriptionsForFilter(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(firstId),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 2, len(data[0].Tier))
+	assert.Equal(s.T(), 1, len(data[0].Tier[0].BoundaryDescription))
+	assert.Equal(s.T(), 1, len(data[0].Tier[0].IncentiveDescription))
+	assert.Equal(s.T(), 1, len(data[0].Tier[1].BoundaryDescription))
+	assert.Equal(s.T(), 1, len(data[0].Tier[1].IncentiveDescription))
+
+	err = s.sut.UpdateTariffDescription(model.TariffDescriptionDataType{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateTariffDescription(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(model.TariffIdType(10)),
+	})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateTariffDescription(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(firstId),
+		Label:    util.Ptr(model.LabelType("tariff")),
+	})
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetDescriptionsForFilter(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(firstId),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.LabelType("tariff"), *data[0].TariffDescription.Label)
+	assert.Equal(s.T(), 2, len(data[0].Tier))
+
+	err = s.sut.SetTiers(firstId, []model.IncentiveTableDescriptionTierType{{}})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetTiers(firstId, []model.IncentiveTableDescriptionTierType{
+		{
+			TierDescription:     &model.TierDescriptionDataType{TierId: util.Ptr(model.TierIdType(0))},
+			BoundaryDescription: []model.TierBoundaryDescriptionDataType{{}},
+		},
+	})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetTiers(firstId, []model.IncentiveTableDescriptionTierType{
+		{
+			TierDescription:      &model.TierDescriptionDataType{TierId: util.Ptr(model.TierIdType(0))},
+			IncentiveDescription: []model.IncentiveDescriptionDataType{{}},
+		},
+	})
+	assert.NotNil(s.T(), err)
+
+	tiers := []model.IncentiveTableDescriptionTierType{
+		{
+			TierDescription: &model.TierDescriptionDataType{TierId: util.Ptr(model.TierIdType(3))},
+		},
+	}
+	err = s.sut.SetTiers(10, tiers)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetTiers(firstId, tiers)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetDescriptionsForFilter(model.TariffDescriptionDataType{
+		TariffId: util.Ptr(firstId),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), tiers, data[0].Tier)
+}
+
+func (s *IncentiveTableSuite) Test_Constraints() {
+	data, err := s.sut.GetConstraints()
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	constraints := []model.IncentiveTableConstraintsType{{}}
+	err = s.sut.SetConstraints(constraints)
+	assert.NotNil(s.T(), err)
+
+	constraints = []model.IncentiveTableConstraintsType{
+		{
+			Tariff: &model.TariffDataType{TariffId: util.Ptr(model.TariffIdType(0))},
+			IncentiveSlotConstraints: &model.TimeTableConstraintsDataType{
+				SlotCountMax: util.Ptr(model.TimeSlotCountType(24)),
+			},
+		},
+	}
+	err = s.sut.SetConstraints(constraints)
+	assert.NotNil(s.T(), err)
+
+	s.addTariff()
+
+	err = s.sut.SetConstraints(constraints)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetConstraints()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), constraints, data)
+}
+
+func (s *IncentiveTableSuite) Test_IncentiveSlots() {
+	data, err := s.sut.GetData()
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	slots := []model.IncentiveTableIncentiveSlotType{
+		{
+			TimeInterval: &model.TimeTableDataType{
+				StartTime: &model.AbsoluteOrRecurringTimeType{
+					DateTime: model.NewDateTimeTypeFromTime(time.Now()),
+				},
+			},
+			Tier: []model.IncentiveTableTierType{
+				{
+					Tier: &model.TierDataType{TierId: util.Ptr(model.TierIdType(0))},
+					Boundary: []model.TierBoundaryDataType{
+						{
+							BoundaryId:         util.Ptr(model.TierBoundaryIdType(0)),
+							LowerBoundaryValue: model.NewScaledNumberType(0),
+						},
+					},
+					Incentive: []model.IncentiveDataType{
+						{
+							IncentiveId: util.Ptr(model.IncentiveIdType(0)),
+							Value:       model.NewScaledNumberType(0.3),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err = s.sut.SetIncentiveSlots(0, slots)
+	assert.NotNil(s.T(), err)
+
+	tariffId := s.addTariff()
+	otherId := s.sut.AddTariffDescription(model.TariffDescriptionDataType{})
+	assert.NotNil(s.T(), otherId)
+
+	err = s.sut.SetIncentiveSlots(tariffId, slots)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetIncentiveSlots(*otherId, nil)
+	assert.Nil(s.T(), err)
+
+	// replaces the slots of the tariff
+	err = s.sut.SetIncentiveSlots(tariffId, slots)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+	assert.Equal(s.T(), *otherId, *data[0].Tariff.TariffId)
+	assert.Equal(s.T(), tariffId, *data[1].Tariff.TariffId)
+	assert.Equal(s.T(), slots, data[1].IncentiveSlot)
+
+	invalid := []model.IncentiveTableIncentiveSlotType{
+		{
+			Tier: []model.IncentiveTableTierType{{}},
+		},
+	}
+	err = s.sut.SetIncentiveSlots(tariffId, invalid)
+	assert.NotNil(s.T(), err)
+
+	invalid[0].Tier[0].Tier = &model.TierDataType{TierId: util.Ptr(model.TierIdType(5))}
+	err = s.sut.SetIncentiveSlots(tariffId, invalid)
+	assert.NotNil(s.T(), err)
+
+	invalid[0].Tier[0].Tier.TierId = util.Ptr(model.TierIdType(0))
+	invalid[0].Tier[0].Boundary = []model.TierBoundaryDataType{
+		{BoundaryId: util.Ptr(model.TierBoundaryIdType(5))},
+	}
+	err = s.sut.SetIncentiveSlots(tariffId, invalid)
+	assert.NotNil(s.T(), err)
+
+	invalid[0].Tier[0].Boundary = nil
+	invalid[0].Tier[0].Incentive = []model.IncentiveDataType{
+		{IncentiveId: util.Ptr(model.IncentiveIdType(5))},
+	}
+	err = s.sut.SetIncentiveSlots(tariffId, invalid)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *IncentiveTableSuite) Test_ValidateDescriptionsWrite() {
+	err := s.sut.ValidateDescriptionsWrite(nil)
+	assert.NotNil(s.T(), err)
+
+	data := &model.IncentiveTableDescriptionDataType{
+		IncentiveTableDescription: []model.IncentiveTableDescriptionType{{}},
+	}
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+
+	tier := model.IncentiveTableDescriptionTierType{
+		TierDescription: &model.TierDescriptionDataType{TierId: util.Ptr(model.TierIdType(0))},
+		BoundaryDescription: []model.TierBoundaryDescriptionDataType{
+			{BoundaryId: util.Ptr(model.TierBoundaryIdType(0))},
+			{BoundaryId: util.Ptr(model.TierBoundaryIdType(1))},
+		},
+		IncentiveDescription: []model.IncentiveDescriptionDataType{
+			{IncentiveId: util.Ptr(model.IncentiveIdType(0))},
+			{IncentiveId: util.Ptr(model.IncentiveIdType(1))},
+		},
+	}
+	data.IncentiveTableDescription[0] = model.IncentiveTableDescriptionType{
+		TariffDescription: &model.TariffDescriptionDataType{TariffId: util.Ptr(model.TariffIdType(0))},
+		Tier:              []model.IncentiveTableDescriptionTierType{tier},
+	}
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+
+	tariffId := s.addTariff()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.Nil(s.T(), err)
+
+	readOnlyId := s.sut.AddTariffDescription(model.TariffDescriptionDataType{})
+	assert.NotNil(s.T(), readOnlyId)
+	readOnly := &model.IncentiveTableDescriptionDataType{
+		IncentiveTableDescription: []model.IncentiveTableDescriptionType{
+			{
+				TariffDescription: &model.TariffDescriptionDataType{TariffId: readOnlyId},
+			},
+		},
+	}
+	err = s.sut.ValidateDescriptionsWrite(readOnly)
+	assert.NotNil(s.T(), err)
+
+	data.IncentiveTableDescription[0].Tier = []model.IncentiveTableDescriptionTierType{{}}
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+	data.IncentiveTableDescription[0].Tier = []model.IncentiveTableDescriptionTierType{tier, tier}
+
+	constraints := &model.TariffOverallConstraintsDataType{
+		MaxTiersPerTariff:      util.Ptr(model.TierCountType(3)),
+		MaxBoundariesPerTariff: util.Ptr(model.TierBoundaryCountType(9)),
+		MaxBoundariesPerTier:   util.Ptr(model.TierBoundaryCountType(3)),
+		MaxIncentivesPerTier:   util.Ptr(model.IncentiveCountType(3)),
+	}
+	setConstraints := func() {
+		err := s.sut.SetConstraints([]model.IncentiveTableConstraintsType{
+			{
+				Tariff:            &model.TariffDataType{TariffId: util.Ptr(tariffId)},
+				TariffConstraints: constraints,
+			},
+		})
+		assert.Nil(s.T(), err)
+	}
+
+	setConstraints()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.Nil(s.T(), err)
+
+	constraints.MaxTiersPerTariff = util.Ptr(model.TierCountType(1))
+	setConstraints()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+
+	constraints.MaxTiersPerTariff = util.Ptr(model.TierCountType(3))
+	constraints.MaxBoundariesPerTariff = util.Ptr(model.TierBoundaryCountType(3))
+	setConstraints()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+
+	constraints.MaxBoundariesPerTariff = util.Ptr(model.TierBoundaryCountType(9))
+	constraints.MaxBoundariesPerTier = util.Ptr(model.TierBoundaryCountType(1))
+	setConstraints()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+
+	constraints.MaxBoundariesPerTier = util.Ptr(model.TierBoundaryCountType(3))
+	constraints.MaxIncentivesPerTier = util.Ptr(model.IncentiveCountType(1))
+	setConstraints()
+	err = s.sut.ValidateDescriptionsWrite(data)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *IncentiveTableSuite) Test_ValidateIncentivesWrite() {
+	err := s.sut.ValidateIncentivesWrite(nil)
+	assert.NotNil(s.T(), err)
+
+	tariffId := s.addTariff()
+
+	data := &model.IncentiveTableDataType{
+		IncentiveTable: []model.IncentiveTableType{{}},
+	}
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.NotNil(s.T(), err)
+
+	slot := model.IncentiveTableIncentiveSlotType{
+		Tier: []model.IncentiveTableTierType{
+			{
+				Tier: &model.TierDataType{TierId: util.Ptr(model.TierIdType(0))},
+				Incentive: []model.IncentiveDataType{
+					{
+						IncentiveId: util.Ptr(model.IncentiveIdType(0)),
+						Value:       model.NewScaledNumberType(0.3),
+					},
+				},
+			},
+		},
+	}
+	data.IncentiveTable[0] = model.IncentiveTableType{
+		Tariff:        &model.TariffDataType{TariffId: util.Ptr(model.TariffIdType(5))},
+		IncentiveSlot: []model.IncentiveTableIncentiveSlotType{slot, slot},
+	}
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.NotNil(s.T(), err)
+
+	data.IncentiveTable[0].Tariff.TariffId = util.Ptr(tariffId)
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetConstraints([]model.IncentiveTableConstraintsType{
+		{
+			Tariff: &model.TariffDataType{TariffId: util.Ptr(tariffId)},
+			IncentiveSlotConstraints: &model.TimeTableConstraintsDataType{
+				SlotCountMin: util.Ptr(model.TimeSlotCountType(3)),
+				SlotCountMax: util.Ptr(model.TimeSlotCountType(4)),
+			},
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.NotNil(s.T(), err)
+
+	data.IncentiveTable[0].IncentiveSlot = []model.IncentiveTableIncentiveSlotType{slot, slot, slot, slot, slot}
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.NotNil(s.T(), err)
+
+	data.IncentiveTable[0].IncentiveSlot = []model.IncentiveTableIncentiveSlotType{slot, slot, slot}
+	err = s.sut.ValidateIncentivesWrite(data)
+	assert.Nil(s.T(), err)
+}
+
+func (s *IncentiveTableSuite) Test_WriteApproval() {
+	err := s.sut.AddWriteApprovalCallback(func(msg *spineapi.Message) {})
+	assert.Nil(s.T(), err)
+
+	s.sut.ApproveOrDenyWrite(nil, nil)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: util.Ptr(model.MsgCounterType(1)),
+		},
+		DeviceRemote: s.remoteDevice,
+	}
+	s.sut.ApproveOrDenyWrite(msg, nil)
+	s.sut.ApproveOrDenyWrite(msg, errors.New("invalid data"))
+}
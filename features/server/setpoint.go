@@ -0,0 +1,153 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type Setpoint struct {
+	*Feature
+
+	*internal.SetpointCommon
+}
+
+func NewSetpoint(localEntity spineapi.EntityLocalInterface) (*Setpoint, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeSetpoint, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Setpoint{
+		Feature:        feature,
+		SetpointCommon: internal.NewLocalSetpoint(feature.featureLocal),
+	}
+
+	return s, nil
+}
+
+var _ api.SetpointServerInterface = (*Setpoint)(nil)
+
+// Add a new description data set and return the setpointId
+//
+// NOTE: the setpointId may not be provided
+//
+// will return nil if the data set could not be added
+func (s *Setpoint) AddDescription(
+	description model.SetpointDescriptionDataType,
+) *model.SetpointIdType {
+	if description.SetpointId != nil {
+		return nil
+	}
+
+	data, err := s.GetDescriptionsForFilter(model.SetpointDescriptionDataType{})
+	if err != nil {
+		data = []model.SetpointDescriptionDataType{}
+	}
+
+	maxId := model.SetpointIdType(0)
+
+	for _, item := range data {
+		if item.SetpointId != nil && *item.SetpointId >= maxId {
+			maxId = *item.SetpointId + 1
+		}
+	}
+
+	setpointId := util.Ptr(maxId)
+	description.SetpointId = setpointId
+
+	partial := model.NewFilterTypePartial()
+	datalist := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{description},
+	}
+
+	if err := s.featureLocal.UpdateData(model.FunctionTypeSetpointDescriptionListData, datalist, partial, nil); err != nil {
+		return nil
+	}
+
+	return setpointId
+}
+
+// Set or update the constraints data set of a setpoint
+//
+// NOTE: the setpointId has to be provided
+//
+// Will return an error if the setpoint does not exist or the data set could not be updated
+func (s *Setpoint) UpdateConstraints(data model.SetpointConstraintsDataType) error {
+	if data.SetpointId == nil {
+		return errors.New("missing id data")
+	}
+
+	if _, err := s.GetDescriptionForId(*data.SetpointId); err != nil {
+		return err
+	}
+
+	// the constraints do not support partial updates, so the complete list has to be set
+	constraints, err := s.GetConstraintsForFilter(model.SetpointConstraintsDataType{})
+	if err != nil {
+		constraints = nil
+	}
+
+	var items []model.SetpointConstraintsDataType
+	for _, item := range constraints {
+		if item.SetpointId != nil && *item.SetpointId == *data.SetpointId {
+			continue
+		}
+		items = append(items, item)
+	}
+	items = append(items, data)
+
+	datalist := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: items,
+	}
+
+	if err := s.featureLocal.UpdateData(model.FunctionTypeSetpointConstraintsListData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Set or update the data set of a setpoint
+// Elements provided in deleteElements will be removed from the data set before the update
+//
+// NOTE: the setpointId has to be provided
+//
+// Will return an error if the setpoint does not exist or the data set could not be updated
+func (s *Setpoint) UpdateSetpoint(
+	data model.SetpointDataType,
+	deleteElements *model.SetpointDataElementsType,
+) error {
+	if data.SetpointId == nil {
+		return errors.New("missing id data")
+	}
+
+	if _, err := s.GetDescriptionForId(*data.SetpointId); err != nil {
+		return err
+	}
+
+	datalist := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{data},
+	}
+
+	partial := model.NewFilterTypePartial()
+	var deleteFilter *model.FilterType
+	if deleteElements != nil {
+		deleteFilter = &model.FilterType{
+			SetpointListDataSelectors: &model.SetpointListDataSelectorsType{
+				SetpointId: data.SetpointId,
+			},
+			SetpointDataElements: deleteElements,
+		}
+	}
+
+	if err := s.featureLocal.UpdateData(model.FunctionTypeSetpointListData, datalist, partial, deleteFilter); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSetpointSuite(t *testing.T) {
+	suite.Run(t, new(SetpointSuite))
+}
+
+type SetpointSuite struct {
+	suite.Suite
+
+	sut *server.Setpoint
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *SetpointSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	_, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewSetpoint(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewSetpoint(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+func (s *SetpointSuite) Test_Description() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	data, err := s.sut.GetDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	desc := model.SetpointDescriptionDataType{
+		SetpointId:   util.Ptr(model.SetpointIdType(5)),
+		SetpointType: filter.SetpointType,
+	}
+	setpointId := s.sut.AddDescription(desc)
+	assert.Nil(s.T(), setpointId)
+
+	desc.SetpointId = nil
+	setpointId = s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), setpointId)
+	assert.Equal(s.T(), model.SetpointIdType(0), *setpointId)
+
+	data, err = s.sut.GetDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), *setpointId, *data[0].SetpointId)
+
+	desc.SetpointType = util.Ptr(model.SetpointTypeTypeValueRelative)
+	setpointId = s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), setpointId)
+	assert.Equal(s.T(), model.SetpointIdType(1), *setpointId)
+
+	result, err := s.sut.GetDescriptionForId(*setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.SetpointTypeTypeValueRelative, *result.SetpointType)
+}
+
+func (s *SetpointSuite) Test_Constraints() {
+	data := model.SetpointConstraintsDataType{
+		SetpointRangeMin: model.NewScaledNumberType(20),
+		SetpointRangeMax: model.NewScaledNumberType(60),
+	}
+
+	err := s.sut.UpdateConstraints(data)
+	assert.NotNil(s.T(), err)
+
+	data.SetpointId = util.Ptr(model.SetpointIdType(0))
+	err = s.sut.UpdateConstraints(data)
+	assert.NotNil(s.T(), err)
+
+	desc := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+	setpointId := s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), setpointId)
+	setpointId2 := s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), setpointId2)
+
+	err = s.sut.UpdateConstraints(data)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateConstraints(model.SetpointConstraintsDataType{
+		SetpointId:       setpointId2,
+		SetpointRangeMin: model.NewScaledNumberType(10),
+		SetpointRangeMax: model.NewScaledNumberType(30),
+	})
+	assert.Nil(s.T(), err)
+
+	data = model.SetpointConstraintsDataType{
+		SetpointId:       setpointId,
+		SetpointRangeMin: model.NewScaledNumberType(30),
+		SetpointRangeMax: model.NewScaledNumberType(70),
+		SetpointStepSize: model.NewScaledNumberType(1),
+	}
+	err = s.sut.UpdateConstraints(data)
+	assert.Nil(s.T(), err)
+
+	list, err := s.sut.GetConstraintsForFilter(model.SetpointConstraintsDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(list))
+
+	result, err := s.sut.GetConstraintsForId(*setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 30.0, result.SetpointRangeMin.GetValue())
+	assert.Equal(s.T(), 1.0, result.SetpointStepSize.GetValue())
+
+	result, err = s.sut.GetConstraintsForId(*setpointId2)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, result.SetpointRangeMin.GetValue())
+}
+
+func (s *SetpointSuite) Test_Setpoint() {
+	data := model.SetpointDataType{
+		Value:                model.NewScaledNumberType(50),
+		IsSetpointChangeable: util.Ptr(true),
+		IsSetpointActive:     util.Ptr(true),
+		TimePeriod:           model.NewTimePeriodTypeWithRelativeEndTime(time.Hour),
+	}
+
+	err := s.sut.UpdateSetpoint(data, nil)
+	assert.NotNil(s.T(), err)
+
+	data.SetpointId = util.Ptr(model.SetpointIdType(0))
+	err = s.sut.UpdateSetpoint(data, nil)
+	assert.NotNil(s.T(), err)
+
+	setpointId := s.sut.AddDescription(model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	})
+	assert.NotNil(s.T(), setpointId)
+
+	err = s.sut.UpdateSetpoint(data, nil)
+	assert.Nil(s.T(), err)
+
+	result, err := s.sut.GetDataForId(*setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.0, result.Value.GetValue())
+	assert.NotNil(s.T(), result.TimePeriod)
+
+	data = model.SetpointDataType{
+		SetpointId: setpointId,
+		Value:      model.NewScaledNumberType(55),
+	}
+	deleteElements := &model.SetpointDataElementsType{
+		TimePeriod: &model.TimePeriodElementsType{},
+	}
+	err = s.sut.UpdateSetpoint(data, deleteElements)
+	assert.Nil(s.T(), err)
+
+	result, err = s.sut.GetDataForId(*setpointId)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 55.0, result.Value.GetValue())
+	assert.Nil(s.T(), result.TimePeriod)
+	assert.True(s.T(), *result.IsSetpointChangeable)
+}
@@ -0,0 +1,415 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type SmartEnergyManagementPs struct {
+	*Feature
+
+	*internal.SmartEnergyManagementPsCommon
+}
+
+func NewSmartEnergyManagementPs(localEntity spineapi.EntityLocalInterface) (*SmartEnergyManagementPs, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeSmartEnergyManagementPs, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	sem := &SmartEnergyManagementPs{
+		Feature:                       feature,
+		SmartEnergyManagementPsCommon: internal.NewLocalSmartEnergyManagementPs(feature.featureLocal),
+	}
+
+	return sem, nil
+}
+
+var _ api.SmartEnergyManagementPsServerInterface = (*SmartEnergyManagementPs)(nil)
+
+// Set the node schedule information
+//
+// Will return an error if the data set could not be updated
+func (s *SmartEnergyManagementPs) SetNodeScheduleInformation(data model.PowerSequenceNodeScheduleInformationDataType) error {
+	psData := s.dataCopy()
+	psData.NodeScheduleInformation = &data
+
+	return s.SetData(psData)
+}
+
+// Add a new alternative with the provided power sequences and return the alternativesId
+//
+// NOTE: the alternativesId and the sequenceIds may not be provided,
+// they are set automatically on all data sets of the power sequences
+//
+// will return nil if the data set could not be added
+func (s *SmartEnergyManagementPs) AddAlternative(
+	powerSequences []model.SmartEnergyManagementPsPowerSequenceType,
+) *model.AlternativesIdType {
+	if len(powerSequences) == 0 {
+		return nil
+	}
+
+	psData := s.dataCopy()
+
+	alternativesId := model.AlternativesIdType(0)
+	sequenceId := model.PowerSequenceIdType(0)
+	for _, alternative := range psData.Alternatives {
+		if alternative.Relation != nil && alternative.Relation.AlternativesId != nil &&
+			*alternative.Relation.AlternativesId >= alternativesId {
+			alternativesId = *alternative.Relation.AlternativesId + 1
+		}
+		for _, sequence := range alternative.PowerSequence {
+			if id := sequenceIdOf(sequence); id != nil && *id >= sequenceId {
+				sequenceId = *id + 1
+			}
+		}
+	}
+
+	alternative := model.SmartEnergyManagementPsAlternativesType{
+		Relation: &model.SmartEnergyManagementPsAlternativesRelationType{
+			AlternativesId: util.Ptr(alternativesId),
+		},
+	}
+	for _, sequence := range powerSequences {
+		if sequenceIdOf(sequence) != nil {
+			return nil
+		}
+
+		alternative.Relation.SequenceId = append(alternative.Relation.SequenceId, sequenceId)
+		alternative.PowerSequence = append(alternative.PowerSequence, sequenceWithId(sequence, sequenceId))
+		sequenceId++
+	}
+
+	psData.Alternatives = append(psData.Alternatives, alternative)
+
+	if err := s.SetData(psData); err != nil {
+		return nil
+	}
+
+	return util.Ptr(alternativesId)
+}
+
+// Remove an alternative including all of its power sequences
+//
+// Will return an error if the alternative does not exist or the data set could not be updated
+func (s *SmartEnergyManagementPs) RemoveAlternative(alternativesId model.AlternativesIdType) error {
+	psData := s.dataCopy()
+
+	var alternatives []model.SmartEnergyManagementPsAlternativesType
+	for _, alternative := range psData.Alternatives {
+		if alternative.Relation != nil && alternative.Relation.AlternativesId != nil &&
+			*alternative.Relation.AlternativesId == alternativesId {
+			continue
+		}
+		alternatives = append(alternatives, alternative)
+	}
+
+	if len(alternatives) == len(psData.Alternatives) {
+		return api.ErrDataNotAvailable
+	}
+
+	psData.Alternatives = alternatives
+
+	return s.SetData(psData)
+}
+
+// Set or update the state of a power sequence
+//
+// NOTE: the sequenceId has to be provided
+//
+// Will return an error if the power sequence does not exist or the data set could not be updated
+func (s *SmartEnergyManagementPs) UpdateSequenceState(data model.PowerSequenceStateDataType) error {
+	if data.SequenceId == nil {
+		return api.ErrMissingData
+	}
+
+	return s.updateSequence(*data.SequenceId, func(sequence *model.SmartEnergyManagementPsPowerSequenceType) {
+		sequence.State = &data
+	})
+}
+
+// Set or update the schedule of a power sequence
+//
+// NOTE: the sequenceId has to be provided
+//
+// Will return an error if the power sequence does not exist or the data set could not be updated
+func (s *SmartEnergyManagementPs) UpdateSequenceSchedule(data model.PowerSequenceScheduleDataType) error {
+	if data.SequenceId == nil {
+		return api.ErrMissingData
+	}
+
+	return s.updateSequence(*data.SequenceId, func(sequence *model.SmartEnergyManagementPsPowerSequenceType) {
+		sequence.Schedule = &data
+	})
+}
+
+// Set or update the schedule constraints of a power sequence
+//
+// NOTE: the sequenceId has to be provided
+//
+// Will return an error if the power sequence does not exist or the data set could not be updated
+func (s *SmartEnergyManagementPs) UpdateSequenceScheduleConstraints(data model.PowerSequenceScheduleConstraintsDataType) error {
+	if data.SequenceId == nil {
+		return api.ErrMissingData
+	}
+
+	return s.updateSequence(*data.SequenceId, func(sequence *model.SmartEnergyManagementPsPowerSequenceType) {
+		sequence.ScheduleConstraints = &data
+	})
+}
+
+// Replace the time slots of a power sequence
+//
+// NOTE: the sequenceId of the slots is set automatically
+//
+// Will return an error if the power sequence does not exist or the data set could not be updated
+func (s *SmartEnergyManagementPs) UpdateSequenceTimeSlots(
+	sequenceId model.PowerSequenceIdType,
+	slots []model.SmartEnergyManagementPsPowerTimeSlotType,
+) error {
+	return s.updateSequence(sequenceId, func(sequence *model.SmartEnergyManagementPsPowerSequenceType) {
+		sequence.PowerTimeSlot = timeSlotsWithId(slots, sequenceId)
+	})
+}
+
+// Apply the schedules of written data onto the current data set
+// and return the resulting complete data set, without storing it
+//
+// This is intended to be used in a write approval callback, as the
+// data type does not support partial updates, the result can be stored
+// using SetData. Relative start and end times are converted into absolute times.
+//
+// Will return an error if a schedule refers to an unknown power sequence
+// or a power sequence that can not be controlled remotely
+func (s *SmartEnergyManagementPs) ApplyScheduleWrite(
+	data *model.SmartEnergyManagementPsDataType,
+) (*model.SmartEnergyManagementPsDataType, error) {
+	if data == nil {
+		return nil, api.ErrMissingData
+	}
+
+	psData := s.dataCopy()
+
+	for _, alternative := range data.Alternatives {
+		for _, sequence := range alternative.PowerSequence {
+			if sequence.Schedule == nil {
+				continue
+			}
+
+			schedule := *sequence.Schedule
+			if schedule.SequenceId == nil {
+				return nil, api.ErrMissingData
+			}
+
+			item := findSequence(psData, *schedule.SequenceId)
+			if item == nil {
+				return nil, api.ErrDataNotAvailable
+			}
+
+			if item.State == nil || item.State.SequenceRemoteControllable == nil ||
+				!*item.State.SequenceRemoteControllable {
+				return nil, errors.New("power sequence is not remote controllable")
+			}
+
+			schedule.StartTime = absoluteTime(schedule.StartTime)
+			schedule.EndTime = absoluteTime(schedule.EndTime)
+			item.Schedule = &schedule
+		}
+	}
+
+	return psData, nil
+}
+
+// return a copy of the current data, which can be modified
+// without changing the stored data set
+//
+// NOTE: only the slices are copied, so pointers have to be
+// replaced and may not be written to
+func (s *SmartEnergyManagementPs) dataCopy() *model.SmartEnergyManagementPsDataType {
+	result := &model.SmartEnergyManagementPsDataType{}
+
+	data, err := s.GetData()
+	if err != nil {
+		return result
+	}
+
+	result.NodeScheduleInformation = data.NodeScheduleInformation
+	for _, alternative := range data.Alternatives {
+		item := model.SmartEnergyManagementPsAlternativesType{
+			Relation:      alternative.Relation,
+			PowerSequence: append([]model.SmartEnergyManagementPsPowerSequenceType(nil), alternative.PowerSequence...),
+		}
+		result.Alternatives = append(result.Alternatives, item)
+	}
+
+	return result
+}
+
+// Replace the complete data set
+//
+// subscribers are notified about the change
+//
+// Will return an error if the data set could not be updated
+func (s *SmartEnergyManagementPs) SetData(data *model.SmartEnergyManagementPsDataType) error {
+	if data == nil {
+		return api.ErrMissingData
+	}
+
+	if err := s.featureLocal.UpdateData(model.FunctionTypeSmartEnergyManagementPsData, data, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// update a power sequence of the data set using the provided function
+func (s *SmartEnergyManagementPs) updateSequence(
+	sequenceId model.PowerSequenceIdType,
+	update func(sequence *model.SmartEnergyManagementPsPowerSequenceType),
+) error {
+	psData := s.dataCopy()
+
+	sequence := findSequence(psData, sequenceId)
+	if sequence == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	update(sequence)
+
+	return s.SetData(psData)
+}
+
+// return the power sequence with the given id in the data set
+func findSequence(
+	data *model.SmartEnergyManagementPsDataType,
+	sequenceId model.PowerSequenceIdType,
+) *model.SmartEnergyManagementPsPowerSequenceType {
+	for i := range data.Alternatives {
+		for j := range data.Alternatives[i].PowerSequence {
+			sequence := &data.Alternatives[i].PowerSequence[j]
+			if id := sequenceIdOf(*sequence); id != nil && *id == sequenceId {
+				return sequence
+			}
+		}
+	}
+
+	return nil
+}
+
+// return the sequenceId of a power sequence, which is defined by its description
+func sequenceIdOf(sequence model.SmartEnergyManagementPsPowerSequenceType) *model.PowerSequenceIdType {
+	if sequence.Description == nil {
+		return nil
+	}
+
+	return sequence.Description.SequenceId
+}
+
+// return a copy of the power sequence with the sequenceId set on all its data sets
+func sequenceWithId(
+	sequence model.SmartEnergyManagementPsPowerSequenceType,
+	sequenceId model.PowerSequenceIdType,
+) model.SmartEnergyManagementPsPowerSequenceType {
+	id := util.Ptr(sequenceId)
+
+	description := model.PowerSequenceDescriptionDataType{}
+	if sequence.Description != nil {
+		description = *sequence.Description
+	}
+	description.SequenceId = id
+	sequence.Description = &description
+
+	if sequence.State != nil {
+		value := *sequence.State
+		value.SequenceId = id
+		sequence.State = &value
+	}
+	if sequence.Schedule != nil {
+		value := *sequence.Schedule
+		value.SequenceId = id
+		sequence.Schedule = &value
+	}
+	if sequence.ScheduleConstraints != nil {
+		value := *sequence.ScheduleConstraints
+		value.SequenceId = id
+		sequence.ScheduleConstraints = &value
+	}
+	if sequence.SchedulePreference != nil {
+		value := *sequence.SchedulePreference
+		value.SequenceId = id
+		sequence.SchedulePreference = &value
+	}
+	if sequence.OperatingConstraintsInterrupt != nil {
+		value := *sequence.OperatingConstraintsInterrupt
+		value.SequenceId = id
+		sequence.OperatingConstraintsInterrupt = &value
+	}
+	if sequence.OperatingConstraintsDuration != nil {
+		value := *sequence.OperatingConstraintsDuration
+		value.SequenceId = id
+		sequence.OperatingConstraintsDuration = &value
+	}
+	if sequence.OperatingConstraintsResumeImplication != nil {
+		value := *sequence.OperatingConstraintsResumeImplication
+		value.SequenceId = id
+		sequence.OperatingConstraintsResumeImplication = &value
+	}
+
+	sequence.PowerTimeSlot = timeSlotsWithId(sequence.PowerTimeSlot, sequenceId)
+
+	return sequence
+}
+
+// return a copy of the time slots with the sequenceId set on all their data sets
+func timeSlotsWithId(
+	slots []model.SmartEnergyManagementPsPowerTimeSlotType,
+	sequenceId model.PowerSequenceIdType,
+) []model.SmartEnergyManagementPsPowerTimeSlotType {
+	id := util.Ptr(sequenceId)
+
+	var result []model.SmartEnergyManagementPsPowerTimeSlotType
+	for _, slot := range slots {
+		if slot.Schedule != nil {
+			value := *slot.Schedule
+			value.SequenceId = id
+			slot.Schedule = &value
+		}
+		if slot.ScheduleConstraints != nil {
+			value := *slot.ScheduleConstraints
+			value.SequenceId = id
+			slot.ScheduleConstraints = &value
+		}
+		if slot.ValueList != nil {
+			valueList := &model.SmartEnergyManagementPsPowerTimeSlotValueListType{}
+			for _, value := range slot.ValueList.Value {
+				value.SequenceId = id
+				valueList.Value = append(valueList.Value, value)
+			}
+			slot.ValueList = valueList
+		}
+		result = append(result, slot)
+	}
+
+	return result
+}
+
+// return an absolute time for a relative one, which would otherwise
+// refer to the time of the write
+func absoluteTime(value *model.AbsoluteOrRelativeTimeType) *model.AbsoluteOrRelativeTimeType {
+	if value == nil || !value.IsRelativeTime() {
+		return value
+	}
+
+	t, err := value.GetTime()
+	if err != nil {
+		return value
+	}
+
+	return model.NewAbsoluteOrRelativeTimeTypeFromTime(t)
+}
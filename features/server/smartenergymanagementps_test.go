@@ -0,0 +1,291 @@
+package server_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSmartEnergyManagementPsSuite(t *testing.T) {
+	suite.Run(t, new(SmartEnergyManagementPsSuite))
+}
+
+type SmartEnergyManagementPsSuite struct {
+	suite.Suite
+
+	sut *server.SmartEnergyManagementPs
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *SmartEnergyManagementPsSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	_, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewSmartEnergyManagementPs(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewSmartEnergyManagementPs(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+// return a power sequence with a single slot
+func (s *SmartEnergyManagementPsSuite) powerSequence(power float64) model.SmartEnergyManagementPsPowerSequenceType {
+	return model.SmartEnergyManagementPsPowerSequenceType{
+		Description: &model.PowerSequenceDescriptionDataType{
+			PowerUnit: util.Ptr(model.UnitOfMeasurementTypeW),
+		},
+		State: &model.PowerSequenceStateDataType{
+			State:                      util.Ptr(model.PowerSequenceStateTypeInactive),
+			SequenceRemoteControllable: util.Ptr(true),
+		},
+		ScheduleConstraints: &model.PowerSequenceScheduleConstraintsDataType{
+			EarliestStartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour),
+		},
+		OperatingConstraintsInterrupt: &model.OperatingConstraintsInterruptDataType{
+			IsPausable: util.Ptr(false),
+		},
+		PowerTimeSlot: []model.SmartEnergyManagementPsPowerTimeSlotType{
+			{
+				Schedule: &model.PowerTimeSlotScheduleDataType{
+					SlotNumber:      util.Ptr(model.PowerTimeSlotNumberType(0)),
+					DefaultDuration: model.NewDurationType(time.Hour),
+				},
+				ValueList: &model.SmartEnergyManagementPsPowerTimeSlotValueListType{
+					Value: []model.PowerTimeSlotValueDataType{
+						{
+							SlotNumber: util.Ptr(model.PowerTimeSlotNumberType(0)),
+							ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePower),
+							Value:      model.NewScaledNumberType(power),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *SmartEnergyManagementPsSuite) Test_SetNodeScheduleInformation() {
+	err := s.sut.SetNodeScheduleInformation(model.PowerSequenceNodeScheduleInformationDataType{
+		NodeRemoteControllable: util.Ptr(true),
+	})
+	assert.Nil(s.T(), err)
+
+	data, err := s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data.NodeScheduleInformation)
+	assert.True(s.T(), *data.NodeScheduleInformation.NodeRemoteControllable)
+}
+
+func (s *SmartEnergyManagementPsSuite) Test_Alternatives() {
+	alternativesId := s.sut.AddAlternative(nil)
+	assert.Nil(s.T(), alternativesId)
+
+	sequence := s.powerSequence(1000)
+	sequence.Description.SequenceId = util.Ptr(model.PowerSequenceIdType(5))
+	alternativesId = s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{sequence})
+	assert.Nil(s.T(), alternativesId)
+
+	alternativesId = s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{
+		s.powerSequence(1000),
+		s.powerSequence(2000),
+	})
+	assert.NotNil(s.T(), alternativesId)
+	assert.Equal(s.T(), model.AlternativesIdType(0), *alternativesId)
+
+	alternativesId = s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{
+		s.powerSequence(3000),
+	})
+	assert.NotNil(s.T(), alternativesId)
+	assert.Equal(s.T(), model.AlternativesIdType(1), *alternativesId)
+
+	data, err := s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data.Alternatives))
+	assert.Equal(s.T(), []model.PowerSequenceIdType{0, 1}, data.Alternatives[0].Relation.SequenceId)
+	assert.Equal(s.T(), []model.PowerSequenceIdType{2}, data.Alternatives[1].Relation.SequenceId)
+
+	item := data.Alternatives[1].PowerSequence[0]
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.Description.SequenceId)
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.State.SequenceId)
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.ScheduleConstraints.SequenceId)
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.OperatingConstraintsInterrupt.SequenceId)
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.PowerTimeSlot[0].Schedule.SequenceId)
+	assert.Equal(s.T(), model.PowerSequenceIdType(2), *item.PowerTimeSlot[0].ValueList.Value[0].SequenceId)
+
+	err = s.sut.RemoveAlternative(model.AlternativesIdType(5))
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.RemoveAlternative(model.AlternativesIdType(0))
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data.Alternatives))
+	assert.Equal(s.T(), model.AlternativesIdType(1), *data.Alternatives[0].Relation.AlternativesId)
+
+	// new ids continue after the highest existing ones
+	alternativesId = s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{
+		s.powerSequence(1000),
+	})
+	assert.NotNil(s.T(), alternativesId)
+	assert.Equal(s.T(), model.AlternativesIdType(2), *alternativesId)
+
+	data, err = s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.PowerSequenceIdType{3}, data.Alternatives[1].Relation.SequenceId)
+}
+
+func (s *SmartEnergyManagementPsSuite) Test_UpdateSequence() {
+	err := s.sut.UpdateSequenceState(model.PowerSequenceStateDataType{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateSequenceState(model.PowerSequenceStateDataType{
+		SequenceId: util.Ptr(model.PowerSequenceIdType(0)),
+	})
+	assert.NotNil(s.T(), err)
+
+	alternativesId := s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{
+		s.powerSequence(1000),
+	})
+	assert.NotNil(s.T(), alternativesId)
+
+	data, err := s.sut.GetData()
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceState(model.PowerSequenceStateDataType{
+		SequenceId: util.Ptr(model.PowerSequenceIdType(0)),
+		State:      util.Ptr(model.PowerSequenceStateTypeRunning),
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceSchedule(model.PowerSequenceScheduleDataType{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateSequenceSchedule(model.PowerSequenceScheduleDataType{
+		SequenceId: util.Ptr(model.PowerSequenceIdType(0)),
+		StartTime:  model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now()),
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceScheduleConstraints(model.PowerSequenceScheduleConstraintsDataType{})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateSequenceScheduleConstraints(model.PowerSequenceScheduleConstraintsDataType{
+		SequenceId:    util.Ptr(model.PowerSequenceIdType(0)),
+		LatestEndTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour * 4),
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceTimeSlots(model.PowerSequenceIdType(1), nil)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.UpdateSequenceTimeSlots(model.PowerSequenceIdType(0), s.powerSequence(2000).PowerTimeSlot)
+	assert.Nil(s.T(), err)
+
+	// previously returned data is not changed by updates
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, *data.Alternatives[0].PowerSequence[0].State.State)
+	assert.Nil(s.T(), data.Alternatives[0].PowerSequence[0].Schedule)
+
+	data, err = s.sut.GetData()
+	assert.Nil(s.T(), err)
+	item := data.Alternatives[0].PowerSequence[0]
+	assert.Equal(s.T(), model.PowerSequenceStateTypeRunning, *item.State.State)
+	assert.NotNil(s.T(), item.Schedule.StartTime)
+	assert.Nil(s.T(), item.ScheduleConstraints.EarliestStartTime)
+	assert.NotNil(s.T(), item.ScheduleConstraints.LatestEndTime)
+	assert.Equal(s.T(), 2000.0, item.PowerTimeSlot[0].ValueList.Value[0].Value.GetValue())
+	assert.Equal(s.T(), model.PowerSequenceIdType(0), *item.PowerTimeSlot[0].ValueList.Value[0].SequenceId)
+}
+
+func (s *SmartEnergyManagementPsSuite) Test_ApplyScheduleWrite() {
+	data, err := s.sut.ApplyScheduleWrite(nil)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	sequence := s.powerSequence(1000)
+	sequence.State.SequenceRemoteControllable = util.Ptr(false)
+	alternativesId := s.sut.AddAlternative([]model.SmartEnergyManagementPsPowerSequenceType{
+		s.powerSequence(1000),
+		sequence,
+	})
+	assert.NotNil(s.T(), alternativesId)
+
+	write := func(sequenceId *model.PowerSequenceIdType, startTime *model.AbsoluteOrRelativeTimeType) *model.SmartEnergyManagementPsDataType {
+		return &model.SmartEnergyManagementPsDataType{
+			Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+				{
+					PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{
+						{
+							Schedule: &model.PowerSequenceScheduleDataType{
+								SequenceId: sequenceId,
+								StartTime:  startTime,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	data, err = s.sut.ApplyScheduleWrite(write(nil, nil))
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.ApplyScheduleWrite(write(util.Ptr(model.PowerSequenceIdType(5)), nil))
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.ApplyScheduleWrite(write(util.Ptr(model.PowerSequenceIdType(1)), nil))
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.ApplyScheduleWrite(write(
+		util.Ptr(model.PowerSequenceIdType(0)),
+		model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour),
+	))
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), 2, len(data.Alternatives[0].PowerSequence))
+
+	schedule := data.Alternatives[0].PowerSequence[0].Schedule
+	assert.NotNil(s.T(), schedule)
+	assert.False(s.T(), schedule.StartTime.IsRelativeTime())
+	startTime, err := schedule.StartTime.GetTime()
+	assert.Nil(s.T(), err)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour), startTime, time.Minute)
+
+	// the stored data is not changed
+	stored, err := s.sut.GetData()
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), stored.Alternatives[0].PowerSequence[0].Schedule)
+}
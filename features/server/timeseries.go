@@ -0,0 +1,273 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type TimeSeries struct {
+	*Feature
+
+	*internal.TimeSeriesCommon
+}
+
+func NewTimeSeries(localEntity spineapi.EntityLocalInterface) (*TimeSeries, error) {
+	feature, err := NewFeature(model.FeatureTypeTypeTimeSeries, localEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &TimeSeries{
+		Feature:          feature,
+		TimeSeriesCommon: internal.NewLocalTimeSeries(feature.featureLocal),
+	}
+
+	return t, nil
+}
+
+var _ api.TimeSeriesServerInterface = (*TimeSeries)(nil)
+
+// Add a new description data set and return the timeSeriesId
+//
+// NOTE: the timeSeriesId may not be provided
+//
+// will return nil if the data set could not be added
+func (t *TimeSeries) AddDescription(
+	description model.TimeSeriesDescriptionDataType,
+) *model.TimeSeriesIdType {
+	if description.TimeSeriesId != nil {
+		return nil
+	}
+
+	data, err := t.GetDescriptionsForFilter(model.TimeSeriesDescriptionDataType{})
+	if err != nil {
+		data = []model.TimeSeriesDescriptionDataType{}
+	}
+
+	maxId := model.TimeSeriesIdType(0)
+
+	for _, item := range data {
+		if item.TimeSeriesId != nil && *item.TimeSeriesId >= maxId {
+			maxId = *item.TimeSeriesId + 1
+		}
+	}
+
+	timeSeriesId := util.Ptr(maxId)
+	description.TimeSeriesId = timeSeriesId
+
+	partial := model.NewFilterTypePartial()
+	datalist := &model.TimeSeriesDescriptionListDataType{
+		TimeSeriesDescriptionData: []model.TimeSeriesDescriptionDataType{description},
+	}
+
+	if err := t.featureLocal.UpdateData(model.FunctionTypeTimeSeriesDescriptionListData, datalist, partial, nil); err != nil {
+		return nil
+	}
+
+	return timeSeriesId
+}
+
+// Set the constraints of the time series, replacing all existing constraints
+//
+// NOTE: the timeSeriesId has to be provided for each item
+//
+// Will return an error if a time series does not exist or the data set could not be updated
+func (t *TimeSeries) SetConstraints(data []model.TimeSeriesConstraintsDataType) error {
+	for _, item := range data {
+		if item.TimeSeriesId == nil {
+			return errors.New("missing id data")
+		}
+
+		filter := model.TimeSeriesDescriptionDataType{
+			TimeSeriesId: item.TimeSeriesId,
+		}
+		if descriptions, err := t.GetDescriptionsForFilter(filter); err != nil || len(descriptions) == 0 {
+			return api.ErrDataNotAvailable
+		}
+	}
+
+	datalist := &model.TimeSeriesConstraintsListDataType{
+		TimeSeriesConstraintsData: data,
+	}
+
+	if err := t.featureLocal.UpdateData(model.FunctionTypeTimeSeriesConstraintsListData, datalist, nil, nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Set or update data set for a timeSeriesId
+//
+// Will return an error if the data set could not be updated
+func (t *TimeSeries) UpdateDataForIds(
+	data []api.TimeSeriesDataForID,
+) (resultErr error) {
+	var filterData []api.TimeSeriesDataForFilter
+	for index, item := range data {
+		filterData = append(filterData, api.TimeSeriesDataForFilter{
+			Data:   item.Data,
+			Filter: model.TimeSeriesDescriptionDataType{TimeSeriesId: &data[index].Id},
+		})
+	}
+
+	return t.UpdateDataForFilters(filterData, nil, nil)
+}
+
+// Set or update data set for a filter
+// deleteSelector will trigger removal of matching items from the data set before the update
+// deleteElement will limit the fields to be removed using Id
+//
+// Will return an error if the data set could not be updated
+func (t *TimeSeries) UpdateDataForFilters(
+	data []api.TimeSeriesDataForFilter,
+	deleteSelector *model.TimeSeriesListDataSelectorsType,
+	deleteElements *model.TimeSeriesDataElementsType,
+) (resultErr error) {
+	resultErr = api.ErrDataNotAvailable
+
+	var timeSeriesData []model.TimeSeriesDataType
+
+	for _, item := range data {
+		descriptions, err := t.GetDescriptionsForFilter(item.Filter)
+		if err != nil || descriptions == nil || len(descriptions) != 1 {
+			return
+		}
+
+		description := descriptions[0]
+		item.Data.TimeSeriesId = description.TimeSeriesId
+
+		timeSeriesData = append(timeSeriesData, item.Data)
+	}
+
+	partial := model.NewFilterTypePartial()
+
+	datalist := &model.TimeSeriesListDataType{
+		TimeSeriesData: timeSeriesData,
+	}
+
+	var deleteFilter *model.FilterType
+	if deleteSelector != nil {
+		deleteFilter = &model.FilterType{
+			TimeSeriesListDataSelectors: deleteSelector,
+		}
+
+		if deleteElements != nil {
+			deleteFilter.TimeSeriesDataElements = deleteElements
+		}
+	}
+
+	if err := t.featureLocal.UpdateData(model.FunctionTypeTimeSeriesListData, datalist, partial, deleteFilter); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Add a callback which is invoked for incoming writes of time series data
+//
+// The callback has to approve or deny each write using ApproveOrDenyWrite,
+// otherwise the write is denied once the approval timeout is reached
+func (t *TimeSeries) AddWriteApprovalCallback(function spineapi.WriteApprovalCallbackFunc) error {
+	return t.featureLocal.AddWriteApprovalCallback(function)
+}
+
+// Approve or deny an incoming write
+//
+// The write is approved if err is nil, otherwise it is denied
+// with the error message as description
+func (t *TimeSeries) ApproveOrDenyWrite(msg *spineapi.Message, err error) {
+	if msg == nil || msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil {
+		return
+	}
+
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if err != nil {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(err.Error()))
+	}
+
+	t.featureLocal.ApproveOrDenyWrite(msg, result)
+}
+
+// Return a time period with absolute start and end times
+func NewAbsoluteTimePeriod(start, end time.Time) *model.TimePeriodType {
+	return &model.TimePeriodType{
+		StartTime: model.NewAbsoluteOrRelativeTimeTypeFromTime(start),
+		EndTime:   model.NewAbsoluteOrRelativeTimeTypeFromTime(end),
+	}
+}
+
+// Return a time period with start and end times relative to now
+func NewRelativeTimePeriod(start, end time.Duration) *model.TimePeriodType {
+	return &model.TimePeriodType{
+		StartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(start),
+		EndTime:   model.NewAbsoluteOrRelativeTimeTypeFromDuration(end),
+	}
+}
+
+// Return the absolute start and end times of a time period
+//
+// Relative times are resolved using the reference time, which usually is the
+// time the data was received. A missing start time is the reference time,
+// a missing end time is returned as zero time.
+//
+// Will return an error if a time can not be parsed
+func TimePeriodTimes(period model.TimePeriodType, reference time.Time) (start, end time.Time, err error) {
+	start = reference
+	if period.StartTime != nil {
+		if start, err = resolveTime(*period.StartTime, reference); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if period.EndTime != nil {
+		if end, err = resolveTime(*period.EndTime, reference); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return start, end, nil
+}
+
+// Return the start and end times of a time period relative to the reference time
+//
+// Missing start or end times are returned as 0.
+//
+// Will return an error if a time can not be parsed
+func TimePeriodDurations(period model.TimePeriodType, reference time.Time) (start, end time.Duration, err error) {
+	startTime, endTime, err := TimePeriodTimes(period, reference)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = startTime.Sub(reference)
+	if !endTime.IsZero() {
+		end = endTime.Sub(reference)
+	}
+
+	return start, end, nil
+}
+
+// return the time of an absolute or relative time value, using the reference time for relative values
+func resolveTime(value model.AbsoluteOrRelativeTimeType, reference time.Time) (time.Time, error) {
+	if value.IsRelativeTime() {
+		duration, err := value.GetTimeDuration()
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return reference.Add(duration), nil
+	}
+
+	return value.GetDateTimeType().GetTime()
+}
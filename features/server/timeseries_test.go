@@ -0,0 +1,268 @@
+package server_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTimeSeriesSuite(t *testing.T) {
+	suite.Run(t, new(TimeSeriesSuite))
+}
+
+type TimeSeriesSuite struct {
+	suite.Suite
+
+	sut *server.TimeSeries
+
+	service api.ServiceInterface
+
+	localEntity  spineapi.EntityLocalInterface
+	remoteDevice spineapi.DeviceRemoteInterface
+}
+
+func (s *TimeSeriesSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+
+	s.remoteDevice, _ = setupFeatures(s.service, s.T())
+
+	var err error
+	s.sut, err = server.NewTimeSeries(nil)
+	assert.NotNil(s.T(), err)
+
+	s.sut, err = server.NewTimeSeries(s.localEntity)
+	assert.Nil(s.T(), err)
+}
+
+func (s *TimeSeriesSuite) Test_Description() {
+	filter := model.TimeSeriesDescriptionDataType{
+		TimeSeriesType: util.Ptr(model.TimeSeriesTypeTypeConstraints),
+	}
+
+	data, err := s.sut.GetDescriptionsForFilter(filter)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	desc := model.TimeSeriesDescriptionDataType{
+		TimeSeriesId:        util.Ptr(model.TimeSeriesIdType(5)),
+		TimeSeriesType:      filter.TimeSeriesType,
+		TimeSeriesWriteable: util.Ptr(true),
+		Unit:                util.Ptr(model.UnitOfMeasurementTypeW),
+	}
+	timeSeriesId := s.sut.AddDescription(desc)
+	assert.Nil(s.T(), timeSeriesId)
+
+	desc.TimeSeriesId = nil
+	timeSeriesId = s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), timeSeriesId)
+	assert.Equal(s.T(), model.TimeSeriesIdType(0), *timeSeriesId)
+
+	data, err = s.sut.GetDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), *timeSeriesId, *data[0].TimeSeriesId)
+
+	desc.TimeSeriesType = util.Ptr(model.TimeSeriesTypeTypePlan)
+	timeSeriesId = s.sut.AddDescription(desc)
+	assert.NotNil(s.T(), timeSeriesId)
+	assert.Equal(s.T(), model.TimeSeriesIdType(1), *timeSeriesId)
+}
+
+func (s *TimeSeriesSuite) Test_Constraints() {
+	constraints := []model.TimeSeriesConstraintsDataType{
+		{
+			SlotCountMax: util.Ptr(model.TimeSeriesSlotCountType(10)),
+		},
+	}
+
+	err := s.sut.SetConstraints(constraints)
+	assert.NotNil(s.T(), err)
+
+	constraints[0].TimeSeriesId = util.Ptr(model.TimeSeriesIdType(0))
+	err = s.sut.SetConstraints(constraints)
+	assert.NotNil(s.T(), err)
+
+	timeSeriesId := s.sut.AddDescription(model.TimeSeriesDescriptionDataType{
+		TimeSeriesType: util.Ptr(model.TimeSeriesTypeTypeConstraints),
+	})
+	assert.NotNil(s.T(), timeSeriesId)
+
+	err = s.sut.SetConstraints(constraints)
+	assert.Nil(s.T(), err)
+
+	data, err := s.sut.GetConstraints()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), model.TimeSeriesSlotCountType(10), *data[0].SlotCountMax)
+
+	err = s.sut.SetConstraints(nil)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetConstraints()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(data))
+}
+
+func (s *TimeSeriesSuite) Test_UpdateData() {
+	slots := []model.TimeSeriesSlotType{
+		{
+			TimeSeriesSlotId: util.Ptr(model.TimeSeriesSlotIdType(0)),
+			TimePeriod:       server.NewRelativeTimePeriod(0, time.Hour),
+			MaxValue:         model.NewScaledNumberType(4200),
+		},
+	}
+
+	err := s.sut.UpdateDataForIds([]api.TimeSeriesDataForID{
+		{
+			Id: model.TimeSeriesIdType(0),
+			Data: model.TimeSeriesDataType{
+				TimeSeriesSlot: slots,
+			},
+		},
+	})
+	assert.NotNil(s.T(), err)
+
+	timeSeriesId := s.sut.AddDescription(model.TimeSeriesDescriptionDataType{
+		TimeSeriesType: util.Ptr(model.TimeSeriesTypeTypePlan),
+	})
+	assert.NotNil(s.T(), timeSeriesId)
+
+	err = s.sut.UpdateDataForIds([]api.TimeSeriesDataForID{
+		{
+			Id: *timeSeriesId,
+			Data: model.TimeSeriesDataType{
+				TimePeriod:     server.NewRelativeTimePeriod(0, time.Hour),
+				TimeSeriesSlot: slots,
+			},
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	filter := model.TimeSeriesDescriptionDataType{
+		TimeSeriesType: util.Ptr(model.TimeSeriesTypeTypePlan),
+	}
+	data, err := s.sut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 1, len(data[0].TimeSeriesSlot))
+	assert.NotNil(s.T(), data[0].TimePeriod)
+
+	slots = append(slots, model.TimeSeriesSlotType{
+		TimeSeriesSlotId: util.Ptr(model.TimeSeriesSlotIdType(1)),
+		TimePeriod:       server.NewRelativeTimePeriod(time.Hour, 2*time.Hour),
+		MaxValue:         model.NewScaledNumberType(1000),
+	})
+	deleteSelector := &model.TimeSeriesListDataSelectorsType{
+		TimeSeriesId: timeSeriesId,
+	}
+	deleteElements := &model.TimeSeriesDataElementsType{
+		TimePeriod: &model.TimePeriodElementsType{},
+	}
+	err = s.sut.UpdateDataForFilters([]api.TimeSeriesDataForFilter{
+		{
+			Filter: filter,
+			Data: model.TimeSeriesDataType{
+				TimeSeriesSlot: slots,
+			},
+		},
+	}, deleteSelector, deleteElements)
+	assert.Nil(s.T(), err)
+
+	data, err = s.sut.GetDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 2, len(data[0].TimeSeriesSlot))
+	assert.Nil(s.T(), data[0].TimePeriod)
+}
+
+func (s *TimeSeriesSuite) Test_WriteApproval() {
+	err := s.sut.AddWriteApprovalCallback(func(msg *spineapi.Message) {})
+	assert.Nil(s.T(), err)
+
+	s.sut.ApproveOrDenyWrite(nil, nil)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: util.Ptr(model.MsgCounterType(1)),
+		},
+		DeviceRemote: s.remoteDevice,
+	}
+	s.sut.ApproveOrDenyWrite(msg, nil)
+	s.sut.ApproveOrDenyWrite(msg, errors.New("invalid data"))
+}
+
+func (s *TimeSeriesSuite) Test_TimePeriod() {
+	reference := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	period := server.NewAbsoluteTimePeriod(reference.Add(time.Hour), reference.Add(2*time.Hour))
+	start, end, err := server.TimePeriodTimes(*period, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), reference.Add(time.Hour), start)
+	assert.Equal(s.T(), reference.Add(2*time.Hour), end)
+
+	startDuration, endDuration, err := server.TimePeriodDurations(*period, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Hour, startDuration)
+	assert.Equal(s.T(), 2*time.Hour, endDuration)
+
+	period = server.NewRelativeTimePeriod(15*time.Minute, 45*time.Minute)
+	start, end, err = server.TimePeriodTimes(*period, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), reference.Add(15*time.Minute), start)
+	assert.Equal(s.T(), reference.Add(45*time.Minute), end)
+
+	startDuration, endDuration, err = server.TimePeriodDurations(*period, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 15*time.Minute, startDuration)
+	assert.Equal(s.T(), 45*time.Minute, endDuration)
+
+	start, end, err = server.TimePeriodTimes(model.TimePeriodType{}, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), reference, start)
+	assert.True(s.T(), end.IsZero())
+
+	startDuration, endDuration, err = server.TimePeriodDurations(model.TimePeriodType{}, reference)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Duration(0), startDuration)
+	assert.Equal(s.T(), time.Duration(0), endDuration)
+
+	period = &model.TimePeriodType{
+		StartTime: model.NewAbsoluteOrRelativeTimeType("invalid"),
+	}
+	_, _, err = server.TimePeriodTimes(*period, reference)
+	assert.NotNil(s.T(), err)
+	_, _, err = server.TimePeriodDurations(*period, reference)
+	assert.NotNil(s.T(), err)
+
+	period = &model.TimePeriodType{
+		EndTime: model.NewAbsoluteOrRelativeTimeType("invalid"),
+	}
+	_, _, err = server.TimePeriodTimes(*period, reference)
+	assert.NotNil(s.T(), err)
+}
@@ -0,0 +1,283 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemcevc "github.com/enbility/eebus-go/usecases/cem/cevc"
+	cemevcc "github.com/enbility/eebus-go/usecases/cem/evcc"
+	evcevc "github.com/enbility/eebus-go/usecases/ev/cevc"
+	evevcc "github.com/enbility/eebus-go/usecases/ev/evcc"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCEVCSuite(t *testing.T) {
+	suite.Run(t, new(CEVCSuite))
+}
+
+// the CEM reads the demand and constraints of the EV of an EVSE,
+// and writes power limits and incentives with CEVC
+type CEVCSuite struct {
+	suite.Suite
+
+	cemService api.ServiceInterface
+	cemEvcc    *cemevcc.EVCC
+	cemCevc    *cemcevc.CEVC
+
+	evcc *evevcc.EVCC
+	cevc *evcevc.CEVC
+
+	cemSki   string
+	evseSki  string
+	evEntity spineapi.EntityRemoteInterface
+
+	cemEvents []api.EventType
+	evEvents  []api.EventType
+	mux       sync.Mutex
+}
+
+func (s *CEVCSuite) cemEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.evseSki {
+		return
+	}
+
+	s.evEntity = entity
+	s.cemEvents = append(s.cemEvents, event)
+}
+
+func (s *CEVCSuite) evEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.cemSki {
+		return
+	}
+
+	s.evEvents = append(s.evEvents, event)
+}
+
+func (s *CEVCSuite) cemEventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.cemEvents, event)
+}
+
+func (s *CEVCSuite) evEventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.evEvents, event)
+}
+
+func (s *CEVCSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evEntity
+}
+
+func (s *CEVCSuite) BeforeTest(suiteName, testName string) {
+	s.cemService = newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.mux.Lock()
+	s.cemSki = s.cemService.LocalService().SKI()
+	s.evseSki = evseService.LocalService().SKI()
+	s.cemEvents = nil
+	s.evEvents = nil
+	s.evEntity = nil
+	s.mux.Unlock()
+
+	cemEntity := s.cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.cemEvcc = cemevcc.NewEVCC(s.cemService, cemEntity, s.cemEvent)
+	s.cemEvcc.AddFeatures()
+	s.cemEvcc.AddUseCase()
+	s.cemCevc = cemcevc.NewCEVC(cemEntity, s.cemEvent)
+	s.cemCevc.AddFeatures()
+	s.cemCevc.AddUseCase()
+
+	s.evcc = evevcc.NewEVCC(evseService, evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+
+	s.cevc = evcevc.NewCEVC(s.evcc.LocalEntity, s.evEvent)
+	s.cevc.AddFeatures()
+	s.cevc.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.cevc)
+
+	unsubscribeOnCleanup(s.T(),
+		s.cemEvcc, s.cemEvcc.UseCaseBase, s.cemCevc, s.cemCevc.UseCaseBase,
+		s.evcc.UseCaseBase, s.cevc, s.cevc.UseCaseBase)
+	connectServices(s.T(), s.cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+// connect the EV and wait until the CEM received its demand and constraints
+func (s *CEVCSuite) connectEV() spineapi.EntityRemoteInterface {
+	assert.Nil(s.T(), s.cevc.SetEnergyDemand(ucapi.Demand{
+		OptDemand: 20000,
+		MaxDemand: 40000,
+	}))
+	assert.Nil(s.T(), s.cevc.SetTimeSlotConstraints(ucapi.TimeSlotConstraints{
+		MinSlots:        1,
+		MaxSlots:        10,
+		MaxSlotDuration: 2 * time.Hour,
+	}))
+
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.cemEventReceived(cemcevc.UseCaseSupportUpdate)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	assert.Eventually(s.T(), func() bool {
+		_, err := s.cemCevc.TimeSlotConstraints(entity)
+		if err != nil {
+			return false
+		}
+		_, err = s.cemCevc.EnergyDemand(entity)
+		return err == nil
+	}, time.Second*5, time.Millisecond*10)
+
+	// the CEM does not request the incentive constraints,
+	// so they are only received via notify once the CEM subscribed
+	assert.Eventually(s.T(), func() bool {
+		_ = s.cevc.SetIncentiveConstraints(ucapi.IncentiveSlotConstraints{MinSlots: 1, MaxSlots: 24})
+		_, err := s.cemCevc.IncentiveConstraints(entity)
+		return err == nil
+	}, time.Second*5, time.Millisecond*50)
+
+	return entity
+}
+
+func (s *CEVCSuite) Test_EnergyDemand() {
+	entity := s.connectEV()
+
+	assert.Equal(s.T(), ucapi.EVChargeStrategyTypeDirectCharging, s.cemCevc.ChargeStrategy(entity))
+
+	demand, err := s.cemCevc.EnergyDemand(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 20000.0, demand.OptDemand)
+	assert.Equal(s.T(), 40000.0, demand.MaxDemand)
+
+	constraints, err := s.cemCevc.TimeSlotConstraints(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(10), constraints.MaxSlots)
+	assert.Equal(s.T(), 2*time.Hour, constraints.MaxSlotDuration)
+
+	assert.Nil(s.T(), s.cevc.SetPowerLimitsUpdateRequired(true))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.cemEventReceived(cemcevc.DataRequestedPowerLimitsAndIncentives)
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *CEVCSuite) Test_WritePowerLimits() {
+	entity := s.connectEV()
+
+	data := []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 11000},
+		{Duration: 2 * time.Hour, Value: 4000},
+	}
+	assert.Nil(s.T(), s.cemCevc.WritePowerLimits(entity, data))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(evcevc.DataUpdatePowerLimits)
+	}, time.Second*5, time.Millisecond*10)
+
+	limits, err := s.cevc.PowerLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), data, limits)
+
+	// the data is removed once the EV disconnects
+	s.evcc.EVDisconnected()
+
+	_, err = s.cevc.PowerLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+}
+
+func (s *CEVCSuite) Test_DenyPowerLimits() {
+	entity := s.connectEV()
+
+	timeSeries, err := client.NewTimeSeries(s.cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), entity)
+	assert.Nil(s.T(), err)
+
+	results := make(chan model.ResultDataType, 1)
+	timeSeries.AddResultCallback(func(msg spineapi.ResponseMessage) {
+		if result, ok := msg.Data.(*model.ResultDataType); ok {
+			results <- *result
+		}
+	})
+
+	// the slots exceed the maximum slot duration
+	data := []ucapi.DurationSlotValue{
+		{Duration: 3 * time.Hour, Value: 11000},
+		{Duration: time.Hour, Value: 4000},
+	}
+	assert.Nil(s.T(), s.cemCevc.WritePowerLimits(entity, data))
+
+	select {
+	case result := <-results:
+		assert.Equal(s.T(), model.ErrorNumberType(7), *result.ErrorNumber)
+		assert.Equal(s.T(), model.DescriptionType("slot duration is too long"), *result.Description)
+	case <-time.After(time.Second * 5):
+		s.T().Fatal("no result received")
+	}
+
+	assert.False(s.T(), s.evEventReceived(evcevc.DataUpdatePowerLimits))
+
+	_, err = s.cevc.PowerLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+}
+
+func (s *CEVCSuite) Test_WriteIncentives() {
+	entity := s.connectEV()
+
+	assert.Nil(s.T(), s.cemCevc.WriteIncentiveTableDescriptions(entity, nil))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(evcevc.DataUpdateIncentiveTableDescriptions)
+	}, time.Second*5, time.Millisecond*10)
+
+	tariffs, err := s.cevc.IncentiveTableDescriptions()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(tariffs))
+	assert.Equal(s.T(), model.IncentiveTypeTypeAbsoluteCost, tariffs[0].Tiers[0].Incentives[0].Type)
+
+	data := []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 0.25},
+		{Duration: 2 * time.Hour, Value: 0.5},
+	}
+	assert.Nil(s.T(), s.cemCevc.WriteIncentives(entity, data))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(evcevc.DataUpdateIncentives)
+	}, time.Second*5, time.Millisecond*10)
+
+	incentives, err := s.cevc.Incentives()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), data, incentives)
+}
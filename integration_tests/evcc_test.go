@@ -0,0 +1,205 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemevcc "github.com/enbility/eebus-go/usecases/cem/evcc"
+	evevcc "github.com/enbility/eebus-go/usecases/ev/evcc"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEVCCSuite(t *testing.T) {
+	suite.Run(t, new(EVCCSuite))
+}
+
+type EVCCSuite struct {
+	suite.Suite
+
+	cem *cemevcc.EVCC
+	ev  *evevcc.EVCC
+
+	evEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *EVCCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if event == cemevcc.EvConnected {
+		s.evEntity = entity
+	}
+	s.events = append(s.events, event)
+}
+
+func (s *EVCCSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *EVCCSuite) resetEvents() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = nil
+}
+
+func (s *EVCCSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evEntity
+}
+
+func (s *EVCCSuite) BeforeTest(suiteName, testName string) {
+	s.resetEvents()
+
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.cem = cemevcc.NewEVCC(cemService, cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.ev = evevcc.NewEVCC(evseService, evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.ev.AddFeatures()
+	s.ev.AddUseCase()
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.ev.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+// set all EV values and plug in the EV
+func (s *EVCCSuite) connectEV() {
+	assert.Nil(s.T(), s.ev.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeISO151182ED2))
+	assert.Nil(s.T(), s.ev.SetAsymmetricChargingSupport(true))
+	assert.Nil(s.T(), s.ev.SetIdentifications([]ucapi.IdentificationItem{
+		{
+			Value:     "00:11:22:33:44:55",
+			ValueType: model.IdentificationTypeTypeEui48,
+		},
+	}))
+	assert.Nil(s.T(), s.ev.SetManufacturerData(api.ManufacturerData{
+		BrandName:    "brand",
+		SerialNumber: "1234",
+	}))
+	assert.Nil(s.T(), s.ev.SetChargingPowerLimits(1400, 11000, 10))
+
+	s.ev.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemevcc.EvConnected,
+			cemevcc.DataUpdateCommunicationStandard,
+			cemevcc.DataUpdateAsymmetricChargingSupport,
+			cemevcc.DataUpdateIdentifications,
+			cemevcc.DataUpdateManufacturerData,
+			cemevcc.DataUpdateCurrentLimits,
+		)
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *EVCCSuite) Test_Values() {
+	s.connectEV()
+
+	entity := s.connectedEntity()
+	assert.True(s.T(), s.cem.EVConnected(entity))
+
+	standard, err := s.cem.CommunicationStandard(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceConfigurationKeyValueStringTypeISO151182ED2, standard)
+
+	asymmetric, err := s.cem.AsymmetricChargingSupport(entity)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), asymmetric)
+
+	identifications, err := s.cem.Identifications(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.IdentificationItem{
+		{
+			Value:     "00:11:22:33:44:55",
+			ValueType: model.IdentificationTypeTypeEui48,
+		},
+	}, identifications)
+
+	manufacturerData, err := s.cem.ManufacturerData(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "brand", manufacturerData.BrandName)
+	assert.Equal(s.T(), "1234", manufacturerData.SerialNumber)
+
+	minimum, maximum, standby, err := s.cem.ChargingPowerLimits(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1400.0, minimum)
+	assert.Equal(s.T(), 11000.0, maximum)
+	assert.Equal(s.T(), 10.0, standby)
+
+	chargeState, err := s.cem.ChargeState(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.EVChargeStateTypeActive, chargeState)
+
+	assert.Nil(s.T(), s.ev.SetChargeState(ucapi.EVChargeStateTypePaused))
+	assert.Eventually(s.T(), func() bool {
+		sleeping, err := s.cem.IsInSleepMode(entity)
+		return err == nil && sleeping
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *EVCCSuite) Test_Reconnect() {
+	s.connectEV()
+
+	entity := s.connectedEntity()
+	assert.Eventually(s.T(), func() bool {
+		return s.cem.IsScenarioAvailableAtEntity(entity, 1)
+	}, time.Second*5, time.Millisecond*10)
+
+	s.ev.EVDisconnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(cemevcc.EvDisconnected)
+	}, time.Second*5, time.Millisecond*10)
+	assert.False(s.T(), s.cem.EVConnected(entity))
+
+	// the use case is announced again for the new EV
+	s.resetEvents()
+	s.connectEV()
+
+	entity = s.connectedEntity()
+	assert.True(s.T(), s.cem.EVConnected(entity))
+	assert.Eventually(s.T(), func() bool {
+		return s.cem.IsScenarioAvailableAtEntity(entity, 1)
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *EVCCSuite) Test_RemoteScenarios() {
+	// the CEM provides no server features, so all scenarios are available
+	assert.Eventually(s.T(), func() bool {
+		entities := s.ev.RemoteEntitiesScenarios()
+		return len(entities) == 1 && slices.Equal([]uint{1, 2, 3, 4, 5, 6, 7, 8}, entities[0].Scenarios)
+	}, time.Second*5, time.Millisecond*10)
+}
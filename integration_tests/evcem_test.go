@@ -0,0 +1,200 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	cemevcem "github.com/enbility/eebus-go/usecases/cem/evcem"
+	evevcc "github.com/enbility/eebus-go/usecases/ev/evcc"
+	evevcem "github.com/enbility/eebus-go/usecases/ev/evcem"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEVCEMSuite(t *testing.T) {
+	suite.Run(t, new(EVCEMSuite))
+}
+
+type EVCEMSuite struct {
+	suite.Suite
+
+	cem   *cemevcem.EVCEM
+	evcc  *evevcc.EVCC
+	evcem *evevcem.EVCEM
+
+	evseSki  string
+	evEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *EVCEMSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.evseSki {
+		return
+	}
+
+	s.evEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *EVCEMSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *EVCEMSuite) resetEvents() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = nil
+}
+
+func (s *EVCEMSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evEntity
+}
+
+func (s *EVCEMSuite) BeforeTest(suiteName, testName string) {
+
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.mux.Lock()
+	s.evseSki = evseService.LocalService().SKI()
+	s.events = nil
+	s.evEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemevcem.NewEVCEM(cemService, cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.evcc = evevcc.NewEVCC(evseService, evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+
+	s.evcem = evevcem.NewEVCEM(s.evcc.LocalEntity, nil, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.evcem.AddFeatures()
+	s.evcem.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.evcem)
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.evcc.UseCaseBase, s.evcem.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+func (s *EVCEMSuite) Test_Values() {
+	assert.Nil(s.T(), s.evcem.UpdateCurrentPerPhase([]float64{10, 11, 12}))
+	assert.Nil(s.T(), s.evcem.UpdatePowerPerPhase([]float64{2300, 2500, 2750}))
+	assert.Nil(s.T(), s.evcem.UpdateEnergyCharged(1500))
+
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemevcem.DataUpdatePhasesConnected,
+			cemevcem.DataUpdateCurrentPerPhase,
+			cemevcem.DataUpdatePowerPerPhase,
+			cemevcem.DataUpdateEnergyCharged,
+		)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	phases, err := s.cem.PhasesConnected(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(3), phases)
+
+	current, err := s.cem.CurrentPerPhase(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 11, 12}, current)
+
+	power, err := s.cem.PowerPerPhase(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{2300, 2500, 2750}, power)
+
+	energy, err := s.cem.EnergyCharged(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1500.0, energy)
+
+	// updates are notified to the subscribed CEM
+	assert.Nil(s.T(), s.evcem.UpdateEnergyCharged(2000))
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.cem.EnergyCharged(entity)
+		return err == nil && value == 2000
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *EVCEMSuite) Test_Reconnect() {
+	assert.Nil(s.T(), s.evcem.UpdateCurrentPerPhase([]float64{10, 11, 12}))
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(cemevcem.DataUpdateCurrentPerPhase)
+	}, time.Second*5, time.Millisecond*10)
+
+	s.evcc.EVDisconnected()
+	s.resetEvents()
+
+	// the next EV is charging with a single phase
+	assert.Nil(s.T(), s.evcem.SetConnectedPhases(model.ElectricalConnectionPhaseNameTypeA))
+	assert.Nil(s.T(), s.evcem.UpdateCurrentPerPhase([]float64{16}))
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemevcem.DataUpdatePhasesConnected,
+			cemevcem.DataUpdateCurrentPerPhase,
+		)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	phases, err := s.cem.PhasesConnected(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(1), phases)
+
+	assert.Eventually(s.T(), func() bool {
+		current, err := s.cem.CurrentPerPhase(entity)
+		return err == nil && slices.Equal([]float64{16}, current)
+	}, time.Second*5, time.Millisecond*10)
+
+	// the power of the previous EV is not reported
+	_, err = s.cem.PowerPerPhase(entity)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *EVCEMSuite) Test_RemoteScenarios() {
+	// the CEM provides no server features, so all scenarios are available
+	assert.Eventually(s.T(), func() bool {
+		entities := s.evcem.RemoteEntitiesScenarios()
+		return len(entities) == 1 && slices.Equal([]uint{1, 2, 3}, entities[0].Scenarios)
+	}, time.Second*5, time.Millisecond*10)
+}
@@ -0,0 +1,134 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	cemevsecc "github.com/enbility/eebus-go/usecases/cem/evsecc"
+	evseevsecc "github.com/enbility/eebus-go/usecases/evse/evsecc"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEVSECCSuite(t *testing.T) {
+	suite.Run(t, new(EVSECCSuite))
+}
+
+// the CEM reads the manufacturer data and operating state of an EVSE
+type EVSECCSuite struct {
+	suite.Suite
+
+	cem  *cemevsecc.EVSECC
+	evse *evseevsecc.EVSECC
+
+	evseSki    string
+	evseEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *EVSECCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.evseSki {
+		return
+	}
+
+	s.evseEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *EVSECCSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *EVSECCSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evseEntity
+}
+
+func (s *EVSECCSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.mux.Lock()
+	s.evseSki = evseService.LocalService().SKI()
+	s.events = nil
+	s.evseEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemevsecc.NewEVSECC(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.evse = evseevsecc.NewEVSECC(evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.evse.AddFeatures()
+	s.evse.AddUseCase()
+
+	// the data is provided before the CEM connects, as it is only requested once
+	assert.Nil(s.T(), s.evse.SetManufacturerData(api.ManufacturerData{
+		BrandName:    "brand",
+		SerialNumber: "1234",
+	}))
+	assert.Nil(s.T(), s.evse.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeFailure, "contactor error"))
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.evse.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+func (s *EVSECCSuite) Test_Data() {
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemevsecc.DataUpdateManufacturerData,
+			cemevsecc.DataUpdateOperatingState,
+		)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	data, err := s.cem.ManufacturerData(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "brand", data.BrandName)
+	assert.Equal(s.T(), "1234", data.SerialNumber)
+	assert.Equal(s.T(), "", data.DeviceName)
+
+	state, lastErrorCode, err := s.cem.OperatingState(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeFailure, state)
+	assert.Equal(s.T(), "contactor error", lastErrorCode)
+}
+
+func (s *EVSECCSuite) Test_RemoteScenarios() {
+	// the CEM provides no server features, so all scenarios are available
+	assert.Eventually(s.T(), func() bool {
+		entities := s.evse.RemoteEntitiesScenarios()
+		return len(entities) == 1 && slices.Equal([]uint{1, 2}, entities[0].Scenarios)
+	}, time.Second*5, time.Millisecond*10)
+}
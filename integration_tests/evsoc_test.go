@@ -0,0 +1,130 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	cemevcem "github.com/enbility/eebus-go/usecases/cem/evcem"
+	cemevsoc "github.com/enbility/eebus-go/usecases/cem/evsoc"
+	evevcc "github.com/enbility/eebus-go/usecases/ev/evcc"
+	evevcem "github.com/enbility/eebus-go/usecases/ev/evcem"
+	evevsoc "github.com/enbility/eebus-go/usecases/ev/evsoc"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEVSOCSuite(t *testing.T) {
+	suite.Run(t, new(EVSOCSuite))
+}
+
+// the CEM reads the state of charge of the EV of an EVSE,
+// the measurement descriptions are requested by the CEM EVCEM use case
+type EVSOCSuite struct {
+	suite.Suite
+
+	cemEvcem *cemevcem.EVCEM
+	cemEvsoc *cemevsoc.EVSOC
+
+	evcc  *evevcc.EVCC
+	evcem *evevcem.EVCEM
+	evsoc *evevsoc.EVSOC
+
+	evseSki  string
+	evEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *EVSOCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.evseSki {
+		return
+	}
+
+	s.evEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *EVSOCSuite) eventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.events, event)
+}
+
+func (s *EVSOCSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evEntity
+}
+
+func (s *EVSOCSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.mux.Lock()
+	s.evseSki = evseService.LocalService().SKI()
+	s.events = nil
+	s.evEntity = nil
+	s.mux.Unlock()
+
+	cemEntity := cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.cemEvcem = cemevcem.NewEVCEM(cemService, cemEntity, nil)
+	s.cemEvcem.AddFeatures()
+	s.cemEvcem.AddUseCase()
+	s.cemEvsoc = cemevsoc.NewEVSOC(cemEntity, s.Event)
+	s.cemEvsoc.AddFeatures()
+	s.cemEvsoc.AddUseCase()
+
+	s.evcc = evevcc.NewEVCC(evseService, evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+
+	s.evcem = evevcem.NewEVCEM(s.evcc.LocalEntity, nil, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.evcem.AddFeatures()
+	s.evcem.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.evcem)
+
+	s.evsoc = evevsoc.NewEVSOC(s.evcc.LocalEntity, nil)
+	s.evsoc.AddFeatures()
+	s.evsoc.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.evsoc)
+
+	unsubscribeOnCleanup(s.T(),
+		s.cemEvcem, s.cemEvcem.UseCaseBase, s.cemEvsoc, s.cemEvsoc.UseCaseBase,
+		s.evcc.UseCaseBase, s.evcem.UseCaseBase, s.evsoc.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+func (s *EVSOCSuite) Test_StateOfCharge() {
+	assert.Nil(s.T(), s.evsoc.UpdateStateOfCharge(45))
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventReceived(cemevsoc.DataUpdateStateOfCharge)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	soc, err := s.cemEvsoc.StateOfCharge(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 45.0, soc)
+}
@@ -6,10 +6,17 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
 	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/mock"
 )
 
 const (
@@ -133,3 +140,126 @@ func waitForAck(t *testing.T, msgCounterReference *model.MsgCounterType, writeHa
 		}
 	}
 }
+
+// create a service which is set up, but not started
+func newTestService(
+	t *testing.T,
+	deviceCategories []shipapi.DeviceCategoryType,
+	deviceType model.DeviceTypeType,
+	entityTypes []model.EntityTypeType,
+) api.ServiceInterface {
+	certificate, err := cert.CreateCertificate("test", "test", "DE", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configuration, err := api.NewConfiguration(
+		"test", "test", "test", "test",
+		deviceCategories, deviceType, entityTypes,
+		9999, certificate, time.Second*4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceHandler := mocks.NewServiceReaderInterface(t)
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	eebusService := service.NewService(configuration, serviceHandler)
+	if err := eebusService.Setup(); err != nil {
+		t.Fatal(err)
+	}
+
+	return eebusService
+}
+
+// in-memory connection delivering the SPINE messages of a local device
+// to the remote device representation of it on the other side
+//
+// the messages are delivered asynchronously and in order, just like a SHIP connection would
+type devicePipe struct {
+	reader   shipapi.ShipConnectionDataReaderInterface
+	messages chan []byte
+	done     chan struct{}
+}
+
+var _ shipapi.ShipConnectionDataWriterInterface = (*devicePipe)(nil)
+
+func (p *devicePipe) WriteShipMessageWithPayload(message []byte) {
+	select {
+	case p.messages <- message:
+	case <-p.done:
+	}
+}
+
+func (p *devicePipe) run() {
+	for {
+		select {
+		case message := <-p.messages:
+			p.reader.HandleShipPayloadMessage(message)
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// connect the local devices of two services without a SHIP connection,
+// which triggers the detailed discovery and use case discovery on both sides
+//
+// the connection is stopped when the test finished
+func connectServices(t *testing.T, serviceA, serviceB api.ServiceInterface) {
+	done := make(chan struct{})
+
+	pipeAToB := &devicePipe{messages: make(chan []byte, 1000), done: done}
+	pipeBToA := &devicePipe{messages: make(chan []byte, 1000), done: done}
+
+	skiA := serviceA.LocalService().SKI()
+	skiB := serviceB.LocalService().SKI()
+
+	// each pipe delivers to the representation of the sending device on the other side
+	pipeBToA.reader = serviceA.LocalDevice().SetupRemoteDevice(skiB, pipeAToB)
+	pipeAToB.reader = serviceB.LocalDevice().SetupRemoteDevice(skiA, pipeBToA)
+
+	var wg sync.WaitGroup
+	for _, pipe := range []*devicePipe{pipeAToB, pipeBToA} {
+		wg.Add(1)
+		go func(pipe *devicePipe) {
+			defer wg.Done()
+			pipe.run()
+		}(pipe)
+	}
+
+	t.Cleanup(func() {
+		close(done)
+		wg.Wait()
+	})
+}
+
+// remove event handlers, e.g. use cases, from the global SPINE events once the test finished,
+// so they do not receive the events of other tests
+//
+// has to be invoked before connectServices, so the handlers are removed after the connection is stopped
+func unsubscribeOnCleanup(t *testing.T, handlers ...spineapi.EventHandlerInterface) {
+	t.Cleanup(func() {
+		for _, handler := range handlers {
+			_ = spine.Events.Unsubscribe(handler)
+		}
+	})
+}
+
+// wait until the remote device subscribed to the node management of the local service
+//
+// entities added before, e.g. an EV entity, are otherwise not announced to the remote device
+// if they are added after the detailed discovery data was read but before the subscription was created
+func waitForNodeManagementSubscription(t *testing.T, localService api.ServiceInterface) {
+	localDevice := localService.LocalDevice()
+	address := localDevice.NodeManagement().Address()
+
+	for i := 0; i < 500; i++ {
+		if len(localDevice.SubscriptionManager().SubscriptionsOnFeature(*address)) > 0 {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	t.Fatal("node management subscription not available")
+}
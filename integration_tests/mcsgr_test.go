@@ -0,0 +1,178 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemmcsgr "github.com/enbility/eebus-go/usecases/cem/mcsgr"
+	csmcsgr "github.com/enbility/eebus-go/usecases/cs/mcsgr"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMCSGRSuite(t *testing.T) {
+	suite.Run(t, new(MCSGRSuite))
+}
+
+// the CEM controls the SG-Ready state of a heat pump
+type MCSGRSuite struct {
+	suite.Suite
+
+	cem *cemmcsgr.MCSGR
+	cs  *csmcsgr.MCSGR
+
+	cemSki, csSki string
+	csEntity      spineapi.EntityRemoteInterface
+
+	cemEvents, csEvents []api.EventType
+	mux                 sync.Mutex
+}
+
+func (s *MCSGRSuite) CemEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.csSki {
+		return
+	}
+
+	s.csEntity = entity
+	s.cemEvents = append(s.cemEvents, event)
+}
+
+func (s *MCSGRSuite) CsEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.cemSki {
+		return
+	}
+
+	s.csEvents = append(s.csEvents, event)
+}
+
+func (s *MCSGRSuite) eventReceived(events *[]api.EventType, event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(*events, event)
+}
+
+func (s *MCSGRSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.csEntity
+}
+
+func (s *MCSGRSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	csService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeHVAC},
+		model.DeviceTypeTypeHeatgenerationSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeHeatPumpAppliance})
+
+	s.mux.Lock()
+	s.cemSki = cemService.LocalService().SKI()
+	s.csSki = csService.LocalService().SKI()
+	s.cemEvents = nil
+	s.csEvents = nil
+	s.csEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemmcsgr.NewMCSGR(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.CemEvent)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.cs = csmcsgr.NewMCSGR(csService.LocalDevice().EntityForType(model.EntityTypeTypeHeatPumpAppliance), s.CsEvent)
+	s.cs.AddFeatures()
+	s.cs.AddUseCase()
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.cs, s.cs.UseCaseBase)
+	connectServices(s.T(), cemService, csService)
+	waitForNodeManagementSubscription(s.T(), csService)
+}
+
+func (s *MCSGRSuite) Test_WriteSGReadyState() {
+	assert.Eventually(s.T(), func() bool {
+		return s.eventReceived(&s.cemEvents, cemmcsgr.DataUpdateSGReadyState)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	states, err := s.cem.SupportedSGReadyStates(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.SGReadyStateType{
+		ucapi.SGReadyStateTypeBlocked,
+		ucapi.SGReadyStateTypeNormal,
+		ucapi.SGReadyStateTypeRecommendedIncrease,
+		ucapi.SGReadyStateTypeForcedIncrease,
+	}, states)
+
+	state, err := s.cem.SGReadyState(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeNormal, state)
+
+	// the controllable system approves the requested state
+	_, err = s.cem.WriteSGReadyState(entity, ucapi.SGReadyStateTypeRecommendedIncrease)
+	assert.Nil(s.T(), err)
+
+	var pending map[model.MsgCounterType]ucapi.SGReadyStateType
+	assert.Eventually(s.T(), func() bool {
+		pending = s.cs.PendingSGReadyStates()
+		return len(pending) == 1
+	}, time.Second*5, time.Millisecond*10)
+	assert.True(s.T(), s.eventReceived(&s.csEvents, csmcsgr.WriteApprovalRequired))
+
+	for msgCounter, requested := range pending {
+		assert.Equal(s.T(), ucapi.SGReadyStateTypeRecommendedIncrease, requested)
+		s.cs.ApproveOrDenySGReadyState(msgCounter, true, "")
+	}
+
+	assert.Eventually(s.T(), func() bool {
+		state, err := s.cem.SGReadyState(entity)
+		return err == nil && state == ucapi.SGReadyStateTypeRecommendedIncrease
+	}, time.Second*5, time.Millisecond*10)
+	assert.True(s.T(), s.eventReceived(&s.csEvents, csmcsgr.DataUpdateSGReadyState))
+
+	state, err = s.cs.SGReadyState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeRecommendedIncrease, state)
+
+	// the controllable system denies the requested state
+	_, err = s.cem.WriteSGReadyState(entity, ucapi.SGReadyStateTypeBlocked)
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		pending = s.cs.PendingSGReadyStates()
+		return len(pending) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	for msgCounter := range pending {
+		s.cs.ApproveOrDenySGReadyState(msgCounter, false, "not now")
+	}
+
+	state, err = s.cs.SGReadyState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeRecommendedIncrease, state)
+
+	// changes of the controllable system are reported to the CEM
+	assert.Nil(s.T(), s.cs.SetSGReadyState(ucapi.SGReadyStateTypeNormal))
+
+	assert.Eventually(s.T(), func() bool {
+		state, err := s.cem.SGReadyState(entity)
+		return err == nil && state == ucapi.SGReadyStateTypeNormal
+	}, time.Second*5, time.Millisecond*10)
+}
@@ -0,0 +1,150 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	gcpmgcp "github.com/enbility/eebus-go/usecases/gcp/mgcp"
+	mamgcp "github.com/enbility/eebus-go/usecases/ma/mgcp"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMGCPSuite(t *testing.T) {
+	suite.Run(t, new(MGCPSuite))
+}
+
+type MGCPSuite struct {
+	suite.Suite
+
+	monitoringAppliance *mamgcp.MGCP
+	gridConnectionPoint *gcpmgcp.MGCP
+
+	gridEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *MGCPSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = append(s.events, event)
+}
+
+func (s *MGCPSuite) eventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.events, event)
+}
+
+func (s *MGCPSuite) BeforeTest(suiteName, testName string) {
+	s.mux.Lock()
+	s.events = nil
+	s.mux.Unlock()
+
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	smgwService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeGridConnectionHub},
+		model.DeviceTypeTypeElectricitySupplySystem,
+		[]model.EntityTypeType{model.EntityTypeTypeGridConnectionPointOfPremises})
+
+	s.monitoringAppliance = mamgcp.NewMGCP(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.monitoringAppliance.AddFeatures()
+	s.monitoringAppliance.AddUseCase()
+
+	gcpEntity := smgwService.LocalDevice().EntityForType(model.EntityTypeTypeGridConnectionPointOfPremises)
+	s.gridConnectionPoint = gcpmgcp.NewMGCP(gcpEntity, nil, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.gridConnectionPoint.AddFeatures()
+	s.gridConnectionPoint.AddUseCase()
+
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdatePowerLimitationFactor(0.5))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdatePower(-2500))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdateEnergyFeedIn(8000))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdateEnergyConsumed(12000))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdateCurrentPerPhase([]float64{-1.5, -2.25, -1.25}))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdateVoltagePerPhase([]float64{230, 231, 229}))
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdateFrequency(50.1))
+
+	unsubscribeOnCleanup(s.T(),
+		s.monitoringAppliance, s.monitoringAppliance.UseCaseBase,
+		s.gridConnectionPoint.UseCaseBase)
+	connectServices(s.T(), cemService, smgwService)
+
+	// the frequency is the last value reported for the initially read measurement data
+	assert.Eventually(s.T(), func() bool {
+		return s.eventReceived(mamgcp.DataUpdateFrequency) &&
+			s.eventReceived(mamgcp.DataUpdatePowerLimitationFactor)
+	}, time.Second*5, time.Millisecond*10)
+
+	entities := s.monitoringAppliance.RemoteEntitiesScenarios()
+	if assert.Equal(s.T(), 1, len(entities)) {
+		s.gridEntity = entities[0].Entity
+	}
+}
+
+func (s *MGCPSuite) Test_Values() {
+	factor, err := s.monitoringAppliance.PowerLimitationFactor(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0.5, factor)
+
+	power, err := s.monitoringAppliance.Power(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -2500.0, power)
+
+	energyFeedIn, err := s.monitoringAppliance.EnergyFeedIn(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 8000.0, energyFeedIn)
+
+	energyConsumed, err := s.monitoringAppliance.EnergyConsumed(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 12000.0, energyConsumed)
+
+	currentPerPhase, err := s.monitoringAppliance.CurrentPerPhase(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-1.5, -2.25, -1.25}, currentPerPhase)
+
+	voltagePerPhase, err := s.monitoringAppliance.VoltagePerPhase(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 229}, voltagePerPhase)
+
+	frequency, err := s.monitoringAppliance.Frequency(s.gridEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.1, frequency)
+}
+
+func (s *MGCPSuite) Test_Notify() {
+	// updates are notified to the subscribed monitoring appliance
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdatePower(1500))
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.monitoringAppliance.Power(s.gridEntity)
+		return err == nil && value == 1500
+	}, time.Second*5, time.Millisecond*10)
+
+	assert.Nil(s.T(), s.gridConnectionPoint.UpdatePowerLimitationFactor(0.25))
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.monitoringAppliance.PowerLimitationFactor(s.gridEntity)
+		return err == nil && value == 0.25
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *MGCPSuite) Test_RemoteScenarios() {
+	// the monitoring appliance provides no server features, so all scenarios are available
+	assert.Eventually(s.T(), func() bool {
+		entities := s.gridConnectionPoint.RemoteEntitiesScenarios()
+		return len(entities) == 1 && slices.Equal([]uint{1, 2, 3, 4, 5, 6, 7}, entities[0].Scenarios)
+	}, time.Second*5, time.Millisecond*10)
+}
@@ -0,0 +1,148 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	mampc "github.com/enbility/eebus-go/usecases/ma/mpc"
+	mumpc "github.com/enbility/eebus-go/usecases/mu/mpc"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMPCSuite(t *testing.T) {
+	suite.Run(t, new(MPCSuite))
+}
+
+type MPCSuite struct {
+	suite.Suite
+
+	monitoringAppliance *mampc.MPC
+	monitoredUnit       *mumpc.MPC
+
+	monitoredEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *MPCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.events = append(s.events, event)
+}
+
+func (s *MPCSuite) eventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.events, event)
+}
+
+func (s *MPCSuite) BeforeTest(suiteName, testName string) {
+	s.mux.Lock()
+	s.events = nil
+	s.mux.Unlock()
+
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.monitoringAppliance = mampc.NewMPC(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.monitoringAppliance.AddFeatures()
+	s.monitoringAppliance.AddUseCase()
+
+	s.monitoredUnit = mumpc.NewMPC(evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.monitoredUnit.AddFeatures()
+	s.monitoredUnit.AddUseCase()
+
+	assert.Nil(s.T(), s.monitoredUnit.UpdatePower(1200))
+	assert.Nil(s.T(), s.monitoredUnit.UpdatePowerPerPhase([]float64{400, 500, 300}))
+	assert.Nil(s.T(), s.monitoredUnit.UpdateEnergyConsumed(15000))
+	assert.Nil(s.T(), s.monitoredUnit.UpdateEnergyProduced(300))
+	assert.Nil(s.T(), s.monitoredUnit.UpdateCurrentPerPhase([]float64{1.5, 2.25, 1.25}))
+	assert.Nil(s.T(), s.monitoredUnit.UpdateVoltagePerPhase([]float64{230, 231, 229}))
+	assert.Nil(s.T(), s.monitoredUnit.UpdateFrequency(50.1))
+
+	unsubscribeOnCleanup(s.T(),
+		s.monitoringAppliance, s.monitoringAppliance.UseCaseBase,
+		s.monitoredUnit.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+
+	// the frequency is the last value reported for the initially read measurement data
+	assert.Eventually(s.T(), func() bool {
+		return s.eventReceived(mampc.DataUpdateFrequency)
+	}, time.Second*5, time.Millisecond*10)
+
+	entities := s.monitoringAppliance.RemoteEntitiesScenarios()
+	if assert.Equal(s.T(), 1, len(entities)) {
+		s.monitoredEntity = entities[0].Entity
+	}
+}
+
+func (s *MPCSuite) Test_Values() {
+	power, err := s.monitoringAppliance.Power(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1200.0, power)
+
+	powerPerPhase, err := s.monitoringAppliance.PowerPerPhase(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{400, 500, 300}, powerPerPhase)
+
+	energyConsumed, err := s.monitoringAppliance.EnergyConsumed(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 15000.0, energyConsumed)
+
+	energyProduced, err := s.monitoringAppliance.EnergyProduced(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 300.0, energyProduced)
+
+	currentPerPhase, err := s.monitoringAppliance.CurrentPerPhase(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1.5, 2.25, 1.25}, currentPerPhase)
+
+	voltagePerPhase, err := s.monitoringAppliance.VoltagePerPhase(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 229}, voltagePerPhase)
+
+	frequency, err := s.monitoringAppliance.Frequency(s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 50.1, frequency)
+}
+
+func (s *MPCSuite) Test_Notify() {
+	// updates are notified to the subscribed monitoring appliance
+	assert.Nil(s.T(), s.monitoredUnit.UpdatePower(800))
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.monitoringAppliance.Power(s.monitoredEntity)
+		return err == nil && value == 800
+	}, time.Second*5, time.Millisecond*10)
+
+	assert.Nil(s.T(), s.monitoredUnit.UpdateVoltagePerPhase([]float64{225, 226, 227}))
+
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.monitoringAppliance.VoltagePerPhase(s.monitoredEntity)
+		return err == nil && slices.Equal(value, []float64{225, 226, 227})
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func (s *MPCSuite) Test_RemoteScenarios() {
+	// the monitoring appliance provides no server features, so all scenarios are available
+	assert.Eventually(s.T(), func() bool {
+		entities := s.monitoredUnit.RemoteEntitiesScenarios()
+		return len(entities) == 1 && slices.Equal([]uint{1, 2, 3, 4, 5}, entities[0].Scenarios)
+	}, time.Second*5, time.Millisecond*10)
+}
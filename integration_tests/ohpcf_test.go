@@ -0,0 +1,181 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemohpcf "github.com/enbility/eebus-go/usecases/cem/ohpcf"
+	compressorohpcf "github.com/enbility/eebus-go/usecases/compressor/ohpcf"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestOHPCFSuite(t *testing.T) {
+	suite.Run(t, new(OHPCFSuite))
+}
+
+// the CEM schedules the power sequences of a heat pump compressor
+type OHPCFSuite struct {
+	suite.Suite
+
+	cem        *cemohpcf.OHPCF
+	compressor *compressorohpcf.OHPCF
+
+	compressorSki    string
+	compressorEntity spineapi.EntityRemoteInterface
+
+	events    []api.EventType
+	scheduled []time.Time
+	cancelled []uint
+	mux       sync.Mutex
+}
+
+func (s *OHPCFSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.compressorSki {
+		return
+	}
+
+	s.compressorEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *OHPCFSuite) Schedule(sequenceId uint, startTime time.Time) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.scheduled = append(s.scheduled, startTime)
+}
+
+func (s *OHPCFSuite) Cancel(sequenceId uint) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.cancelled = append(s.cancelled, sequenceId)
+}
+
+func (s *OHPCFSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *OHPCFSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.compressorEntity
+}
+
+func (s *OHPCFSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	compressorService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeHVAC},
+		model.DeviceTypeTypeHeatgenerationSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCompressor})
+
+	s.mux.Lock()
+	s.compressorSki = compressorService.LocalService().SKI()
+	s.events = nil
+	s.scheduled = nil
+	s.cancelled = nil
+	s.compressorEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemohpcf.NewOHPCF(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.compressor = compressorohpcf.NewOHPCF(
+		compressorService.LocalDevice().EntityForType(model.EntityTypeTypeCompressor), nil, s.Schedule, s.Cancel)
+	s.compressor.AddFeatures()
+	s.compressor.AddUseCase()
+
+	_, err := s.compressor.AddAlternative([]ucapi.PowerSequence{
+		{
+			RemoteControllable: true,
+			Slots: []ucapi.PowerSequenceSlot{
+				{Duration: time.Hour, Power: 2000},
+				{Duration: time.Minute * 30, Power: 1000},
+			},
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.compressor.UseCaseBase)
+	connectServices(s.T(), cemService, compressorService)
+	waitForNodeManagementSubscription(s.T(), compressorService)
+}
+
+func (s *OHPCFSuite) Test_ScheduleSequence() {
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(cemohpcf.DataUpdateFlexibility, cemohpcf.DataUpdateState)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	sequences, err := s.cem.PowerSequences(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(sequences))
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, sequences[0].State)
+	assert.Equal(s.T(), 2, len(sequences[0].Slots))
+	assert.Equal(s.T(), 2000.0, sequences[0].Slots[0].Power)
+
+	startTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err = s.cem.ScheduleSequence(entity, sequences[0].Id, startTime)
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		state, err := s.cem.SequenceState(entity, sequences[0].Id)
+		return err == nil && state == model.PowerSequenceStateTypeScheduled
+	}, time.Second*5, time.Millisecond*10)
+
+	s.mux.Lock()
+	assert.Equal(s.T(), 1, len(s.scheduled))
+	assert.True(s.T(), startTime.Equal(s.scheduled[0]))
+	s.mux.Unlock()
+
+	sequences, err = s.cem.PowerSequences(entity)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), startTime.Equal(sequences[0].StartTime))
+
+	_, err = s.cem.CancelSequence(entity, sequences[0].Id)
+	assert.Nil(s.T(), err)
+
+	assert.Eventually(s.T(), func() bool {
+		state, err := s.cem.SequenceState(entity, sequences[0].Id)
+		return err == nil && state == model.PowerSequenceStateTypeInactive
+	}, time.Second*5, time.Millisecond*10)
+
+	s.mux.Lock()
+	assert.Equal(s.T(), []uint{sequences[0].Id}, s.cancelled)
+	s.mux.Unlock()
+
+	// the compressor reports the state of the running sequence
+	assert.Nil(s.T(), s.compressor.UpdateSequenceState(sequences[0].Id, model.PowerSequenceStateTypeRunning, 1))
+
+	assert.Eventually(s.T(), func() bool {
+		state, err := s.cem.SequenceState(entity, sequences[0].Id)
+		return err == nil && state == model.PowerSequenceStateTypeRunning
+	}, time.Second*5, time.Millisecond*10)
+}
@@ -0,0 +1,353 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemevcc "github.com/enbility/eebus-go/usecases/cem/evcc"
+	cemevcem "github.com/enbility/eebus-go/usecases/cem/evcem"
+	cemopev "github.com/enbility/eebus-go/usecases/cem/opev"
+	cemoscev "github.com/enbility/eebus-go/usecases/cem/oscev"
+	evevcc "github.com/enbility/eebus-go/usecases/ev/evcc"
+	evevcem "github.com/enbility/eebus-go/usecases/ev/evcem"
+	evopev "github.com/enbility/eebus-go/usecases/ev/opev"
+	evoscev "github.com/enbility/eebus-go/usecases/ev/oscev"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestOPEVSuite(t *testing.T) {
+	suite.Run(t, new(OPEVSuite))
+}
+
+// the CEM writes current limits with OPEV and OSCEV to the EV of an EVSE
+//
+// EVCC and EVCEM provide the permitted values and the current measurements the limits refer to
+type OPEVSuite struct {
+	suite.Suite
+
+	cemEvcc  *cemevcc.EVCC
+	cemEvcem *cemevcem.EVCEM
+	cemOpev  *cemopev.OPEV
+	cemOscev *cemoscev.OSCEV
+
+	evcc  *evevcc.EVCC
+	evcem *evevcem.EVCEM
+	opev  *evopev.OPEV
+	oscev *evoscev.OSCEV
+
+	cemSki   string
+	evseSki  string
+	evEntity spineapi.EntityRemoteInterface
+
+	cemEvents []api.EventType
+	evEvents  []api.EventType
+	mux       sync.Mutex
+}
+
+func (s *OPEVSuite) cemEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.evseSki {
+		return
+	}
+
+	s.evEntity = entity
+	s.cemEvents = append(s.cemEvents, event)
+}
+
+func (s *OPEVSuite) evEvent(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.cemSki {
+		return
+	}
+
+	s.evEvents = append(s.evEvents, event)
+}
+
+func (s *OPEVSuite) cemEventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.cemEvents, event)
+}
+
+func (s *OPEVSuite) evEventReceived(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return slices.Contains(s.evEvents, event)
+}
+
+func (s *OPEVSuite) resetEvents() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.cemEvents = nil
+	s.evEvents = nil
+}
+
+func (s *OPEVSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.evEntity
+}
+
+func (s *OPEVSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	evseService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE})
+
+	s.mux.Lock()
+	s.cemSki = cemService.LocalService().SKI()
+	s.evseSki = evseService.LocalService().SKI()
+	s.cemEvents = nil
+	s.evEvents = nil
+	s.evEntity = nil
+	s.mux.Unlock()
+
+	cemEntity := cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.cemEvcc = cemevcc.NewEVCC(cemService, cemEntity, s.cemEvent)
+	s.cemEvcc.AddFeatures()
+	s.cemEvcc.AddUseCase()
+	s.cemEvcem = cemevcem.NewEVCEM(cemService, cemEntity, s.cemEvent)
+	s.cemEvcem.AddFeatures()
+	s.cemEvcem.AddUseCase()
+	s.cemOpev = cemopev.NewOPEV(cemEntity, s.cemEvent)
+	s.cemOpev.AddFeatures()
+	s.cemOpev.AddUseCase()
+	s.cemOscev = cemoscev.NewOSCEV(cemEntity, s.cemEvent)
+	s.cemOscev.AddFeatures()
+	s.cemOscev.AddUseCase()
+
+	s.evcc = evevcc.NewEVCC(evseService, evseService.LocalDevice().EntityForType(model.EntityTypeTypeEVSE), nil)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+
+	s.evcem = evevcem.NewEVCEM(s.evcc.LocalEntity, nil, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.evcem.AddFeatures()
+	s.evcem.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.evcem)
+
+	s.opev = evopev.NewOPEV(s.evcc.LocalEntity, s.evEvent, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.opev.AddFeatures()
+	s.opev.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.opev)
+
+	s.oscev = evoscev.NewOSCEV(s.evcc.LocalEntity, s.evEvent, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.oscev.AddFeatures()
+	s.oscev.AddUseCase()
+	s.evcc.AddEVDataUseCase(s.oscev)
+
+	unsubscribeOnCleanup(s.T(),
+		s.cemEvcc, s.cemEvcc.UseCaseBase, s.cemEvcem, s.cemEvcem.UseCaseBase, s.cemOpev, s.cemOpev.UseCaseBase, s.cemOscev, s.cemOscev.UseCaseBase,
+		s.evcc.UseCaseBase, s.evcem.UseCaseBase, s.opev, s.opev.UseCaseBase, s.oscev, s.oscev.UseCaseBase)
+	connectServices(s.T(), cemService, evseService)
+	waitForNodeManagementSubscription(s.T(), evseService)
+}
+
+// connect the EV and wait until the CEM received its current limits
+func (s *OPEVSuite) connectEV() spineapi.EntityRemoteInterface {
+	assert.Nil(s.T(), s.opev.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0}))
+
+	s.evcc.EVConnected()
+
+	assert.Eventually(s.T(), func() bool {
+		return s.cemEventReceived(cemopev.UseCaseSupportUpdate) &&
+			s.cemEventReceived(cemoscev.UseCaseSupportUpdate)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	assert.Eventually(s.T(), func() bool {
+		_, maximum, _, err := s.cemOpev.CurrentLimits(entity)
+		if err != nil || !slices.Equal([]float64{16, 16, 16}, maximum) {
+			return false
+		}
+		obligations, err := s.cemOpev.LoadControlLimits(entity)
+		if err != nil || len(obligations) != 3 {
+			return false
+		}
+		recommendations, err := s.cemOscev.LoadControlLimits(entity)
+		return err == nil && len(recommendations) == 3
+	}, time.Second*5, time.Millisecond*10)
+
+	return entity
+}
+
+// approve or deny all pending write limits once they are available
+func (s *OPEVSuite) approvePending(
+	event api.EventType,
+	pending func() map[model.MsgCounterType][]ucapi.LoadLimitsPhase,
+	approveOrDeny func(model.MsgCounterType, bool, string),
+	approve bool,
+) map[model.MsgCounterType][]ucapi.LoadLimitsPhase {
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(event)
+	}, time.Second*5, time.Millisecond*10)
+
+	limits := pending()
+	for msgCounter := range limits {
+		approveOrDeny(msgCounter, approve, "limit not accepted")
+	}
+
+	return limits
+}
+
+// write limits and wait for the result
+func (s *OPEVSuite) writeLimits(
+	write func(spineapi.EntityRemoteInterface, []ucapi.LoadLimitsPhase, func(model.ResultDataType)) (*model.MsgCounterType, error),
+	entity spineapi.EntityRemoteInterface,
+	limits []ucapi.LoadLimitsPhase,
+) chan model.ResultDataType {
+	results := make(chan model.ResultDataType, 1)
+
+	_, err := write(entity, limits, func(result model.ResultDataType) {
+		results <- result
+	})
+	assert.Nil(s.T(), err)
+
+	return results
+}
+
+func (s *OPEVSuite) waitForResult(results chan model.ResultDataType) model.ResultDataType {
+	select {
+	case result := <-results:
+		return result
+	case <-time.After(time.Second * 5):
+		s.T().Fatal("no result received")
+	}
+
+	return model.ResultDataType{}
+}
+
+func phaseLimits(values ...float64) []ucapi.LoadLimitsPhase {
+	var result []ucapi.LoadLimitsPhase
+	for index, value := range values {
+		result = append(result, ucapi.LoadLimitsPhase{
+			Phase:        ucapi.PhaseNameMapping[index],
+			IsChangeable: true,
+			IsActive:     true,
+			Value:        value,
+		})
+	}
+
+	return result
+}
+
+func (s *OPEVSuite) Test_WriteLimits() {
+	entity := s.connectEV()
+
+	limits, err := s.opev.EffectiveCurrentLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16, 16, 16}, limits)
+
+	// obligations
+	results := s.writeLimits(s.cemOpev.WriteLoadControlLimits, entity, phaseLimits(10, 12, 16))
+
+	pending := s.approvePending(evopev.WriteApprovalRequired,
+		s.opev.PendingLoadControlLimits, s.opev.ApproveOrDenyLoadControlLimits, true)
+	assert.Equal(s.T(), 1, len(pending))
+	for _, item := range pending {
+		assert.Equal(s.T(), phaseLimits(10, 12, 16), item)
+	}
+
+	result := s.waitForResult(results)
+	assert.Equal(s.T(), model.ErrorNumberType(0), *result.ErrorNumber)
+
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(evopev.DataUpdateLimit)
+	}, time.Second*5, time.Millisecond*10)
+	assert.False(s.T(), s.evEventReceived(evoscev.DataUpdateLimit))
+
+	obligations, err := s.opev.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10.0, obligations[0].Value)
+	assert.True(s.T(), obligations[0].IsActive)
+
+	assert.Eventually(s.T(), func() bool {
+		limits, err := s.cemOpev.LoadControlLimits(entity)
+		return err == nil && len(limits) == 3 && limits[0].Value == 10 && limits[1].Value == 12
+	}, time.Second*5, time.Millisecond*10)
+
+	// recommendations
+	s.resetEvents()
+	results = s.writeLimits(s.cemOscev.WriteLoadControlLimits, entity, phaseLimits(8, 14, 16))
+
+	s.approvePending(evoscev.WriteApprovalRequired,
+		s.oscev.PendingLoadControlLimits, s.oscev.ApproveOrDenyLoadControlLimits, true)
+
+	result = s.waitForResult(results)
+	assert.Equal(s.T(), model.ErrorNumberType(0), *result.ErrorNumber)
+
+	assert.Eventually(s.T(), func() bool {
+		return s.evEventReceived(evoscev.DataUpdateLimit)
+	}, time.Second*5, time.Millisecond*10)
+	assert.False(s.T(), s.evEventReceived(evopev.WriteApprovalRequired))
+
+	// the recommendations do not exceed the obligations
+	limits, err = s.oscev.EffectiveCurrentLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{8, 12, 16}, limits)
+}
+
+func (s *OPEVSuite) Test_DenyLimits() {
+	entity := s.connectEV()
+
+	results := s.writeLimits(s.cemOpev.WriteLoadControlLimits, entity, phaseLimits(6, 6, 6))
+
+	s.approvePending(evopev.WriteApprovalRequired,
+		s.opev.PendingLoadControlLimits, s.opev.ApproveOrDenyLoadControlLimits, false)
+
+	result := s.waitForResult(results)
+	assert.Equal(s.T(), model.ErrorNumberType(7), *result.ErrorNumber)
+	assert.Equal(s.T(), model.DescriptionType("limit not accepted"), *result.Description)
+
+	obligations, err := s.opev.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), obligations[0].IsActive)
+
+	limits, err := s.opev.EffectiveCurrentLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16, 16, 16}, limits)
+}
+
+func (s *OPEVSuite) Test_Disconnect() {
+	entity := s.connectEV()
+
+	results := s.writeLimits(s.cemOpev.WriteLoadControlLimits, entity, phaseLimits(10, 10, 10))
+	s.approvePending(evopev.WriteApprovalRequired,
+		s.opev.PendingLoadControlLimits, s.opev.ApproveOrDenyLoadControlLimits, true)
+	s.waitForResult(results)
+
+	s.evcc.EVDisconnected()
+
+	// the limits of the previous EV are not active anymore
+	obligations, err := s.opev.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	for _, limit := range obligations {
+		assert.False(s.T(), limit.IsActive)
+	}
+
+	// and the permitted values have to be set for the next EV
+	_, err = s.opev.EffectiveCurrentLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+}
@@ -0,0 +1,140 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	bsvabd "github.com/enbility/eebus-go/usecases/bs/vabd"
+	cemvabd "github.com/enbility/eebus-go/usecases/cem/vabd"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestVABDSuite(t *testing.T) {
+	suite.Run(t, new(VABDSuite))
+}
+
+// the CEM reads the aggregated data of a battery system
+type VABDSuite struct {
+	suite.Suite
+
+	cem     *cemvabd.VABD
+	battery *bsvabd.VABD
+
+	batterySki    string
+	batteryEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *VABDSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.batterySki {
+		return
+	}
+
+	s.batteryEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *VABDSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *VABDSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.batteryEntity
+}
+
+func (s *VABDSuite) BeforeTest(suiteName, testName string) {
+	cemService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	batteryService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeInverter},
+		model.DeviceTypeTypeInverter,
+		[]model.EntityTypeType{model.EntityTypeTypeElectricityStorageSystem})
+
+	s.mux.Lock()
+	s.batterySki = batteryService.LocalService().SKI()
+	s.events = nil
+	s.batteryEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemvabd.NewVABD(cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.battery = bsvabd.NewVABD(batteryService.LocalDevice().EntityForType(model.EntityTypeTypeElectricityStorageSystem), nil)
+	s.battery.AddFeatures()
+	s.battery.AddUseCase()
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.battery.UseCaseBase)
+	connectServices(s.T(), cemService, batteryService)
+	waitForNodeManagementSubscription(s.T(), batteryService)
+}
+
+func (s *VABDSuite) Test_BatteryPacks() {
+	assert.Nil(s.T(), s.battery.UpdateBatteryPacks([]ucapi.BatteryPackData{
+		{Power: -1500, EnergyCharged: 1000, EnergyDischarged: 400, StateOfCharge: 40, Capacity: 5000},
+		{Power: -500, EnergyCharged: 2000, EnergyDischarged: 600, StateOfCharge: 70, Capacity: 5000},
+	}))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemvabd.DataUpdatePower,
+			cemvabd.DataUpdateEnergyCharged,
+			cemvabd.DataUpdateEnergyDischarged,
+			cemvabd.DataUpdateStateOfCharge,
+		)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	// discharging is reported with negative values
+	power, err := s.cem.Power(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), -2000.0, power)
+
+	charged, err := s.cem.EnergyCharged(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3000.0, charged)
+
+	discharged, err := s.cem.EnergyDischarged(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, discharged)
+
+	soc, err := s.cem.StateOfCharge(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 55.0, soc)
+
+	// updates are notified to the subscribed CEM
+	assert.Nil(s.T(), s.battery.UpdatePower(3000))
+	assert.Eventually(s.T(), func() bool {
+		value, err := s.cem.Power(entity)
+		return err == nil && value == 3000
+	}, time.Second*5, time.Millisecond*10)
+}
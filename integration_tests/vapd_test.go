@@ -0,0 +1,152 @@
+package integrationtests
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	cemvapd "github.com/enbility/eebus-go/usecases/cem/vapd"
+	pvsvapd "github.com/enbility/eebus-go/usecases/pvs/vapd"
+	shipapi "github.com/enbility/ship-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestVAPDSuite(t *testing.T) {
+	suite.Run(t, new(VAPDSuite))
+}
+
+// the CEM reads the aggregated data of a PV system
+type VAPDSuite struct {
+	suite.Suite
+
+	cemService api.ServiceInterface
+	cem        *cemvapd.VAPD
+	pv         *pvsvapd.VAPD
+
+	pvSki    string
+	pvEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+	mux    sync.Mutex
+}
+
+func (s *VAPDSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// ignore late events of the services of previous tests
+	if ski != s.pvSki {
+		return
+	}
+
+	s.pvEntity = entity
+	s.events = append(s.events, event)
+}
+
+func (s *VAPDSuite) eventsReceived(events ...api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, event := range events {
+		if !slices.Contains(s.events, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *VAPDSuite) connectedEntity() spineapi.EntityRemoteInterface {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.pvEntity
+}
+
+func (s *VAPDSuite) BeforeTest(suiteName, testName string) {
+	s.cemService = newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM})
+	pvService := newTestService(s.T(),
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeInverter},
+		model.DeviceTypeTypeInverter,
+		[]model.EntityTypeType{model.EntityTypeTypePVSystem})
+
+	s.mux.Lock()
+	s.pvSki = pvService.LocalService().SKI()
+	s.events = nil
+	s.pvEntity = nil
+	s.mux.Unlock()
+
+	s.cem = cemvapd.NewVAPD(s.cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM), s.Event)
+	s.cem.AddFeatures()
+	s.cem.AddUseCase()
+
+	s.pv = pvsvapd.NewVAPD(pvService.LocalDevice().EntityForType(model.EntityTypeTypePVSystem), nil)
+	s.pv.AddFeatures()
+	s.pv.AddUseCase()
+
+	unsubscribeOnCleanup(s.T(), s.cem, s.cem.UseCaseBase, s.pv.UseCaseBase)
+	connectServices(s.T(), s.cemService, pvService)
+	waitForNodeManagementSubscription(s.T(), pvService)
+}
+
+func (s *VAPDSuite) Test_Inverters() {
+	assert.Nil(s.T(), s.pv.UpdateInverters([]ucapi.PVInverterData{
+		{Power: 3000, PVYieldTotal: 50000, PowerNominalPeak: 6000},
+		{Power: 1500, PVYieldTotal: 20000, PowerNominalPeak: 4000},
+	}))
+
+	assert.Eventually(s.T(), func() bool {
+		return s.eventsReceived(
+			cemvapd.DataUpdatePowerNominalPeak,
+			cemvapd.DataUpdatePower,
+			cemvapd.DataUpdatePVYieldTotal,
+		)
+	}, time.Second*5, time.Millisecond*10)
+
+	entity := s.connectedEntity()
+
+	peak, err := s.cem.PowerNominalPeak(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10000.0, peak)
+
+	// production is reported with positive values
+	power, err := s.cem.Power(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4500.0, power)
+
+	yield, err := s.cem.PVYieldTotal(entity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 70000.0, yield)
+
+	// the power measurement refers to an electrical connection with production as positive direction
+	cemEntity := s.cemService.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	measurement, err := client.NewMeasurement(cemEntity, entity)
+	assert.Nil(s.T(), err)
+	ec, err := client.NewElectricalConnection(cemEntity, entity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(model.ScopeTypeTypeACPowerTotal),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descs))
+
+	assert.Eventually(s.T(), func() bool {
+		desc, err := ec.GetDescriptionForParameterDescriptionFilter(model.ElectricalConnectionParameterDescriptionDataType{
+			MeasurementId: descs[0].MeasurementId,
+		})
+		return err == nil && desc.PositiveEnergyDirection != nil &&
+			*desc.PositiveEnergyDirection == model.EnergyDirectionTypeProduce
+	}, time.Second*5, time.Millisecond*10)
+}
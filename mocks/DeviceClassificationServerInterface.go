@@ -1,8 +1,13 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	api "github.com/enbility/eebus-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+)
 
 // DeviceClassificationServerInterface is an autogenerated mock type for the DeviceClassificationServerInterface type
 type DeviceClassificationServerInterface struct {
@@ -17,6 +22,129 @@ func (_m *DeviceClassificationServerInterface) EXPECT() *DeviceClassificationSer
 	return &DeviceClassificationServerInterface_Expecter{mock: &_m.Mock}
 }
 
+// GetManufacturerDetails provides a mock function with given fields:
+func (_m *DeviceClassificationServerInterface) GetManufacturerDetails() (*model.DeviceClassificationManufacturerDataType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetManufacturerDetails")
+	}
+
+	var r0 *model.DeviceClassificationManufacturerDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*model.DeviceClassificationManufacturerDataType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *model.DeviceClassificationManufacturerDataType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DeviceClassificationManufacturerDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeviceClassificationServerInterface_GetManufacturerDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetManufacturerDetails'
+type DeviceClassificationServerInterface_GetManufacturerDetails_Call struct {
+	*mock.Call
+}
+
+// GetManufacturerDetails is a helper method to define mock.On call
+func (_e *DeviceClassificationServerInterface_Expecter) GetManufacturerDetails() *DeviceClassificationServerInterface_GetManufacturerDetails_Call {
+	return &DeviceClassificationServerInterface_GetManufacturerDetails_Call{Call: _e.mock.On("GetManufacturerDetails")}
+}
+
+func (_c *DeviceClassificationServerInterface_GetManufacturerDetails_Call) Run(run func()) *DeviceClassificationServerInterface_GetManufacturerDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_GetManufacturerDetails_Call) Return(_a0 *model.DeviceClassificationManufacturerDataType, _a1 error) *DeviceClassificationServerInterface_GetManufacturerDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_GetManufacturerDetails_Call) RunAndReturn(run func() (*model.DeviceClassificationManufacturerDataType, error)) *DeviceClassificationServerInterface_GetManufacturerDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetManufacturerData provides a mock function with given fields: data
+func (_m *DeviceClassificationServerInterface) SetManufacturerData(data api.ManufacturerData) {
+	_m.Called(data)
+}
+
+// DeviceClassificationServerInterface_SetManufacturerData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetManufacturerData'
+type DeviceClassificationServerInterface_SetManufacturerData_Call struct {
+	*mock.Call
+}
+
+// SetManufacturerData is a helper method to define mock.On call
+//   - data api.ManufacturerData
+func (_e *DeviceClassificationServerInterface_Expecter) SetManufacturerData(data interface{}) *DeviceClassificationServerInterface_SetManufacturerData_Call {
+	return &DeviceClassificationServerInterface_SetManufacturerData_Call{Call: _e.mock.On("SetManufacturerData", data)}
+}
+
+func (_c *DeviceClassificationServerInterface_SetManufacturerData_Call) Run(run func(data api.ManufacturerData)) *DeviceClassificationServerInterface_SetManufacturerData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ManufacturerData))
+	})
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_SetManufacturerData_Call) Return() *DeviceClassificationServerInterface_SetManufacturerData_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_SetManufacturerData_Call) RunAndReturn(run func(api.ManufacturerData)) *DeviceClassificationServerInterface_SetManufacturerData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserData provides a mock function with given fields: data
+func (_m *DeviceClassificationServerInterface) SetUserData(data *model.DeviceClassificationUserDataType) {
+	_m.Called(data)
+}
+
+// DeviceClassificationServerInterface_SetUserData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserData'
+type DeviceClassificationServerInterface_SetUserData_Call struct {
+	*mock.Call
+}
+
+// SetUserData is a helper method to define mock.On call
+//   - data *model.DeviceClassificationUserDataType
+func (_e *DeviceClassificationServerInterface_Expecter) SetUserData(data interface{}) *DeviceClassificationServerInterface_SetUserData_Call {
+	return &DeviceClassificationServerInterface_SetUserData_Call{Call: _e.mock.On("SetUserData", data)}
+}
+
+func (_c *DeviceClassificationServerInterface_SetUserData_Call) Run(run func(data *model.DeviceClassificationUserDataType)) *DeviceClassificationServerInterface_SetUserData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.DeviceClassificationUserDataType))
+	})
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_SetUserData_Call) Return() *DeviceClassificationServerInterface_SetUserData_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DeviceClassificationServerInterface_SetUserData_Call) RunAndReturn(run func(*model.DeviceClassificationUserDataType)) *DeviceClassificationServerInterface_SetUserData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewDeviceClassificationServerInterface creates a new instance of DeviceClassificationServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDeviceClassificationServerInterface(t interface {
@@ -53,6 +53,40 @@ func (_c *DeviceDiagnosisServerInterface_SetLocalOperatingState_Call) RunAndRetu
 	return _c
 }
 
+// SetLocalOperatingStateWithLastErrorCode provides a mock function with given fields: operatingState, lastErrorCode
+func (_m *DeviceDiagnosisServerInterface) SetLocalOperatingStateWithLastErrorCode(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string) {
+	_m.Called(operatingState, lastErrorCode)
+}
+
+// DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLocalOperatingStateWithLastErrorCode'
+type DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call struct {
+	*mock.Call
+}
+
+// SetLocalOperatingStateWithLastErrorCode is a helper method to define mock.On call
+//   - operatingState model.DeviceDiagnosisOperatingStateType
+//   - lastErrorCode string
+func (_e *DeviceDiagnosisServerInterface_Expecter) SetLocalOperatingStateWithLastErrorCode(operatingState interface{}, lastErrorCode interface{}) *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call {
+	return &DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call{Call: _e.mock.On("SetLocalOperatingStateWithLastErrorCode", operatingState, lastErrorCode)}
+}
+
+func (_c *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call) Run(run func(operatingState model.DeviceDiagnosisOperatingStateType, lastErrorCode string)) *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.DeviceDiagnosisOperatingStateType), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call) Return() *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call) RunAndReturn(run func(model.DeviceDiagnosisOperatingStateType, string)) *DeviceDiagnosisServerInterface_SetLocalOperatingStateWithLastErrorCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetLocalState provides a mock function with given fields: statetate
 func (_m *DeviceDiagnosisServerInterface) SetLocalState(statetate *model.DeviceDiagnosisStateDataType) {
 	_m.Called(statetate)
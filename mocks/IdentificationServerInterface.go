@@ -1,8 +1,11 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	model "github.com/enbility/spine-go/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // IdentificationServerInterface is an autogenerated mock type for the IdentificationServerInterface type
 type IdentificationServerInterface struct {
@@ -17,6 +20,425 @@ func (_m *IdentificationServerInterface) EXPECT() *IdentificationServerInterface
 	return &IdentificationServerInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddIdentification provides a mock function with given fields: identificationType, value
+func (_m *IdentificationServerInterface) AddIdentification(identificationType model.IdentificationTypeType, value string) *model.IdentificationIdType {
+	ret := _m.Called(identificationType, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIdentification")
+	}
+
+	var r0 *model.IdentificationIdType
+	if rf, ok := ret.Get(0).(func(model.IdentificationTypeType, string) *model.IdentificationIdType); ok {
+		r0 = rf(identificationType, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdentificationIdType)
+		}
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_AddIdentification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddIdentification'
+type IdentificationServerInterface_AddIdentification_Call struct {
+	*mock.Call
+}
+
+// AddIdentification is a helper method to define mock.On call
+//   - identificationType model.IdentificationTypeType
+//   - value string
+func (_e *IdentificationServerInterface_Expecter) AddIdentification(identificationType interface{}, value interface{}) *IdentificationServerInterface_AddIdentification_Call {
+	return &IdentificationServerInterface_AddIdentification_Call{Call: _e.mock.On("AddIdentification", identificationType, value)}
+}
+
+func (_c *IdentificationServerInterface_AddIdentification_Call) Run(run func(identificationType model.IdentificationTypeType, value string)) *IdentificationServerInterface_AddIdentification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.IdentificationTypeType), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddIdentification_Call) Return(_a0 *model.IdentificationIdType) *IdentificationServerInterface_AddIdentification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddIdentification_Call) RunAndReturn(run func(model.IdentificationTypeType, string) *model.IdentificationIdType) *IdentificationServerInterface_AddIdentification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddMacAddress provides a mock function with given fields: address
+func (_m *IdentificationServerInterface) AddMacAddress(address string) *model.IdentificationIdType {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMacAddress")
+	}
+
+	var r0 *model.IdentificationIdType
+	if rf, ok := ret.Get(0).(func(string) *model.IdentificationIdType); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdentificationIdType)
+		}
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_AddMacAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMacAddress'
+type IdentificationServerInterface_AddMacAddress_Call struct {
+	*mock.Call
+}
+
+// AddMacAddress is a helper method to define mock.On call
+//   - address string
+func (_e *IdentificationServerInterface_Expecter) AddMacAddress(address interface{}) *IdentificationServerInterface_AddMacAddress_Call {
+	return &IdentificationServerInterface_AddMacAddress_Call{Call: _e.mock.On("AddMacAddress", address)}
+}
+
+func (_c *IdentificationServerInterface_AddMacAddress_Call) Run(run func(address string)) *IdentificationServerInterface_AddMacAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddMacAddress_Call) Return(_a0 *model.IdentificationIdType) *IdentificationServerInterface_AddMacAddress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddMacAddress_Call) RunAndReturn(run func(string) *model.IdentificationIdType) *IdentificationServerInterface_AddMacAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRfidTag provides a mock function with given fields: tag
+func (_m *IdentificationServerInterface) AddRfidTag(tag string) *model.IdentificationIdType {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRfidTag")
+	}
+
+	var r0 *model.IdentificationIdType
+	if rf, ok := ret.Get(0).(func(string) *model.IdentificationIdType); ok {
+		r0 = rf(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IdentificationIdType)
+		}
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_AddRfidTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRfidTag'
+type IdentificationServerInterface_AddRfidTag_Call struct {
+	*mock.Call
+}
+
+// AddRfidTag is a helper method to define mock.On call
+//   - tag string
+func (_e *IdentificationServerInterface_Expecter) AddRfidTag(tag interface{}) *IdentificationServerInterface_AddRfidTag_Call {
+	return &IdentificationServerInterface_AddRfidTag_Call{Call: _e.mock.On("AddRfidTag", tag)}
+}
+
+func (_c *IdentificationServerInterface_AddRfidTag_Call) Run(run func(tag string)) *IdentificationServerInterface_AddRfidTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddRfidTag_Call) Return(_a0 *model.IdentificationIdType) *IdentificationServerInterface_AddRfidTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_AddRfidTag_Call) RunAndReturn(run func(string) *model.IdentificationIdType) *IdentificationServerInterface_AddRfidTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckEventPayloadDataForFilter provides a mock function with given fields: payloadData
+func (_m *IdentificationServerInterface) CheckEventPayloadDataForFilter(payloadData interface{}) bool {
+	ret := _m.Called(payloadData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckEventPayloadDataForFilter")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(interface{}) bool); ok {
+		r0 = rf(payloadData)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_CheckEventPayloadDataForFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckEventPayloadDataForFilter'
+type IdentificationServerInterface_CheckEventPayloadDataForFilter_Call struct {
+	*mock.Call
+}
+
+// CheckEventPayloadDataForFilter is a helper method to define mock.On call
+//   - payloadData interface{}
+func (_e *IdentificationServerInterface_Expecter) CheckEventPayloadDataForFilter(payloadData interface{}) *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call {
+	return &IdentificationServerInterface_CheckEventPayloadDataForFilter_Call{Call: _e.mock.On("CheckEventPayloadDataForFilter", payloadData)}
+}
+
+func (_c *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call) Run(run func(payloadData interface{})) *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call) Return(_a0 bool) *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call) RunAndReturn(run func(interface{}) bool) *IdentificationServerInterface_CheckEventPayloadDataForFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearIdentifications provides a mock function with given fields:
+func (_m *IdentificationServerInterface) ClearIdentifications() {
+	_m.Called()
+}
+
+// IdentificationServerInterface_ClearIdentifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearIdentifications'
+type IdentificationServerInterface_ClearIdentifications_Call struct {
+	*mock.Call
+}
+
+// ClearIdentifications is a helper method to define mock.On call
+func (_e *IdentificationServerInterface_Expecter) ClearIdentifications() *IdentificationServerInterface_ClearIdentifications_Call {
+	return &IdentificationServerInterface_ClearIdentifications_Call{Call: _e.mock.On("ClearIdentifications")}
+}
+
+func (_c *IdentificationServerInterface_ClearIdentifications_Call) Run(run func()) *IdentificationServerInterface_ClearIdentifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_ClearIdentifications_Call) Return() *IdentificationServerInterface_ClearIdentifications_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *IdentificationServerInterface_ClearIdentifications_Call) RunAndReturn(run func()) *IdentificationServerInterface_ClearIdentifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDataForFilter provides a mock function with given fields: filter
+func (_m *IdentificationServerInterface) GetDataForFilter(filter model.IdentificationDataType) ([]model.IdentificationDataType, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataForFilter")
+	}
+
+	var r0 []model.IdentificationDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.IdentificationDataType) ([]model.IdentificationDataType, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(model.IdentificationDataType) []model.IdentificationDataType); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IdentificationDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.IdentificationDataType) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IdentificationServerInterface_GetDataForFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataForFilter'
+type IdentificationServerInterface_GetDataForFilter_Call struct {
+	*mock.Call
+}
+
+// GetDataForFilter is a helper method to define mock.On call
+//   - filter model.IdentificationDataType
+func (_e *IdentificationServerInterface_Expecter) GetDataForFilter(filter interface{}) *IdentificationServerInterface_GetDataForFilter_Call {
+	return &IdentificationServerInterface_GetDataForFilter_Call{Call: _e.mock.On("GetDataForFilter", filter)}
+}
+
+func (_c *IdentificationServerInterface_GetDataForFilter_Call) Run(run func(filter model.IdentificationDataType)) *IdentificationServerInterface_GetDataForFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.IdentificationDataType))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_GetDataForFilter_Call) Return(_a0 []model.IdentificationDataType, _a1 error) *IdentificationServerInterface_GetDataForFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_GetDataForFilter_Call) RunAndReturn(run func(model.IdentificationDataType) ([]model.IdentificationDataType, error)) *IdentificationServerInterface_GetDataForFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveIdentification provides a mock function with given fields: identificationId
+func (_m *IdentificationServerInterface) RemoveIdentification(identificationId model.IdentificationIdType) error {
+	ret := _m.Called(identificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIdentification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.IdentificationIdType) error); ok {
+		r0 = rf(identificationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_RemoveIdentification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveIdentification'
+type IdentificationServerInterface_RemoveIdentification_Call struct {
+	*mock.Call
+}
+
+// RemoveIdentification is a helper method to define mock.On call
+//   - identificationId model.IdentificationIdType
+func (_e *IdentificationServerInterface_Expecter) RemoveIdentification(identificationId interface{}) *IdentificationServerInterface_RemoveIdentification_Call {
+	return &IdentificationServerInterface_RemoveIdentification_Call{Call: _e.mock.On("RemoveIdentification", identificationId)}
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentification_Call) Run(run func(identificationId model.IdentificationIdType)) *IdentificationServerInterface_RemoveIdentification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.IdentificationIdType))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentification_Call) Return(_a0 error) *IdentificationServerInterface_RemoveIdentification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentification_Call) RunAndReturn(run func(model.IdentificationIdType) error) *IdentificationServerInterface_RemoveIdentification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveIdentificationsOfType provides a mock function with given fields: identificationType
+func (_m *IdentificationServerInterface) RemoveIdentificationsOfType(identificationType model.IdentificationTypeType) error {
+	ret := _m.Called(identificationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIdentificationsOfType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.IdentificationTypeType) error); ok {
+		r0 = rf(identificationType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_RemoveIdentificationsOfType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveIdentificationsOfType'
+type IdentificationServerInterface_RemoveIdentificationsOfType_Call struct {
+	*mock.Call
+}
+
+// RemoveIdentificationsOfType is a helper method to define mock.On call
+//   - identificationType model.IdentificationTypeType
+func (_e *IdentificationServerInterface_Expecter) RemoveIdentificationsOfType(identificationType interface{}) *IdentificationServerInterface_RemoveIdentificationsOfType_Call {
+	return &IdentificationServerInterface_RemoveIdentificationsOfType_Call{Call: _e.mock.On("RemoveIdentificationsOfType", identificationType)}
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentificationsOfType_Call) Run(run func(identificationType model.IdentificationTypeType)) *IdentificationServerInterface_RemoveIdentificationsOfType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.IdentificationTypeType))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentificationsOfType_Call) Return(_a0 error) *IdentificationServerInterface_RemoveIdentificationsOfType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_RemoveIdentificationsOfType_Call) RunAndReturn(run func(model.IdentificationTypeType) error) *IdentificationServerInterface_RemoveIdentificationsOfType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetIdentifications provides a mock function with given fields: identifications
+func (_m *IdentificationServerInterface) SetIdentifications(identifications []model.IdentificationDataType) error {
+	ret := _m.Called(identifications)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIdentifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.IdentificationDataType) error); ok {
+		r0 = rf(identifications)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdentificationServerInterface_SetIdentifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetIdentifications'
+type IdentificationServerInterface_SetIdentifications_Call struct {
+	*mock.Call
+}
+
+// SetIdentifications is a helper method to define mock.On call
+//   - identifications []model.IdentificationDataType
+func (_e *IdentificationServerInterface_Expecter) SetIdentifications(identifications interface{}) *IdentificationServerInterface_SetIdentifications_Call {
+	return &IdentificationServerInterface_SetIdentifications_Call{Call: _e.mock.On("SetIdentifications", identifications)}
+}
+
+func (_c *IdentificationServerInterface_SetIdentifications_Call) Run(run func(identifications []model.IdentificationDataType)) *IdentificationServerInterface_SetIdentifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.IdentificationDataType))
+	})
+	return _c
+}
+
+func (_c *IdentificationServerInterface_SetIdentifications_Call) Return(_a0 error) *IdentificationServerInterface_SetIdentifications_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdentificationServerInterface_SetIdentifications_Call) RunAndReturn(run func([]model.IdentificationDataType) error) *IdentificationServerInterface_SetIdentifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIdentificationServerInterface creates a new instance of IdentificationServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIdentificationServerInterface(t interface {
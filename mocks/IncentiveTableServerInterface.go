@@ -1,8 +1,13 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	model "github.com/enbility/spine-go/model"
+	mock "github.com/stretchr/testify/mock"
+
+	spine_goapi "github.com/enbility/spine-go/api"
+)
 
 // IncentiveTableServerInterface is an autogenerated mock type for the IncentiveTableServerInterface type
 type IncentiveTableServerInterface struct {
@@ -17,6 +22,733 @@ func (_m *IncentiveTableServerInterface) EXPECT() *IncentiveTableServerInterface
 	return &IncentiveTableServerInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddBoundary provides a mock function with given fields: tariffId, tierId, description
+func (_m *IncentiveTableServerInterface) AddBoundary(tariffId model.TariffIdType, tierId model.TierIdType, description model.TierBoundaryDescriptionDataType) *model.TierBoundaryIdType {
+	ret := _m.Called(tariffId, tierId, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBoundary")
+	}
+
+	var r0 *model.TierBoundaryIdType
+	if rf, ok := ret.Get(0).(func(model.TariffIdType, model.TierIdType, model.TierBoundaryDescriptionDataType) *model.TierBoundaryIdType); ok {
+		r0 = rf(tariffId, tierId, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TierBoundaryIdType)
+		}
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_AddBoundary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBoundary'
+type IncentiveTableServerInterface_AddBoundary_Call struct {
+	*mock.Call
+}
+
+// AddBoundary is a helper method to define mock.On call
+//   - tariffId model.TariffIdType
+//   - tierId model.TierIdType
+//   - description model.TierBoundaryDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) AddBoundary(tariffId interface{}, tierId interface{}, description interface{}) *IncentiveTableServerInterface_AddBoundary_Call {
+	return &IncentiveTableServerInterface_AddBoundary_Call{Call: _e.mock.On("AddBoundary", tariffId, tierId, description)}
+}
+
+func (_c *IncentiveTableServerInterface_AddBoundary_Call) Run(run func(tariffId model.TariffIdType, tierId model.TierIdType, description model.TierBoundaryDescriptionDataType)) *IncentiveTableServerInterface_AddBoundary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffIdType), args[1].(model.TierIdType), args[2].(model.TierBoundaryDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddBoundary_Call) Return(_a0 *model.TierBoundaryIdType) *IncentiveTableServerInterface_AddBoundary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddBoundary_Call) RunAndReturn(run func(model.TariffIdType, model.TierIdType, model.TierBoundaryDescriptionDataType) *model.TierBoundaryIdType) *IncentiveTableServerInterface_AddBoundary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddIncentive provides a mock function with given fields: tariffId, tierId, description
+func (_m *IncentiveTableServerInterface) AddIncentive(tariffId model.TariffIdType, tierId model.TierIdType, description model.IncentiveDescriptionDataType) *model.IncentiveIdType {
+	ret := _m.Called(tariffId, tierId, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIncentive")
+	}
+
+	var r0 *model.IncentiveIdType
+	if rf, ok := ret.Get(0).(func(model.TariffIdType, model.TierIdType, model.IncentiveDescriptionDataType) *model.IncentiveIdType); ok {
+		r0 = rf(tariffId, tierId, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IncentiveIdType)
+		}
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_AddIncentive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddIncentive'
+type IncentiveTableServerInterface_AddIncentive_Call struct {
+	*mock.Call
+}
+
+// AddIncentive is a helper method to define mock.On call
+//   - tariffId model.TariffIdType
+//   - tierId model.TierIdType
+//   - description model.IncentiveDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) AddIncentive(tariffId interface{}, tierId interface{}, description interface{}) *IncentiveTableServerInterface_AddIncentive_Call {
+	return &IncentiveTableServerInterface_AddIncentive_Call{Call: _e.mock.On("AddIncentive", tariffId, tierId, description)}
+}
+
+func (_c *IncentiveTableServerInterface_AddIncentive_Call) Run(run func(tariffId model.TariffIdType, tierId model.TierIdType, description model.IncentiveDescriptionDataType)) *IncentiveTableServerInterface_AddIncentive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffIdType), args[1].(model.TierIdType), args[2].(model.IncentiveDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddIncentive_Call) Return(_a0 *model.IncentiveIdType) *IncentiveTableServerInterface_AddIncentive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddIncentive_Call) RunAndReturn(run func(model.TariffIdType, model.TierIdType, model.IncentiveDescriptionDataType) *model.IncentiveIdType) *IncentiveTableServerInterface_AddIncentive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTariffDescription provides a mock function with given fields: description
+func (_m *IncentiveTableServerInterface) AddTariffDescription(description model.TariffDescriptionDataType) *model.TariffIdType {
+	ret := _m.Called(description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTariffDescription")
+	}
+
+	var r0 *model.TariffIdType
+	if rf, ok := ret.Get(0).(func(model.TariffDescriptionDataType) *model.TariffIdType); ok {
+		r0 = rf(description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TariffIdType)
+		}
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_AddTariffDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTariffDescription'
+type IncentiveTableServerInterface_AddTariffDescription_Call struct {
+	*mock.Call
+}
+
+// AddTariffDescription is a helper method to define mock.On call
+//   - description model.TariffDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) AddTariffDescription(description interface{}) *IncentiveTableServerInterface_AddTariffDescription_Call {
+	return &IncentiveTableServerInterface_AddTariffDescription_Call{Call: _e.mock.On("AddTariffDescription", description)}
+}
+
+func (_c *IncentiveTableServerInterface_AddTariffDescription_Call) Run(run func(description model.TariffDescriptionDataType)) *IncentiveTableServerInterface_AddTariffDescription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddTariffDescription_Call) Return(_a0 *model.TariffIdType) *IncentiveTableServerInterface_AddTariffDescription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddTariffDescription_Call) RunAndReturn(run func(model.TariffDescriptionDataType) *model.TariffIdType) *IncentiveTableServerInterface_AddTariffDescription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTier provides a mock function with given fields: tariffId, description
+func (_m *IncentiveTableServerInterface) AddTier(tariffId model.TariffIdType, description model.TierDescriptionDataType) *model.TierIdType {
+	ret := _m.Called(tariffId, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTier")
+	}
+
+	var r0 *model.TierIdType
+	if rf, ok := ret.Get(0).(func(model.TariffIdType, model.TierDescriptionDataType) *model.TierIdType); ok {
+		r0 = rf(tariffId, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TierIdType)
+		}
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_AddTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTier'
+type IncentiveTableServerInterface_AddTier_Call struct {
+	*mock.Call
+}
+
+// AddTier is a helper method to define mock.On call
+//   - tariffId model.TariffIdType
+//   - description model.TierDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) AddTier(tariffId interface{}, description interface{}) *IncentiveTableServerInterface_AddTier_Call {
+	return &IncentiveTableServerInterface_AddTier_Call{Call: _e.mock.On("AddTier", tariffId, description)}
+}
+
+func (_c *IncentiveTableServerInterface_AddTier_Call) Run(run func(tariffId model.TariffIdType, description model.TierDescriptionDataType)) *IncentiveTableServerInterface_AddTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffIdType), args[1].(model.TierDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddTier_Call) Return(_a0 *model.TierIdType) *IncentiveTableServerInterface_AddTier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddTier_Call) RunAndReturn(run func(model.TariffIdType, model.TierDescriptionDataType) *model.TierIdType) *IncentiveTableServerInterface_AddTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddWriteApprovalCallback provides a mock function with given fields: function
+func (_m *IncentiveTableServerInterface) AddWriteApprovalCallback(function spine_goapi.WriteApprovalCallbackFunc) error {
+	ret := _m.Called(function)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWriteApprovalCallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(spine_goapi.WriteApprovalCallbackFunc) error); ok {
+		r0 = rf(function)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_AddWriteApprovalCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWriteApprovalCallback'
+type IncentiveTableServerInterface_AddWriteApprovalCallback_Call struct {
+	*mock.Call
+}
+
+// AddWriteApprovalCallback is a helper method to define mock.On call
+//   - function spine_goapi.WriteApprovalCallbackFunc
+func (_e *IncentiveTableServerInterface_Expecter) AddWriteApprovalCallback(function interface{}) *IncentiveTableServerInterface_AddWriteApprovalCallback_Call {
+	return &IncentiveTableServerInterface_AddWriteApprovalCallback_Call{Call: _e.mock.On("AddWriteApprovalCallback", function)}
+}
+
+func (_c *IncentiveTableServerInterface_AddWriteApprovalCallback_Call) Run(run func(function spine_goapi.WriteApprovalCallbackFunc)) *IncentiveTableServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(spine_goapi.WriteApprovalCallbackFunc))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddWriteApprovalCallback_Call) Return(_a0 error) *IncentiveTableServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_AddWriteApprovalCallback_Call) RunAndReturn(run func(spine_goapi.WriteApprovalCallbackFunc) error) *IncentiveTableServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveOrDenyWrite provides a mock function with given fields: msg, err
+func (_m *IncentiveTableServerInterface) ApproveOrDenyWrite(msg *spine_goapi.Message, err error) {
+	_m.Called(msg, err)
+}
+
+// IncentiveTableServerInterface_ApproveOrDenyWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveOrDenyWrite'
+type IncentiveTableServerInterface_ApproveOrDenyWrite_Call struct {
+	*mock.Call
+}
+
+// ApproveOrDenyWrite is a helper method to define mock.On call
+//   - msg *spine_goapi.Message
+//   - err error
+func (_e *IncentiveTableServerInterface_Expecter) ApproveOrDenyWrite(msg interface{}, err interface{}) *IncentiveTableServerInterface_ApproveOrDenyWrite_Call {
+	return &IncentiveTableServerInterface_ApproveOrDenyWrite_Call{Call: _e.mock.On("ApproveOrDenyWrite", msg, err)}
+}
+
+func (_c *IncentiveTableServerInterface_ApproveOrDenyWrite_Call) Run(run func(msg *spine_goapi.Message, err error)) *IncentiveTableServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*spine_goapi.Message), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ApproveOrDenyWrite_Call) Return() *IncentiveTableServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ApproveOrDenyWrite_Call) RunAndReturn(run func(*spine_goapi.Message, error)) *IncentiveTableServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConstraints provides a mock function with given fields:
+func (_m *IncentiveTableServerInterface) GetConstraints() ([]model.IncentiveTableConstraintsType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConstraints")
+	}
+
+	var r0 []model.IncentiveTableConstraintsType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]model.IncentiveTableConstraintsType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.IncentiveTableConstraintsType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IncentiveTableConstraintsType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncentiveTableServerInterface_GetConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConstraints'
+type IncentiveTableServerInterface_GetConstraints_Call struct {
+	*mock.Call
+}
+
+// GetConstraints is a helper method to define mock.On call
+func (_e *IncentiveTableServerInterface_Expecter) GetConstraints() *IncentiveTableServerInterface_GetConstraints_Call {
+	return &IncentiveTableServerInterface_GetConstraints_Call{Call: _e.mock.On("GetConstraints")}
+}
+
+func (_c *IncentiveTableServerInterface_GetConstraints_Call) Run(run func()) *IncentiveTableServerInterface_GetConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetConstraints_Call) Return(_a0 []model.IncentiveTableConstraintsType, _a1 error) *IncentiveTableServerInterface_GetConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetConstraints_Call) RunAndReturn(run func() ([]model.IncentiveTableConstraintsType, error)) *IncentiveTableServerInterface_GetConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetData provides a mock function with given fields:
+func (_m *IncentiveTableServerInterface) GetData() ([]model.IncentiveTableType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetData")
+	}
+
+	var r0 []model.IncentiveTableType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]model.IncentiveTableType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.IncentiveTableType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IncentiveTableType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncentiveTableServerInterface_GetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetData'
+type IncentiveTableServerInterface_GetData_Call struct {
+	*mock.Call
+}
+
+// GetData is a helper method to define mock.On call
+func (_e *IncentiveTableServerInterface_Expecter) GetData() *IncentiveTableServerInterface_GetData_Call {
+	return &IncentiveTableServerInterface_GetData_Call{Call: _e.mock.On("GetData")}
+}
+
+func (_c *IncentiveTableServerInterface_GetData_Call) Run(run func()) *IncentiveTableServerInterface_GetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetData_Call) Return(_a0 []model.IncentiveTableType, _a1 error) *IncentiveTableServerInterface_GetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetData_Call) RunAndReturn(run func() ([]model.IncentiveTableType, error)) *IncentiveTableServerInterface_GetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescriptionsForFilter provides a mock function with given fields: filter
+func (_m *IncentiveTableServerInterface) GetDescriptionsForFilter(filter model.TariffDescriptionDataType) ([]model.IncentiveTableDescriptionType, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescriptionsForFilter")
+	}
+
+	var r0 []model.IncentiveTableDescriptionType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.TariffDescriptionDataType) ([]model.IncentiveTableDescriptionType, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(model.TariffDescriptionDataType) []model.IncentiveTableDescriptionType); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.IncentiveTableDescriptionType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.TariffDescriptionDataType) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncentiveTableServerInterface_GetDescriptionsForFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescriptionsForFilter'
+type IncentiveTableServerInterface_GetDescriptionsForFilter_Call struct {
+	*mock.Call
+}
+
+// GetDescriptionsForFilter is a helper method to define mock.On call
+//   - filter model.TariffDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) GetDescriptionsForFilter(filter interface{}) *IncentiveTableServerInterface_GetDescriptionsForFilter_Call {
+	return &IncentiveTableServerInterface_GetDescriptionsForFilter_Call{Call: _e.mock.On("GetDescriptionsForFilter", filter)}
+}
+
+func (_c *IncentiveTableServerInterface_GetDescriptionsForFilter_Call) Run(run func(filter model.TariffDescriptionDataType)) *IncentiveTableServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetDescriptionsForFilter_Call) Return(_a0 []model.IncentiveTableDescriptionType, _a1 error) *IncentiveTableServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_GetDescriptionsForFilter_Call) RunAndReturn(run func(model.TariffDescriptionDataType) ([]model.IncentiveTableDescriptionType, error)) *IncentiveTableServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConstraints provides a mock function with given fields: data
+func (_m *IncentiveTableServerInterface) SetConstraints(data []model.IncentiveTableConstraintsType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConstraints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.IncentiveTableConstraintsType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_SetConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConstraints'
+type IncentiveTableServerInterface_SetConstraints_Call struct {
+	*mock.Call
+}
+
+// SetConstraints is a helper method to define mock.On call
+//   - data []model.IncentiveTableConstraintsType
+func (_e *IncentiveTableServerInterface_Expecter) SetConstraints(data interface{}) *IncentiveTableServerInterface_SetConstraints_Call {
+	return &IncentiveTableServerInterface_SetConstraints_Call{Call: _e.mock.On("SetConstraints", data)}
+}
+
+func (_c *IncentiveTableServerInterface_SetConstraints_Call) Run(run func(data []model.IncentiveTableConstraintsType)) *IncentiveTableServerInterface_SetConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.IncentiveTableConstraintsType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetConstraints_Call) Return(_a0 error) *IncentiveTableServerInterface_SetConstraints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetConstraints_Call) RunAndReturn(run func([]model.IncentiveTableConstraintsType) error) *IncentiveTableServerInterface_SetConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetIncentiveSlots provides a mock function with given fields: tariffId, slots
+func (_m *IncentiveTableServerInterface) SetIncentiveSlots(tariffId model.TariffIdType, slots []model.IncentiveTableIncentiveSlotType) error {
+	ret := _m.Called(tariffId, slots)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIncentiveSlots")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.TariffIdType, []model.IncentiveTableIncentiveSlotType) error); ok {
+		r0 = rf(tariffId, slots)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_SetIncentiveSlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetIncentiveSlots'
+type IncentiveTableServerInterface_SetIncentiveSlots_Call struct {
+	*mock.Call
+}
+
+// SetIncentiveSlots is a helper method to define mock.On call
+//   - tariffId model.TariffIdType
+//   - slots []model.IncentiveTableIncentiveSlotType
+func (_e *IncentiveTableServerInterface_Expecter) SetIncentiveSlots(tariffId interface{}, slots interface{}) *IncentiveTableServerInterface_SetIncentiveSlots_Call {
+	return &IncentiveTableServerInterface_SetIncentiveSlots_Call{Call: _e.mock.On("SetIncentiveSlots", tariffId, slots)}
+}
+
+func (_c *IncentiveTableServerInterface_SetIncentiveSlots_Call) Run(run func(tariffId model.TariffIdType, slots []model.IncentiveTableIncentiveSlotType)) *IncentiveTableServerInterface_SetIncentiveSlots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffIdType), args[1].([]model.IncentiveTableIncentiveSlotType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetIncentiveSlots_Call) Return(_a0 error) *IncentiveTableServerInterface_SetIncentiveSlots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetIncentiveSlots_Call) RunAndReturn(run func(model.TariffIdType, []model.IncentiveTableIncentiveSlotType) error) *IncentiveTableServerInterface_SetIncentiveSlots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTiers provides a mock function with given fields: tariffId, tiers
+func (_m *IncentiveTableServerInterface) SetTiers(tariffId model.TariffIdType, tiers []model.IncentiveTableDescriptionTierType) error {
+	ret := _m.Called(tariffId, tiers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTiers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.TariffIdType, []model.IncentiveTableDescriptionTierType) error); ok {
+		r0 = rf(tariffId, tiers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_SetTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTiers'
+type IncentiveTableServerInterface_SetTiers_Call struct {
+	*mock.Call
+}
+
+// SetTiers is a helper method to define mock.On call
+//   - tariffId model.TariffIdType
+//   - tiers []model.IncentiveTableDescriptionTierType
+func (_e *IncentiveTableServerInterface_Expecter) SetTiers(tariffId interface{}, tiers interface{}) *IncentiveTableServerInterface_SetTiers_Call {
+	return &IncentiveTableServerInterface_SetTiers_Call{Call: _e.mock.On("SetTiers", tariffId, tiers)}
+}
+
+func (_c *IncentiveTableServerInterface_SetTiers_Call) Run(run func(tariffId model.TariffIdType, tiers []model.IncentiveTableDescriptionTierType)) *IncentiveTableServerInterface_SetTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffIdType), args[1].([]model.IncentiveTableDescriptionTierType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetTiers_Call) Return(_a0 error) *IncentiveTableServerInterface_SetTiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_SetTiers_Call) RunAndReturn(run func(model.TariffIdType, []model.IncentiveTableDescriptionTierType) error) *IncentiveTableServerInterface_SetTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTariffDescription provides a mock function with given fields: description
+func (_m *IncentiveTableServerInterface) UpdateTariffDescription(description model.TariffDescriptionDataType) error {
+	ret := _m.Called(description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTariffDescription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.TariffDescriptionDataType) error); ok {
+		r0 = rf(description)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_UpdateTariffDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTariffDescription'
+type IncentiveTableServerInterface_UpdateTariffDescription_Call struct {
+	*mock.Call
+}
+
+// UpdateTariffDescription is a helper method to define mock.On call
+//   - description model.TariffDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) UpdateTariffDescription(description interface{}) *IncentiveTableServerInterface_UpdateTariffDescription_Call {
+	return &IncentiveTableServerInterface_UpdateTariffDescription_Call{Call: _e.mock.On("UpdateTariffDescription", description)}
+}
+
+func (_c *IncentiveTableServerInterface_UpdateTariffDescription_Call) Run(run func(description model.TariffDescriptionDataType)) *IncentiveTableServerInterface_UpdateTariffDescription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TariffDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_UpdateTariffDescription_Call) Return(_a0 error) *IncentiveTableServerInterface_UpdateTariffDescription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_UpdateTariffDescription_Call) RunAndReturn(run func(model.TariffDescriptionDataType) error) *IncentiveTableServerInterface_UpdateTariffDescription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateDescriptionsWrite provides a mock function with given fields: data
+func (_m *IncentiveTableServerInterface) ValidateDescriptionsWrite(data *model.IncentiveTableDescriptionDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateDescriptionsWrite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.IncentiveTableDescriptionDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_ValidateDescriptionsWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateDescriptionsWrite'
+type IncentiveTableServerInterface_ValidateDescriptionsWrite_Call struct {
+	*mock.Call
+}
+
+// ValidateDescriptionsWrite is a helper method to define mock.On call
+//   - data *model.IncentiveTableDescriptionDataType
+func (_e *IncentiveTableServerInterface_Expecter) ValidateDescriptionsWrite(data interface{}) *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call {
+	return &IncentiveTableServerInterface_ValidateDescriptionsWrite_Call{Call: _e.mock.On("ValidateDescriptionsWrite", data)}
+}
+
+func (_c *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call) Run(run func(data *model.IncentiveTableDescriptionDataType)) *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.IncentiveTableDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call) Return(_a0 error) *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call) RunAndReturn(run func(*model.IncentiveTableDescriptionDataType) error) *IncentiveTableServerInterface_ValidateDescriptionsWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateIncentivesWrite provides a mock function with given fields: data
+func (_m *IncentiveTableServerInterface) ValidateIncentivesWrite(data *model.IncentiveTableDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateIncentivesWrite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.IncentiveTableDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IncentiveTableServerInterface_ValidateIncentivesWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateIncentivesWrite'
+type IncentiveTableServerInterface_ValidateIncentivesWrite_Call struct {
+	*mock.Call
+}
+
+// ValidateIncentivesWrite is a helper method to define mock.On call
+//   - data *model.IncentiveTableDataType
+func (_e *IncentiveTableServerInterface_Expecter) ValidateIncentivesWrite(data interface{}) *IncentiveTableServerInterface_ValidateIncentivesWrite_Call {
+	return &IncentiveTableServerInterface_ValidateIncentivesWrite_Call{Call: _e.mock.On("ValidateIncentivesWrite", data)}
+}
+
+func (_c *IncentiveTableServerInterface_ValidateIncentivesWrite_Call) Run(run func(data *model.IncentiveTableDataType)) *IncentiveTableServerInterface_ValidateIncentivesWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.IncentiveTableDataType))
+	})
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ValidateIncentivesWrite_Call) Return(_a0 error) *IncentiveTableServerInterface_ValidateIncentivesWrite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IncentiveTableServerInterface_ValidateIncentivesWrite_Call) RunAndReturn(run func(*model.IncentiveTableDataType) error) *IncentiveTableServerInterface_ValidateIncentivesWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIncentiveTableServerInterface creates a new instance of IncentiveTableServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIncentiveTableServerInterface(t interface {
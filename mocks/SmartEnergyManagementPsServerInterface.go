@@ -1,8 +1,11 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	model "github.com/enbility/spine-go/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // SmartEnergyManagementPsServerInterface is an autogenerated mock type for the SmartEnergyManagementPsServerInterface type
 type SmartEnergyManagementPsServerInterface struct {
@@ -17,6 +20,492 @@ func (_m *SmartEnergyManagementPsServerInterface) EXPECT() *SmartEnergyManagemen
 	return &SmartEnergyManagementPsServerInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddAlternative provides a mock function with given fields: powerSequences
+func (_m *SmartEnergyManagementPsServerInterface) AddAlternative(powerSequences []model.SmartEnergyManagementPsPowerSequenceType) *model.AlternativesIdType {
+	ret := _m.Called(powerSequences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAlternative")
+	}
+
+	var r0 *model.AlternativesIdType
+	if rf, ok := ret.Get(0).(func([]model.SmartEnergyManagementPsPowerSequenceType) *model.AlternativesIdType); ok {
+		r0 = rf(powerSequences)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AlternativesIdType)
+		}
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_AddAlternative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAlternative'
+type SmartEnergyManagementPsServerInterface_AddAlternative_Call struct {
+	*mock.Call
+}
+
+// AddAlternative is a helper method to define mock.On call
+//   - powerSequences []model.SmartEnergyManagementPsPowerSequenceType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) AddAlternative(powerSequences interface{}) *SmartEnergyManagementPsServerInterface_AddAlternative_Call {
+	return &SmartEnergyManagementPsServerInterface_AddAlternative_Call{Call: _e.mock.On("AddAlternative", powerSequences)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_AddAlternative_Call) Run(run func(powerSequences []model.SmartEnergyManagementPsPowerSequenceType)) *SmartEnergyManagementPsServerInterface_AddAlternative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.SmartEnergyManagementPsPowerSequenceType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_AddAlternative_Call) Return(_a0 *model.AlternativesIdType) *SmartEnergyManagementPsServerInterface_AddAlternative_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_AddAlternative_Call) RunAndReturn(run func([]model.SmartEnergyManagementPsPowerSequenceType) *model.AlternativesIdType) *SmartEnergyManagementPsServerInterface_AddAlternative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApplyScheduleWrite provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) ApplyScheduleWrite(data *model.SmartEnergyManagementPsDataType) (*model.SmartEnergyManagementPsDataType, error) {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyScheduleWrite")
+	}
+
+	var r0 *model.SmartEnergyManagementPsDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.SmartEnergyManagementPsDataType) (*model.SmartEnergyManagementPsDataType, error)); ok {
+		return rf(data)
+	}
+	if rf, ok := ret.Get(0).(func(*model.SmartEnergyManagementPsDataType) *model.SmartEnergyManagementPsDataType); ok {
+		r0 = rf(data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SmartEnergyManagementPsDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.SmartEnergyManagementPsDataType) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyScheduleWrite'
+type SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call struct {
+	*mock.Call
+}
+
+// ApplyScheduleWrite is a helper method to define mock.On call
+//   - data *model.SmartEnergyManagementPsDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) ApplyScheduleWrite(data interface{}) *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call {
+	return &SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call{Call: _e.mock.On("ApplyScheduleWrite", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call) Run(run func(data *model.SmartEnergyManagementPsDataType)) *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.SmartEnergyManagementPsDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call) Return(_a0 *model.SmartEnergyManagementPsDataType, _a1 error) *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call) RunAndReturn(run func(*model.SmartEnergyManagementPsDataType) (*model.SmartEnergyManagementPsDataType, error)) *SmartEnergyManagementPsServerInterface_ApplyScheduleWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetData provides a mock function with given fields:
+func (_m *SmartEnergyManagementPsServerInterface) GetData() (*model.SmartEnergyManagementPsDataType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetData")
+	}
+
+	var r0 *model.SmartEnergyManagementPsDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*model.SmartEnergyManagementPsDataType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *model.SmartEnergyManagementPsDataType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SmartEnergyManagementPsDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartEnergyManagementPsServerInterface_GetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetData'
+type SmartEnergyManagementPsServerInterface_GetData_Call struct {
+	*mock.Call
+}
+
+// GetData is a helper method to define mock.On call
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) GetData() *SmartEnergyManagementPsServerInterface_GetData_Call {
+	return &SmartEnergyManagementPsServerInterface_GetData_Call{Call: _e.mock.On("GetData")}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_GetData_Call) Run(run func()) *SmartEnergyManagementPsServerInterface_GetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_GetData_Call) Return(_a0 *model.SmartEnergyManagementPsDataType, _a1 error) *SmartEnergyManagementPsServerInterface_GetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_GetData_Call) RunAndReturn(run func() (*model.SmartEnergyManagementPsDataType, error)) *SmartEnergyManagementPsServerInterface_GetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAlternative provides a mock function with given fields: alternativesId
+func (_m *SmartEnergyManagementPsServerInterface) RemoveAlternative(alternativesId model.AlternativesIdType) error {
+	ret := _m.Called(alternativesId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAlternative")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.AlternativesIdType) error); ok {
+		r0 = rf(alternativesId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_RemoveAlternative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAlternative'
+type SmartEnergyManagementPsServerInterface_RemoveAlternative_Call struct {
+	*mock.Call
+}
+
+// RemoveAlternative is a helper method to define mock.On call
+//   - alternativesId model.AlternativesIdType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) RemoveAlternative(alternativesId interface{}) *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call {
+	return &SmartEnergyManagementPsServerInterface_RemoveAlternative_Call{Call: _e.mock.On("RemoveAlternative", alternativesId)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call) Run(run func(alternativesId model.AlternativesIdType)) *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.AlternativesIdType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call) RunAndReturn(run func(model.AlternativesIdType) error) *SmartEnergyManagementPsServerInterface_RemoveAlternative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetData provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) SetData(data *model.SmartEnergyManagementPsDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetData")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.SmartEnergyManagementPsDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_SetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetData'
+type SmartEnergyManagementPsServerInterface_SetData_Call struct {
+	*mock.Call
+}
+
+// SetData is a helper method to define mock.On call
+//   - data *model.SmartEnergyManagementPsDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) SetData(data interface{}) *SmartEnergyManagementPsServerInterface_SetData_Call {
+	return &SmartEnergyManagementPsServerInterface_SetData_Call{Call: _e.mock.On("SetData", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetData_Call) Run(run func(data *model.SmartEnergyManagementPsDataType)) *SmartEnergyManagementPsServerInterface_SetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.SmartEnergyManagementPsDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetData_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_SetData_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetData_Call) RunAndReturn(run func(*model.SmartEnergyManagementPsDataType) error) *SmartEnergyManagementPsServerInterface_SetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetNodeScheduleInformation provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) SetNodeScheduleInformation(data model.PowerSequenceNodeScheduleInformationDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetNodeScheduleInformation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.PowerSequenceNodeScheduleInformationDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetNodeScheduleInformation'
+type SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call struct {
+	*mock.Call
+}
+
+// SetNodeScheduleInformation is a helper method to define mock.On call
+//   - data model.PowerSequenceNodeScheduleInformationDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) SetNodeScheduleInformation(data interface{}) *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call {
+	return &SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call{Call: _e.mock.On("SetNodeScheduleInformation", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call) Run(run func(data model.PowerSequenceNodeScheduleInformationDataType)) *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.PowerSequenceNodeScheduleInformationDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call) RunAndReturn(run func(model.PowerSequenceNodeScheduleInformationDataType) error) *SmartEnergyManagementPsServerInterface_SetNodeScheduleInformation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSequenceSchedule provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) UpdateSequenceSchedule(data model.PowerSequenceScheduleDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSequenceSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.PowerSequenceScheduleDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSequenceSchedule'
+type SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateSequenceSchedule is a helper method to define mock.On call
+//   - data model.PowerSequenceScheduleDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) UpdateSequenceSchedule(data interface{}) *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call {
+	return &SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call{Call: _e.mock.On("UpdateSequenceSchedule", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call) Run(run func(data model.PowerSequenceScheduleDataType)) *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.PowerSequenceScheduleDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call) RunAndReturn(run func(model.PowerSequenceScheduleDataType) error) *SmartEnergyManagementPsServerInterface_UpdateSequenceSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSequenceScheduleConstraints provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) UpdateSequenceScheduleConstraints(data model.PowerSequenceScheduleConstraintsDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSequenceScheduleConstraints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.PowerSequenceScheduleConstraintsDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSequenceScheduleConstraints'
+type SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call struct {
+	*mock.Call
+}
+
+// UpdateSequenceScheduleConstraints is a helper method to define mock.On call
+//   - data model.PowerSequenceScheduleConstraintsDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) UpdateSequenceScheduleConstraints(data interface{}) *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call {
+	return &SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call{Call: _e.mock.On("UpdateSequenceScheduleConstraints", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call) Run(run func(data model.PowerSequenceScheduleConstraintsDataType)) *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.PowerSequenceScheduleConstraintsDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call) RunAndReturn(run func(model.PowerSequenceScheduleConstraintsDataType) error) *SmartEnergyManagementPsServerInterface_UpdateSequenceScheduleConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSequenceState provides a mock function with given fields: data
+func (_m *SmartEnergyManagementPsServerInterface) UpdateSequenceState(data model.PowerSequenceStateDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSequenceState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.PowerSequenceStateDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSequenceState'
+type SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call struct {
+	*mock.Call
+}
+
+// UpdateSequenceState is a helper method to define mock.On call
+//   - data model.PowerSequenceStateDataType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) UpdateSequenceState(data interface{}) *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call {
+	return &SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call{Call: _e.mock.On("UpdateSequenceState", data)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call) Run(run func(data model.PowerSequenceStateDataType)) *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.PowerSequenceStateDataType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call) RunAndReturn(run func(model.PowerSequenceStateDataType) error) *SmartEnergyManagementPsServerInterface_UpdateSequenceState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSequenceTimeSlots provides a mock function with given fields: sequenceId, slots
+func (_m *SmartEnergyManagementPsServerInterface) UpdateSequenceTimeSlots(sequenceId model.PowerSequenceIdType, slots []model.SmartEnergyManagementPsPowerTimeSlotType) error {
+	ret := _m.Called(sequenceId, slots)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSequenceTimeSlots")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(model.PowerSequenceIdType, []model.SmartEnergyManagementPsPowerTimeSlotType) error); ok {
+		r0 = rf(sequenceId, slots)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSequenceTimeSlots'
+type SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call struct {
+	*mock.Call
+}
+
+// UpdateSequenceTimeSlots is a helper method to define mock.On call
+//   - sequenceId model.PowerSequenceIdType
+//   - slots []model.SmartEnergyManagementPsPowerTimeSlotType
+func (_e *SmartEnergyManagementPsServerInterface_Expecter) UpdateSequenceTimeSlots(sequenceId interface{}, slots interface{}) *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call {
+	return &SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call{Call: _e.mock.On("UpdateSequenceTimeSlots", sequenceId, slots)}
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call) Run(run func(sequenceId model.PowerSequenceIdType, slots []model.SmartEnergyManagementPsPowerTimeSlotType)) *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.PowerSequenceIdType), args[1].([]model.SmartEnergyManagementPsPowerTimeSlotType))
+	})
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call) Return(_a0 error) *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call) RunAndReturn(run func(model.PowerSequenceIdType, []model.SmartEnergyManagementPsPowerTimeSlotType) error) *SmartEnergyManagementPsServerInterface_UpdateSequenceTimeSlots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSmartEnergyManagementPsServerInterface creates a new instance of SmartEnergyManagementPsServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSmartEnergyManagementPsServerInterface(t interface {
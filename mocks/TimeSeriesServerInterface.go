@@ -1,8 +1,15 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	api "github.com/enbility/eebus-go/api"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/enbility/spine-go/model"
+
+	spine_goapi "github.com/enbility/spine-go/api"
+)
 
 // TimeSeriesServerInterface is an autogenerated mock type for the TimeSeriesServerInterface type
 type TimeSeriesServerInterface struct {
@@ -17,6 +24,447 @@ func (_m *TimeSeriesServerInterface) EXPECT() *TimeSeriesServerInterface_Expecte
 	return &TimeSeriesServerInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddDescription provides a mock function with given fields: description
+func (_m *TimeSeriesServerInterface) AddDescription(description model.TimeSeriesDescriptionDataType) *model.TimeSeriesIdType {
+	ret := _m.Called(description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDescription")
+	}
+
+	var r0 *model.TimeSeriesIdType
+	if rf, ok := ret.Get(0).(func(model.TimeSeriesDescriptionDataType) *model.TimeSeriesIdType); ok {
+		r0 = rf(description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeSeriesIdType)
+		}
+	}
+
+	return r0
+}
+
+// TimeSeriesServerInterface_AddDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDescription'
+type TimeSeriesServerInterface_AddDescription_Call struct {
+	*mock.Call
+}
+
+// AddDescription is a helper method to define mock.On call
+//   - description model.TimeSeriesDescriptionDataType
+func (_e *TimeSeriesServerInterface_Expecter) AddDescription(description interface{}) *TimeSeriesServerInterface_AddDescription_Call {
+	return &TimeSeriesServerInterface_AddDescription_Call{Call: _e.mock.On("AddDescription", description)}
+}
+
+func (_c *TimeSeriesServerInterface_AddDescription_Call) Run(run func(description model.TimeSeriesDescriptionDataType)) *TimeSeriesServerInterface_AddDescription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TimeSeriesDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_AddDescription_Call) Return(_a0 *model.TimeSeriesIdType) *TimeSeriesServerInterface_AddDescription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_AddDescription_Call) RunAndReturn(run func(model.TimeSeriesDescriptionDataType) *model.TimeSeriesIdType) *TimeSeriesServerInterface_AddDescription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddWriteApprovalCallback provides a mock function with given fields: function
+func (_m *TimeSeriesServerInterface) AddWriteApprovalCallback(function spine_goapi.WriteApprovalCallbackFunc) error {
+	ret := _m.Called(function)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWriteApprovalCallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(spine_goapi.WriteApprovalCallbackFunc) error); ok {
+		r0 = rf(function)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeSeriesServerInterface_AddWriteApprovalCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddWriteApprovalCallback'
+type TimeSeriesServerInterface_AddWriteApprovalCallback_Call struct {
+	*mock.Call
+}
+
+// AddWriteApprovalCallback is a helper method to define mock.On call
+//   - function spine_goapi.WriteApprovalCallbackFunc
+func (_e *TimeSeriesServerInterface_Expecter) AddWriteApprovalCallback(function interface{}) *TimeSeriesServerInterface_AddWriteApprovalCallback_Call {
+	return &TimeSeriesServerInterface_AddWriteApprovalCallback_Call{Call: _e.mock.On("AddWriteApprovalCallback", function)}
+}
+
+func (_c *TimeSeriesServerInterface_AddWriteApprovalCallback_Call) Run(run func(function spine_goapi.WriteApprovalCallbackFunc)) *TimeSeriesServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(spine_goapi.WriteApprovalCallbackFunc))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_AddWriteApprovalCallback_Call) Return(_a0 error) *TimeSeriesServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_AddWriteApprovalCallback_Call) RunAndReturn(run func(spine_goapi.WriteApprovalCallbackFunc) error) *TimeSeriesServerInterface_AddWriteApprovalCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveOrDenyWrite provides a mock function with given fields: msg, err
+func (_m *TimeSeriesServerInterface) ApproveOrDenyWrite(msg *spine_goapi.Message, err error) {
+	_m.Called(msg, err)
+}
+
+// TimeSeriesServerInterface_ApproveOrDenyWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveOrDenyWrite'
+type TimeSeriesServerInterface_ApproveOrDenyWrite_Call struct {
+	*mock.Call
+}
+
+// ApproveOrDenyWrite is a helper method to define mock.On call
+//   - msg *spine_goapi.Message
+//   - err error
+func (_e *TimeSeriesServerInterface_Expecter) ApproveOrDenyWrite(msg interface{}, err interface{}) *TimeSeriesServerInterface_ApproveOrDenyWrite_Call {
+	return &TimeSeriesServerInterface_ApproveOrDenyWrite_Call{Call: _e.mock.On("ApproveOrDenyWrite", msg, err)}
+}
+
+func (_c *TimeSeriesServerInterface_ApproveOrDenyWrite_Call) Run(run func(msg *spine_goapi.Message, err error)) *TimeSeriesServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*spine_goapi.Message), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_ApproveOrDenyWrite_Call) Return() *TimeSeriesServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_ApproveOrDenyWrite_Call) RunAndReturn(run func(*spine_goapi.Message, error)) *TimeSeriesServerInterface_ApproveOrDenyWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConstraints provides a mock function with given fields:
+func (_m *TimeSeriesServerInterface) GetConstraints() ([]model.TimeSeriesConstraintsDataType, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConstraints")
+	}
+
+	var r0 []model.TimeSeriesConstraintsDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]model.TimeSeriesConstraintsDataType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.TimeSeriesConstraintsDataType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeSeriesConstraintsDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeSeriesServerInterface_GetConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConstraints'
+type TimeSeriesServerInterface_GetConstraints_Call struct {
+	*mock.Call
+}
+
+// GetConstraints is a helper method to define mock.On call
+func (_e *TimeSeriesServerInterface_Expecter) GetConstraints() *TimeSeriesServerInterface_GetConstraints_Call {
+	return &TimeSeriesServerInterface_GetConstraints_Call{Call: _e.mock.On("GetConstraints")}
+}
+
+func (_c *TimeSeriesServerInterface_GetConstraints_Call) Run(run func()) *TimeSeriesServerInterface_GetConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetConstraints_Call) Return(_a0 []model.TimeSeriesConstraintsDataType, _a1 error) *TimeSeriesServerInterface_GetConstraints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetConstraints_Call) RunAndReturn(run func() ([]model.TimeSeriesConstraintsDataType, error)) *TimeSeriesServerInterface_GetConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDataForFilter provides a mock function with given fields: filter
+func (_m *TimeSeriesServerInterface) GetDataForFilter(filter model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDataType, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataForFilter")
+	}
+
+	var r0 []model.TimeSeriesDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDataType, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(model.TimeSeriesDescriptionDataType) []model.TimeSeriesDataType); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeSeriesDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.TimeSeriesDescriptionDataType) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeSeriesServerInterface_GetDataForFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataForFilter'
+type TimeSeriesServerInterface_GetDataForFilter_Call struct {
+	*mock.Call
+}
+
+// GetDataForFilter is a helper method to define mock.On call
+//   - filter model.TimeSeriesDescriptionDataType
+func (_e *TimeSeriesServerInterface_Expecter) GetDataForFilter(filter interface{}) *TimeSeriesServerInterface_GetDataForFilter_Call {
+	return &TimeSeriesServerInterface_GetDataForFilter_Call{Call: _e.mock.On("GetDataForFilter", filter)}
+}
+
+func (_c *TimeSeriesServerInterface_GetDataForFilter_Call) Run(run func(filter model.TimeSeriesDescriptionDataType)) *TimeSeriesServerInterface_GetDataForFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TimeSeriesDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetDataForFilter_Call) Return(_a0 []model.TimeSeriesDataType, _a1 error) *TimeSeriesServerInterface_GetDataForFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetDataForFilter_Call) RunAndReturn(run func(model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDataType, error)) *TimeSeriesServerInterface_GetDataForFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescriptionsForFilter provides a mock function with given fields: filter
+func (_m *TimeSeriesServerInterface) GetDescriptionsForFilter(filter model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDescriptionDataType, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescriptionsForFilter")
+	}
+
+	var r0 []model.TimeSeriesDescriptionDataType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDescriptionDataType, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(model.TimeSeriesDescriptionDataType) []model.TimeSeriesDescriptionDataType); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeSeriesDescriptionDataType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.TimeSeriesDescriptionDataType) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeSeriesServerInterface_GetDescriptionsForFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescriptionsForFilter'
+type TimeSeriesServerInterface_GetDescriptionsForFilter_Call struct {
+	*mock.Call
+}
+
+// GetDescriptionsForFilter is a helper method to define mock.On call
+//   - filter model.TimeSeriesDescriptionDataType
+func (_e *TimeSeriesServerInterface_Expecter) GetDescriptionsForFilter(filter interface{}) *TimeSeriesServerInterface_GetDescriptionsForFilter_Call {
+	return &TimeSeriesServerInterface_GetDescriptionsForFilter_Call{Call: _e.mock.On("GetDescriptionsForFilter", filter)}
+}
+
+func (_c *TimeSeriesServerInterface_GetDescriptionsForFilter_Call) Run(run func(filter model.TimeSeriesDescriptionDataType)) *TimeSeriesServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(model.TimeSeriesDescriptionDataType))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetDescriptionsForFilter_Call) Return(_a0 []model.TimeSeriesDescriptionDataType, _a1 error) *TimeSeriesServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_GetDescriptionsForFilter_Call) RunAndReturn(run func(model.TimeSeriesDescriptionDataType) ([]model.TimeSeriesDescriptionDataType, error)) *TimeSeriesServerInterface_GetDescriptionsForFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetConstraints provides a mock function with given fields: data
+func (_m *TimeSeriesServerInterface) SetConstraints(data []model.TimeSeriesConstraintsDataType) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetConstraints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]model.TimeSeriesConstraintsDataType) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeSeriesServerInterface_SetConstraints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConstraints'
+type TimeSeriesServerInterface_SetConstraints_Call struct {
+	*mock.Call
+}
+
+// SetConstraints is a helper method to define mock.On call
+//   - data []model.TimeSeriesConstraintsDataType
+func (_e *TimeSeriesServerInterface_Expecter) SetConstraints(data interface{}) *TimeSeriesServerInterface_SetConstraints_Call {
+	return &TimeSeriesServerInterface_SetConstraints_Call{Call: _e.mock.On("SetConstraints", data)}
+}
+
+func (_c *TimeSeriesServerInterface_SetConstraints_Call) Run(run func(data []model.TimeSeriesConstraintsDataType)) *TimeSeriesServerInterface_SetConstraints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.TimeSeriesConstraintsDataType))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_SetConstraints_Call) Return(_a0 error) *TimeSeriesServerInterface_SetConstraints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_SetConstraints_Call) RunAndReturn(run func([]model.TimeSeriesConstraintsDataType) error) *TimeSeriesServerInterface_SetConstraints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataForFilters provides a mock function with given fields: data, deleteSelector, deleteElements
+func (_m *TimeSeriesServerInterface) UpdateDataForFilters(data []api.TimeSeriesDataForFilter, deleteSelector *model.TimeSeriesListDataSelectorsType, deleteElements *model.TimeSeriesDataElementsType) error {
+	ret := _m.Called(data, deleteSelector, deleteElements)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataForFilters")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]api.TimeSeriesDataForFilter, *model.TimeSeriesListDataSelectorsType, *model.TimeSeriesDataElementsType) error); ok {
+		r0 = rf(data, deleteSelector, deleteElements)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeSeriesServerInterface_UpdateDataForFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataForFilters'
+type TimeSeriesServerInterface_UpdateDataForFilters_Call struct {
+	*mock.Call
+}
+
+// UpdateDataForFilters is a helper method to define mock.On call
+//   - data []api.TimeSeriesDataForFilter
+//   - deleteSelector *model.TimeSeriesListDataSelectorsType
+//   - deleteElements *model.TimeSeriesDataElementsType
+func (_e *TimeSeriesServerInterface_Expecter) UpdateDataForFilters(data interface{}, deleteSelector interface{}, deleteElements interface{}) *TimeSeriesServerInterface_UpdateDataForFilters_Call {
+	return &TimeSeriesServerInterface_UpdateDataForFilters_Call{Call: _e.mock.On("UpdateDataForFilters", data, deleteSelector, deleteElements)}
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForFilters_Call) Run(run func(data []api.TimeSeriesDataForFilter, deleteSelector *model.TimeSeriesListDataSelectorsType, deleteElements *model.TimeSeriesDataElementsType)) *TimeSeriesServerInterface_UpdateDataForFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]api.TimeSeriesDataForFilter), args[1].(*model.TimeSeriesListDataSelectorsType), args[2].(*model.TimeSeriesDataElementsType))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForFilters_Call) Return(_a0 error) *TimeSeriesServerInterface_UpdateDataForFilters_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForFilters_Call) RunAndReturn(run func([]api.TimeSeriesDataForFilter, *model.TimeSeriesListDataSelectorsType, *model.TimeSeriesDataElementsType) error) *TimeSeriesServerInterface_UpdateDataForFilters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataForIds provides a mock function with given fields: data
+func (_m *TimeSeriesServerInterface) UpdateDataForIds(data []api.TimeSeriesDataForID) error {
+	ret := _m.Called(data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataForIds")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]api.TimeSeriesDataForID) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeSeriesServerInterface_UpdateDataForIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataForIds'
+type TimeSeriesServerInterface_UpdateDataForIds_Call struct {
+	*mock.Call
+}
+
+// UpdateDataForIds is a helper method to define mock.On call
+//   - data []api.TimeSeriesDataForID
+func (_e *TimeSeriesServerInterface_Expecter) UpdateDataForIds(data interface{}) *TimeSeriesServerInterface_UpdateDataForIds_Call {
+	return &TimeSeriesServerInterface_UpdateDataForIds_Call{Call: _e.mock.On("UpdateDataForIds", data)}
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForIds_Call) Run(run func(data []api.TimeSeriesDataForID)) *TimeSeriesServerInterface_UpdateDataForIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]api.TimeSeriesDataForID))
+	})
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForIds_Call) Return(_a0 error) *TimeSeriesServerInterface_UpdateDataForIds_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeSeriesServerInterface_UpdateDataForIds_Call) RunAndReturn(run func([]api.TimeSeriesDataForID) error) *TimeSeriesServerInterface_UpdateDataForIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTimeSeriesServerInterface creates a new instance of TimeSeriesServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTimeSeriesServerInterface(t interface {
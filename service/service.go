@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
 	shipapi "github.com/enbility/ship-go/api"
 	"github.com/enbility/ship-go/cert"
 	"github.com/enbility/ship-go/hub"
@@ -124,6 +125,28 @@ func (s *Service) Setup() error {
 		sd.FeatureSet(),
 	)
 
+	// Provide the manufacturer data of the device, SPINE Resource Specification 4.3.2
+	// unless the application wants to set it itself
+	deviceEntity := s.spineLocalDevice.EntityForType(model.EntityTypeTypeDeviceInformation)
+	if deviceClassification, err := server.NewDeviceClassification(deviceEntity); err == nil {
+		if sd.ManufacturerDataDisabled() {
+			// replace the default data set by the SPINE device, so none is served
+			// until the application sets its own
+			deviceClassification.SetManufacturerData(api.ManufacturerData{})
+		} else {
+			deviceClassification.SetManufacturerData(api.ManufacturerData{
+				DeviceName:       sd.DeviceModel(),
+				DeviceCode:       sd.Identifier(),
+				SerialNumber:     sd.DeviceSerialNumber(),
+				SoftwareRevision: sd.SoftwareRevision(),
+				HardwareRevision: sd.HardwareRevision(),
+				VendorName:       sd.DeviceBrand(),
+				VendorCode:       sd.VendorCode(),
+				BrandName:        sd.DeviceBrand(),
+			})
+		}
+	}
+
 	// Create the device entities and add it to the SPINE device
 	for _, entityType := range sd.EntityTypes() {
 		entityAddressId := model.AddressEntityType(len(s.spineLocalDevice.Entities()))
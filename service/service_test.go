@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
 	"github.com/enbility/eebus-go/mocks"
 	shipapi "github.com/enbility/ship-go/api"
 	"github.com/enbility/ship-go/cert"
@@ -163,6 +164,7 @@ func (s *ServiceSuite) Test_Setup() {
 	certificate, err := cert.CreateCertificate("unit", "org", "de", "cn")
 	assert.Nil(s.T(), err)
 	s.config.SetCertificate(certificate)
+	s.config.SetSoftwareRevision("1.0.0")
 
 	err = s.sut.Setup()
 	assert.Nil(s.T(), err)
@@ -170,6 +172,17 @@ func (s *ServiceSuite) Test_Setup() {
 	address := s.sut.LocalDevice().Address()
 	assert.Equal(s.T(), "d:_n:vendor_model-serial", string(*address))
 
+	deviceEntity := s.sut.LocalDevice().EntityForType(model.EntityTypeTypeDeviceInformation)
+	deviceClassification, err := server.NewDeviceClassification(deviceEntity)
+	assert.Nil(s.T(), err)
+	manufacturerData, err := deviceClassification.GetManufacturerDetails()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "vendor", string(*manufacturerData.VendorCode))
+	assert.Equal(s.T(), "model", string(*manufacturerData.DeviceName))
+	assert.Equal(s.T(), "serial", string(*manufacturerData.SerialNumber))
+	assert.Equal(s.T(), "1.0.0", string(*manufacturerData.SoftwareRevision))
+	assert.Nil(s.T(), manufacturerData.HardwareRevision)
+
 	s.sut.connectionsHub = s.conHub
 	s.conHub.EXPECT().Start().Once()
 	s.sut.Start()
@@ -192,6 +205,35 @@ func (s *ServiceSuite) Test_Setup() {
 	assert.NotNil(s.T(), device)
 }
 
+func (s *ServiceSuite) Test_Setup_ManufacturerDataDisabled() {
+	certificate, err := cert.CreateCertificate("unit", "org", "de", "cn")
+	assert.Nil(s.T(), err)
+	s.config.SetCertificate(certificate)
+	s.config.SetSoftwareRevision("1.0.0")
+	s.config.SetManufacturerDataDisabled(true)
+
+	err = s.sut.Setup()
+	assert.Nil(s.T(), err)
+
+	// neither the service nor the SPINE device provide any data
+	deviceEntity := s.sut.LocalDevice().EntityForType(model.EntityTypeTypeDeviceInformation)
+	deviceClassification, err := server.NewDeviceClassification(deviceEntity)
+	assert.Nil(s.T(), err)
+	manufacturerData, err := deviceClassification.GetManufacturerDetails()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceClassificationManufacturerDataType{}, *manufacturerData)
+
+	// the application sets its own data
+	deviceClassification.SetManufacturerData(api.ManufacturerData{
+		DeviceName: "custom",
+	})
+	manufacturerData, err = deviceClassification.GetManufacturerDetails()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "custom", string(*manufacturerData.DeviceName))
+	assert.Nil(s.T(), manufacturerData.BrandName)
+	assert.Nil(s.T(), manufacturerData.SerialNumber)
+}
+
 func (s *ServiceSuite) Test_Setup_IANA() {
 	var err error
 	certificate := tls.Certificate{}
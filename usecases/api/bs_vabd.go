@@ -0,0 +1,58 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: Battery System
+// UseCase: Visualization of Aggregated Battery Data
+type BsVABDInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the momentary (dis-)charge power of the battery system
+	//
+	// parameters:
+	//   - power: the power in W
+	//
+	//   - positive values are used for charge power
+	//   - negative values are used for discharge power
+	UpdatePower(power float64) error
+
+	// Scenario 2
+
+	// set the total charged energy of the battery system
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyCharged(energy float64) error
+
+	// Scenario 3
+
+	// set the total discharged energy of the battery system
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyDischarged(energy float64) error
+
+	// Scenario 4
+
+	// set the state of charge of the battery system
+	//
+	// parameters:
+	//   - stateOfCharge: the state of charge in %
+	UpdateStateOfCharge(stateOfCharge float64) error
+
+	// Scenario 1 - 4
+
+	// set the data of all scenarios aggregated from several battery packs
+	//
+	// the power and energy values are summed up, the state of charge
+	// is weighted by the capacity of each pack, or averaged if any
+	// pack does not provide a capacity
+	//
+	// parameters:
+	//   - packs: the data of each battery pack
+	UpdateBatteryPacks(packs []BatteryPackData) error
+}
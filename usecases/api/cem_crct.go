@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: Customer Energy Management
+// UseCase: Configuration of Room Cooling Temperature
+type CemCRCTInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the current room cooling temperature setpoints (°C)
+	//
+	// parameters:
+	//   - entity: the entity of the HVAC room
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such setpoint is (yet) available
+	//   - and others
+	Setpoints(entity spineapi.EntityRemoteInterface) ([]Setpoint, error)
+
+	// return the constraints of the room cooling temperature setpoints (°C)
+	//
+	// parameters:
+	//   - entity: the entity of the HVAC room
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such constraints are (yet) available
+	//   - and others
+	SetpointConstraints(entity spineapi.EntityRemoteInterface) ([]SetpointConstraints, error)
+
+	// write a new room cooling temperature setpoint (°C)
+	//
+	// parameters:
+	//   - entity: the entity of the HVAC room
+	//   - setpointId: the id of the setpoint to be changed
+	//   - value: the new value of the setpoint
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such setpoint is (yet) available
+	//   - ErrNotSupported if the setpoint can not be changed
+	//   - and others, e.g. if the value violates the setpoint constraints
+	WriteSetpoint(entity spineapi.EntityRemoteInterface, setpointId uint, value float64) (*model.MsgCounterType, error)
+}
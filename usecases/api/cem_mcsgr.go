@@ -0,0 +1,49 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: Customer Energy Management
+// UseCase: Monitoring and Control of Smart Grid Ready Conditions
+type CemMCSGRInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the SG-Ready states supported by the Controllable System
+	//
+	// parameters:
+	//   - entity: the entity of the Controllable System
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such data is (yet) available
+	//   - and others
+	SupportedSGReadyStates(entity spineapi.EntityRemoteInterface) ([]SGReadyStateType, error)
+
+	// return the current SG-Ready state of the Controllable System
+	//
+	// parameters:
+	//   - entity: the entity of the Controllable System
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such data is (yet) available
+	//   - and others
+	SGReadyState(entity spineapi.EntityRemoteInterface) (SGReadyStateType, error)
+
+	// Scenario 2
+
+	// send a new SG-Ready state to the Controllable System
+	//
+	// parameters:
+	//   - entity: the entity of the Controllable System
+	//   - state: the SG-Ready state to be set
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such data is (yet) available
+	//   - ErrNotSupported if the state is not supported or can not be changed
+	//   - and others
+	WriteSGReadyState(entity spineapi.EntityRemoteInterface, state SGReadyStateType) (*model.MsgCounterType, error)
+}
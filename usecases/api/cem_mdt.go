@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// Actor: Customer Energy Management
+// UseCase: Monitoring of DHW Temperature
+type CemMDTInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the current DHW temperature (°C)
+	//
+	// parameters:
+	//   - entity: the entity of the DHW circuit
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such measurement is (yet) available
+	//   - and others
+	Temperature(entity spineapi.EntityRemoteInterface) (float64, error)
+}
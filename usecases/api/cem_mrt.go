@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+)
+
+// Actor: Customer Energy Management
+// UseCase: Monitoring of Room Temperature
+type CemMRTInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the current room temperature (°C)
+	//
+	// parameters:
+	//   - entity: the entity of the HVAC room
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such measurement is (yet) available
+	//   - and others
+	Temperature(entity spineapi.EntityRemoteInterface) (float64, error)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: Customer Energy Management
+// UseCase: Optimization of Self Consumption by Heat Pump Compressor Flexibility
+type CemOHPCFInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the power sequences the compressor currently offers
+	//
+	// parameters:
+	//   - entity: the entity of the compressor
+	PowerSequences(entity spineapi.EntityRemoteInterface) ([]PowerSequence, error)
+
+	// Scenario 2
+
+	// schedule a power sequence to be started at the given time
+	//
+	// parameters:
+	//   - entity: the entity of the compressor
+	//   - sequenceId: the id of the power sequence
+	//   - startTime: the time the sequence should be started
+	ScheduleSequence(entity spineapi.EntityRemoteInterface, sequenceId uint, startTime time.Time) (*model.MsgCounterType, error)
+
+	// start a power sequence immediately
+	//
+	// parameters:
+	//   - entity: the entity of the compressor
+	//   - sequenceId: the id of the power sequence
+	StartSequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*model.MsgCounterType, error)
+
+	// cancel a previously scheduled power sequence
+	//
+	// parameters:
+	//   - entity: the entity of the compressor
+	//   - sequenceId: the id of the power sequence
+	CancelSequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*model.MsgCounterType, error)
+
+	// Scenario 3
+
+	// return the current state of a power sequence
+	//
+	// parameters:
+	//   - entity: the entity of the compressor
+	//   - sequenceId: the id of the power sequence
+	SequenceState(entity spineapi.EntityRemoteInterface, sequenceId uint) (model.PowerSequenceStateType, error)
+}
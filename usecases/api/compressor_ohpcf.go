@@ -0,0 +1,49 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: Compressor
+// UseCase: Optimization of Self Consumption by Heat Pump Compressor Flexibility
+type CompressorOHPCFInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the power sequences currently offered to the CEM
+	PowerSequences() []PowerSequence
+
+	// offer a new alternative of power sequences and return its id
+	//
+	// the ids of the power sequences are set automatically,
+	// use `PowerSequences` to get them
+	//
+	// parameters:
+	//   - sequences: the power sequences of the alternative, only one of them may be scheduled
+	AddAlternative(sequences []PowerSequence) (uint, error)
+
+	// remove an alternative including all of its power sequences
+	//
+	// parameters:
+	//   - alternativesId: the id of the alternative
+	RemoveAlternative(alternativesId uint) error
+
+	// replace the time slots of a power sequence
+	//
+	// parameters:
+	//   - sequenceId: the id of the power sequence
+	//   - slots: the time slots in the order of their execution
+	UpdateSequenceSlots(sequenceId uint, slots []PowerSequenceSlot) error
+
+	// Scenario 3
+
+	// update the state of a power sequence
+	//
+	// parameters:
+	//   - sequenceId: the id of the power sequence
+	//   - state: the new state of the power sequence
+	//   - activeSlot: the number of the currently active slot, only used if the state is running
+	UpdateSequenceState(sequenceId uint, state model.PowerSequenceStateType, activeSlot uint) error
+}
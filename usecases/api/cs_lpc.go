@@ -25,6 +25,8 @@ type CsLPCInterface interface {
 	ConsumptionLimit() (LoadLimit, error)
 
 	// set the current loadcontrol limit data
+	//
+	// an active limit with a duration is automatically deactivated once the duration elapsed
 	SetConsumptionLimit(limit LoadLimit) (resultErr error)
 
 	// return the currently pending incoming consumption write limits
@@ -38,6 +40,25 @@ type CsLPCInterface interface {
 	//  - reason: the reason why the approval is denied, otherwise an empty string
 	ApproveOrDenyConsumptionLimit(msgCounter model.MsgCounterType, approve bool, reason string)
 
+	// set the timeout for pending incoming consumption write limits
+	//
+	// If a pending write limit is not approved or denied within the timeout,
+	// it is approved or denied according to the policy.
+	// The timeout applies to write limits received afterwards.
+	//
+	// parameters:
+	//   - timeout: the timeout, 0 disables the timeout (default)
+	//   - policy: if the write limit is approved or denied once the timeout elapsed
+	//   - reason: the reason provided if the write limit is denied
+	SetWriteApprovalTimeout(timeout time.Duration, policy WriteApprovalPolicyType, reason string)
+
+	// set the store used to persist the consumption limit, the failsafe values and the nominal max
+	//
+	// The stored data is restored into the local server features right away, or once
+	// `AddFeatures` is invoked if the features were not yet added.
+	// Afterwards every change of the data is stored.
+	SetLimitStore(store LimitStoreInterface)
+
 	// Scenario 2
 
 	// return Failsafe limit for the consumed active (real) power of the
@@ -102,4 +123,38 @@ type CsLPCInterface interface {
 	// parameters:
 	//   - value: nominal max power consumption in W
 	SetConsumptionNominalMax(value float64) (resultErr error)
+
+	// State machine
+
+	// start the Controllable System state machine
+	//
+	// The state machine is not running by default. Once started it begins in "init" state
+	// and switches the states depending on the received heartbeats and limits, the
+	// failsafe consumption active power limit and the failsafe duration minimum.
+	StartStateMachine()
+
+	// stop the Controllable System state machine
+	//
+	// the last state is kept
+	StopStateMachine()
+
+	// set the clock used by the Controllable System state machine and
+	// for the end time of limits with a duration
+	//
+	// the system time is used by default or if clock is nil
+	SetClock(clock ClockInterface)
+
+	// return the current state of the Controllable System state machine
+	//
+	// returns an empty value if the state machine was never started
+	CurrentState() LimitationStateType
+
+	// return the consumption limit the Controllable System has to apply in the current state
+	//
+	// If the state machine was never started, the active consumption limit is returned
+	//
+	// return values:
+	//   - value: the power limit in W
+	//   - isLimited: false if no limit has to be applied
+	EffectiveConsumptionLimit() (value float64, isLimited bool)
 }
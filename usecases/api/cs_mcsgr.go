@@ -0,0 +1,41 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: Controllable System
+// UseCase: Monitoring and Control of Smart Grid Ready Conditions
+type CsMCSGRInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// return the current SG-Ready state
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such data is (yet) available
+	//   - and others
+	SGReadyState() (SGReadyStateType, error)
+
+	// set the current SG-Ready state
+	//
+	// possible errors:
+	//   - ErrNotSupported if the state is not supported
+	//   - and others
+	SetSGReadyState(state SGReadyStateType) error
+
+	// Scenario 2
+
+	// return the currently pending incoming SG-Ready state writes
+	PendingSGReadyStates() map[model.MsgCounterType]SGReadyStateType
+
+	// accept or deny an incoming SG-Ready state write
+	//
+	// parameters:
+	//  - msgCounter: the message counter of the incoming write message
+	//  - approve: if the SG-Ready state for msgCounter should be approved or not
+	//  - reason: the reason why the approval is denied, otherwise an empty string
+	ApproveOrDenySGReadyState(msgCounter model.MsgCounterType, approve bool, reason string)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: EV
+// UseCase: Coordinated EV Charging
+type EvCEVCInterface interface {
+	api.UseCaseInterface
+	EvDataResetInterface
+
+	// Scenario 1
+
+	// set the current energy demand of the EV
+	//
+	// parameters:
+	//   - demand: details about the actual demand of the EV,
+	//     if DurationUntilEnd is 0, direct charging is active, otherwise timed charging
+	SetEnergyDemand(demand Demand) error
+
+	// Scenario 2
+
+	// set the constraints the power limits of the CEM have to fullfill
+	//
+	// incoming power limits not matching these constraints are denied
+	//
+	// parameters:
+	//   - constraints: the time slot constraints
+	SetTimeSlotConstraints(constraints TimeSlotConstraints) error
+
+	// request new power limits from the CEM
+	//
+	// parameters:
+	//   - required: if true, the CEM is requested to send new power limits
+	SetPowerLimitsUpdateRequired(required bool) error
+
+	// return the power limits received from the CEM
+	//
+	// return values:
+	//   - limits: the power limit of each slot in W
+	//   - error: if no data is available
+	PowerLimits() ([]DurationSlotValue, error)
+
+	// Scenario 3
+
+	// set the constraints the incentives of the CEM have to fullfill
+	//
+	// incoming incentives not matching these constraints are denied
+	//
+	// parameters:
+	//   - constraints: the incentive slot constraints
+	SetIncentiveConstraints(constraints IncentiveSlotConstraints) error
+
+	// request new incentive table descriptions from the CEM
+	//
+	// parameters:
+	//   - required: if true, the CEM is requested to send new incentive table descriptions
+	SetIncentivesUpdateRequired(required bool) error
+
+	// return the incentive table descriptions received from the CEM
+	//
+	// return values:
+	//   - descriptions: the tiers, boundaries and incentives of each tariff
+	//   - error: if no data is available
+	IncentiveTableDescriptions() ([]IncentiveTariffDescription, error)
+
+	// return the incentives received from the CEM
+	//
+	// return values:
+	//   - incentives: the incentive of each slot, as described by the incentive table description
+	//   - error: if no data is available
+	Incentives() ([]DurationSlotValue, error)
+
+	// Scenario 4
+
+	// set the charge plan of the EV
+	//
+	// parameters:
+	//   - plan: the planned power of each slot
+	SetChargePlan(plan ChargePlan) error
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: EV
+// UseCase: EV Commissioning and Configuration
+type EvEVCCInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// add the EV entity to the EVSE, invoked when an EV is plugged in
+	//
+	// the use cases announced for the EV entity are restored
+	// and the charge state is set to active
+	EVConnected()
+
+	// return if the EV entity is currently added to the EVSE
+	IsEVConnected() bool
+
+	// Scenario 2
+
+	// set the communication standard used to communicate between EVSE and EV
+	//
+	// parameters:
+	//   - standard: the communication standard, e.g. model.DeviceConfigurationKeyValueStringTypeISO151182ED2
+	SetCommunicationStandard(standard model.DeviceConfigurationKeyValueStringType) error
+
+	// Scenario 3
+
+	// set if the EV supports asymmetric charging
+	//
+	// parameters:
+	//   - supported: true if asymmetric charging is supported
+	SetAsymmetricChargingSupport(supported bool) error
+
+	// Scenario 4
+
+	// set the identifications of the connected EV, e.g. PCID, Mac Address, RFID
+	//
+	// parameters:
+	//   - identifications: the identifications, an empty list removes all identifications
+	SetIdentifications(identifications []IdentificationItem) error
+
+	// Scenario 5
+
+	// set the manufacturer data of the EV
+	//
+	// parameters:
+	//   - data: the manufacturer data, empty fields are not provided
+	SetManufacturerData(data api.ManufacturerData) error
+
+	// Scenario 6
+
+	// set the minimum, maximum and standby charging power of the EV
+	//
+	// parameters:
+	//   - minimum: the minimum charging power in W
+	//   - maximum: the maximum charging power in W
+	//   - standby: the standby power in W
+	SetChargingPowerLimits(minimum, maximum, standby float64) error
+
+	// Scenario 7
+
+	// set the current charge state of the EV
+	//
+	// the paused state is reported as sleep mode
+	//
+	// parameters:
+	//   - state: the charge state, unknown and unplugged are not supported
+	SetChargeState(state EVChargeStateType) error
+
+	// Scenario 8
+
+	// remove the EV entity from the EVSE, invoked when an EV is unplugged
+	//
+	// all EV specific data is reset, including the data of the use cases
+	// added via AddEVDataUseCase
+	EVDisconnected()
+
+	// add another use case of the EV actor, which data is reset once the EV is disconnected
+	//
+	// parameters:
+	//   - usecase: the use case using the EV entity
+	AddEVDataUseCase(usecase EvDataResetInterface)
+}
+
+// Use cases of the EV actor providing data of the connected EV
+type EvDataResetInterface interface {
+	// reset the data of the connected EV, invoked once the EV is disconnected
+	ResetEVData()
+}
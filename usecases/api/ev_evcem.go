@@ -0,0 +1,45 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: EV
+// UseCase: Measurement of Electricity during EV Charging
+type EvEVCEMInterface interface {
+	api.UseCaseInterface
+	EvDataResetInterface
+
+	// set the phases the connected EV is charging with
+	//
+	// the measurement descriptions are replaced and all measured values are removed
+	//
+	// parameters:
+	//   - connectedPhases: the connected phases, e.g. "abc" or "a"
+	SetConnectedPhases(connectedPhases model.ElectricalConnectionPhaseNameType) error
+
+	// Scenario 1
+
+	// set the momentary charging current per phase
+	//
+	// parameters:
+	//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+	UpdateCurrentPerPhase(phaseCurrent []float64) error
+
+	// Scenario 2
+
+	// set the momentary charging power per phase
+	//
+	// parameters:
+	//   - phasePower: the power in W for each connected phase, in the order of phases a, b and c
+	UpdatePowerPerPhase(phasePower []float64) error
+
+	// Scenario 3
+
+	// set the energy charged during the current charging session
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyCharged(energy float64) error
+}
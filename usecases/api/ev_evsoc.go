@@ -0,0 +1,44 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: EV
+// UseCase: EV State Of Charge
+type EvEVSOCInterface interface {
+	api.UseCaseInterface
+	EvDataResetInterface
+
+	// Scenario 1
+
+	// set the current state of charge of the EV
+	//
+	// parameters:
+	//   - stateOfCharge: the state of charge in %
+	UpdateStateOfCharge(stateOfCharge float64) error
+
+	// Scenario 2
+
+	// set the nominal battery capacity of the EV
+	//
+	// parameters:
+	//   - capacity: the nominal capacity in Wh
+	UpdateNominalCapacity(capacity float64) error
+
+	// Scenario 3
+
+	// set the actual usable battery capacity of the EV
+	//
+	// parameters:
+	//   - capacity: the actual capacity in Wh
+	UpdateActualCapacity(capacity float64) error
+
+	// Scenario 4
+
+	// set the remaining travel range of the EV
+	//
+	// parameters:
+	//   - travelRange: the travel range in m
+	UpdateTravelRange(travelRange float64) error
+}
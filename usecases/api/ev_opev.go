@@ -0,0 +1,59 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: EV
+// UseCase: Overload Protection by EV Charging Current Curtailment
+type EvOPEVInterface interface {
+	api.UseCaseInterface
+	EvDataResetInterface
+
+	// Scenario 1
+
+	// set the minimum, maximum and standby charging current of the EV per phase
+	//
+	// the values are shared with the OSCEV use case
+	//
+	// parameters:
+	//   - minimum: the minimum current in A for each phase, in the order of phases a, b and c
+	//   - maximum: the maximum current in A for each phase, in the order of phases a, b and c
+	//   - standby: the standby current in A for each phase, in the order of phases a, b and c
+	SetCurrentLimits(minimum, maximum, standby []float64) error
+
+	// return the current loadcontrol obligation limits
+	//
+	// return values:
+	//   - limits: per phase data
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no such limit is (yet) available
+	//   - and others
+	LoadControlLimits() (limits []LoadLimitsPhase, resultErr error)
+
+	// return the currently pending incoming obligation write limits
+	PendingLoadControlLimits() map[model.MsgCounterType][]LoadLimitsPhase
+
+	// accept or deny an incoming obligation write limit
+	//
+	// parameters:
+	//  - msgCounter: the message counter of the incoming write message
+	//  - approve: if the write limits for msgCounter should be approved or not
+	//  - reason: the reason why the approval is denied, otherwise an empty string
+	ApproveOrDenyLoadControlLimits(msgCounter model.MsgCounterType, approve bool, reason string)
+
+	// return the effective charging current limit per phase
+	//
+	// the maximum permitted current, reduced by the active obligation limits
+	// and the active recommendation limits of the OSCEV use case
+	//
+	// return values:
+	//   - the limits in A, in the order of phases a, b and c
+	//
+	// possible errors:
+	//   - ErrDataNotAvailable if no permitted values are available
+	//   - and others
+	EffectiveCurrentLimits() ([]float64, error)
+}
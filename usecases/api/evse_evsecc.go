@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Actor: EVSE
+// UseCase: EVSE Commissioning and Configuration
+type EvseEVSECCInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the manufacturer data of the EVSE
+	//
+	// parameters:
+	//   - data: the manufacturer data, empty fields are not provided
+	SetManufacturerData(data api.ManufacturerData) error
+
+	// Scenario 2
+
+	// set the operating state of the EVSE
+	//
+	// parameters:
+	//   - state: the operating state
+	//   - errorMessage: the last error code, only provided if not empty
+	SetOperatingState(state model.DeviceDiagnosisOperatingStateType, errorMessage string) error
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: Grid Connection Point
+// UseCase: Monitoring of Grid Connection Point
+type GcpMGCPInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the current power limitation factor
+	//
+	// parameters:
+	//   - factor: the factor of the PV peak power the production is limited to, e.g. 0.7
+	UpdatePowerLimitationFactor(factor float64) error
+
+	// Scenario 2
+
+	// set the momentary power consumption or production at the grid connection point
+	//
+	// parameters:
+	//   - power: the power in W
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	UpdatePower(power float64) error
+
+	// Scenario 3
+
+	// set the total feed in energy at the grid connection point
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyFeedIn(energy float64) error
+
+	// Scenario 4
+
+	// set the total consumption energy at the grid connection point
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyConsumed(energy float64) error
+
+	// Scenario 5
+
+	// set the momentary current consumption or production at the grid connection point
+	//
+	// parameters:
+	//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	UpdateCurrentPerPhase(phaseCurrent []float64) error
+
+	// Scenario 6
+
+	// set the voltage phase details at the grid connection point
+	//
+	// parameters:
+	//   - phaseVoltage: the voltage in V for each connected phase, in the order of phases a, b and c
+	UpdateVoltagePerPhase(phaseVoltage []float64) error
+
+	// Scenario 7
+
+	// set the frequency at the grid connection point
+	//
+	// parameters:
+	//   - frequency: the frequency in Hz
+	UpdateFrequency(frequency float64) error
+}
@@ -0,0 +1,30 @@
+package api
+
+import "time"
+
+// Contains the data of a Controllable System in the LPC and LPP use cases
+// that has to survive a restart
+//
+// Zero values are not restored, as they can not be distinguished from the
+// defaults of the server features, so values set by the application are kept
+type LimitStoreData struct {
+	LimitValue       float64       `json:"limitValue"`             // the limit value
+	LimitActive      bool          `json:"limitActive"`            // if the limit is active
+	LimitEndTime     *time.Time    `json:"limitEndTime,omitempty"` // the end time of an active limit with a duration
+	FailsafeLimit    float64       `json:"failsafeLimit"`          // the failsafe active power limit
+	FailsafeDuration time.Duration `json:"failsafeDuration"`       // the failsafe duration minimum
+	NominalMax       float64       `json:"nominalMax"`             // the nominal max active power
+}
+
+// Persists the limits and failsafe values of a Controllable System
+//
+// Used by the Controllable System actors of the LPC and LPP use cases
+type LimitStoreInterface interface {
+	// return the stored data
+	//
+	// returns nil without an error if no data was stored yet
+	Load() (*LimitStoreData, error)
+
+	// store the data, replacing the previously stored data
+	Save(data LimitStoreData) error
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: Monitored Unit
+// UseCase: Monitoring of Power Consumption
+type MuMPCInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the momentary active power consumption or production
+	//
+	// parameters:
+	//   - power: the power in W
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	UpdatePower(power float64) error
+
+	// set the momentary active phase specific power consumption or production per phase
+	//
+	// parameters:
+	//   - phasePower: the power in W for each connected phase, in the order of phases a, b and c
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	UpdatePowerPerPhase(phasePower []float64) error
+
+	// Scenario 2
+
+	// set the total consumption energy
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyConsumed(energy float64) error
+
+	// set the total feed in energy
+	//
+	// parameters:
+	//   - energy: the energy in Wh
+	UpdateEnergyProduced(energy float64) error
+
+	// Scenario 3
+
+	// set the momentary phase specific current consumption or production
+	//
+	// parameters:
+	//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+	//
+	//   - positive values are used for consumption
+	//   - negative values are used for production
+	UpdateCurrentPerPhase(phaseCurrent []float64) error
+
+	// Scenario 4
+
+	// set the phase specific voltage details
+	//
+	// parameters:
+	//   - phaseVoltage: the voltage in V for each connected phase, in the order of phases a, b and c
+	UpdateVoltagePerPhase(phaseVoltage []float64) error
+
+	// Scenario 5
+
+	// set the frequency
+	//
+	// parameters:
+	//   - frequency: the frequency in Hz
+	UpdateFrequency(frequency float64) error
+}
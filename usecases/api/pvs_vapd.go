@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/enbility/eebus-go/api"
+)
+
+// Actor: PV System
+// UseCase: Visualization of Aggregated Photovoltaic Data
+type PvsVAPDInterface interface {
+	api.UseCaseInterface
+
+	// Scenario 1
+
+	// set the nominal peak power of the PV system
+	//
+	// parameters:
+	//   - power: the power in W
+	UpdatePowerNominalPeak(power float64) error
+
+	// Scenario 2
+
+	// set the momentary production power of the PV system
+	//
+	// parameters:
+	//   - power: the power in W
+	//
+	//   - positive values are used for production
+	UpdatePower(power float64) error
+
+	// Scenario 3
+
+	// set the total PV yield of the PV system
+	//
+	// parameters:
+	//   - yield: the energy in Wh
+	UpdatePVYieldTotal(yield float64) error
+
+	// Scenario 1 - 3
+
+	// set the data of all scenarios aggregated from several inverters
+	//
+	// all values are summed up
+	//
+	// parameters:
+	//   - inverters: the data of each inverter
+	UpdateInverters(inverters []PVInverterData) error
+}
@@ -17,6 +17,60 @@ const (
 	EVChargeStateTypeFinished  EVChargeStateType = "finished"
 )
 
+// Defines the states of a Controllable System in the LPC and LPP use cases
+type LimitationStateType string
+
+const (
+	// the Controllable System started and applies the failsafe limit
+	LimitationStateTypeInit LimitationStateType = "init"
+
+	// the Energy Guard is available and no limit is active
+	LimitationStateTypeUnlimitedControlled LimitationStateType = "unlimited/controlled"
+
+	// the Energy Guard is available and a limit is active
+	LimitationStateTypeLimited LimitationStateType = "limited"
+
+	// the heartbeat of the Energy Guard is missing and the failsafe limit applies
+	LimitationStateTypeFailsafe LimitationStateType = "failsafe"
+
+	// the Energy Guard is not available and no limit applies
+	LimitationStateTypeUnlimitedAutonomous LimitationStateType = "unlimited/autonomous"
+)
+
+// Provides the current time
+//
+// Used by the Controllable System state machine of the LPC and LPP use cases,
+// e.g. to replace the system time in tests
+type ClockInterface interface {
+	// return the current time
+	Now() time.Time
+}
+
+// Defines how a pending write approval is answered once its timeout elapsed
+type WriteApprovalPolicyType string
+
+const (
+	WriteApprovalPolicyTypeApprove WriteApprovalPolicyType = "approve"
+	WriteApprovalPolicyTypeDeny    WriteApprovalPolicyType = "deny"
+)
+
+// Defines the SG-Ready operating states of a Controllable System
+type SGReadyStateType uint
+
+const (
+	// SG-Ready condition 1: the operation is blocked
+	SGReadyStateTypeBlocked SGReadyStateType = 1
+
+	// SG-Ready condition 2: normal operation
+	SGReadyStateTypeNormal SGReadyStateType = 2
+
+	// SG-Ready condition 3: an increased operation is recommended
+	SGReadyStateTypeRecommendedIncrease SGReadyStateType = 3
+
+	// SG-Ready condition 4: an increased operation is forced
+	SGReadyStateTypeForcedIncrease SGReadyStateType = 4
+)
+
 // Defines a phase specific limit data set
 type LoadLimitsPhase struct {
 	Phase        model.ElectricalConnectionPhaseNameType // the phase
@@ -150,3 +204,67 @@ type DurationSlotValue struct {
 	Duration time.Duration // Duration of this slot
 	Value    float64       // Energy Cost or Power Limit
 }
+
+// Contains the data of a single battery pack, which is aggregated
+// with the other packs of a battery system
+type BatteryPackData struct {
+	Power            float64 // momentary (dis-)charge power in W, positive values are charge power
+	EnergyCharged    float64 // total charged energy in Wh
+	EnergyDischarged float64 // total discharged energy in Wh
+	StateOfCharge    float64 // state of charge in %
+	Capacity         float64 // usable capacity in Wh, used to weight the state of charge if set for all packs
+}
+
+// Contains the data of a single inverter, which is aggregated
+// with the other inverters of a PV system
+type PVInverterData struct {
+	Power            float64 // momentary production power in W, positive values are production
+	PVYieldTotal     float64 // total PV yield in Wh
+	PowerNominalPeak float64 // nominal peak power of the connected PV strings in W
+}
+
+// Contains the details of a power sequence offered by a flexible consumer,
+// e.g. the compressor of a heat pump
+type PowerSequence struct {
+	Id             uint // the id of the sequence, used for scheduling it
+	AlternativesId uint // the id of the group of alternatives this sequence belongs to
+
+	State              model.PowerSequenceStateType // the current state of the sequence
+	RemoteControllable bool                         // true if the sequence can be scheduled or started by the CEM
+	ActiveSlot         uint                         // the number of the currently active slot, only relevant if running
+
+	EarliestStartTime time.Time // the earliest time the sequence may be started, zero if not restricted
+	LatestEndTime     time.Time // the latest time the sequence has to be finished, zero if not restricted
+	StartTime         time.Time // the scheduled start time, zero if not scheduled
+
+	Slots []PowerSequenceSlot // the time slots of the sequence in the order of their execution
+}
+
+// Contains the details of a single time slot of a power sequence
+type PowerSequenceSlot struct {
+	Number   uint          // the number of the slot within the sequence
+	Duration time.Duration // the default duration of the slot
+	Power    float64       // the expected power in W
+	PowerMin float64       // the minimum power in W, 0 if not provided
+	PowerMax float64       // the maximum power in W, 0 if not provided
+}
+
+// Contains the details of a setpoint, e.g. a temperature setpoint
+type Setpoint struct {
+	Id           uint    // the id of the setpoint, used for writing it
+	Value        float64 // the current value of the setpoint
+	MinValue     float64 // the minimum value of the setpoint, 0 if not provided
+	MaxValue     float64 // the maximum value of the setpoint, 0 if not provided
+	IsActive     bool    // if the setpoint is active, true if not provided
+	IsChangeable bool    // if the value can be changed via write
+}
+
+// Contains the constraints of a setpoint
+type SetpointConstraints struct {
+	Id          uint    // the id of the setpoint
+	MinValue    float64 // the minimum value the setpoint can be set to, 0 if not provided
+	HasMinValue bool    // if a minimum value is provided
+	MaxValue    float64 // the maximum value the setpoint can be set to, 0 if not provided
+	HasMaxValue bool    // if a maximum value is provided
+	StepSize    float64 // the value has to be a multiple of this value, starting at the minimum value if provided, if != 0
+}
@@ -0,0 +1,109 @@
+package vabd
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+)
+
+// set the values of the measurements of the provided scopes
+func (e *VABD) updateMeasurements(scopes []model.ScopeTypeType, values []float64) error {
+	e.mux.Lock()
+	var ids []model.MeasurementIdType
+	for _, scope := range scopes {
+		id, ok := e.measurementIds[scope]
+		if !ok {
+			e.mux.Unlock()
+			return api.ErrMetadataNotAvailable
+		}
+		ids = append(ids, id)
+	}
+	e.mux.Unlock()
+
+	return internal.UpdateMeasurementValues(e.LocalEntity, ids, values)
+}
+
+// Scenario 1
+
+// set the momentary (dis-)charge power of the battery system
+//
+//   - power: the power in W
+//   - positive values are used for charge power
+//   - negative values are used for discharge power
+func (e *VABD) UpdatePower(power float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeACPowerTotal}, []float64{power})
+}
+
+// Scenario 2
+
+// set the total charged energy of the battery system
+//
+//   - energy: the energy in Wh
+func (e *VABD) UpdateEnergyCharged(energy float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeCharge}, []float64{energy})
+}
+
+// Scenario 3
+
+// set the total discharged energy of the battery system
+//
+//   - energy: the energy in Wh
+func (e *VABD) UpdateEnergyDischarged(energy float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeDischarge}, []float64{energy})
+}
+
+// Scenario 4
+
+// set the state of charge of the battery system
+//
+//   - stateOfCharge: the state of charge in %
+func (e *VABD) UpdateStateOfCharge(stateOfCharge float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeStateOfCharge}, []float64{stateOfCharge})
+}
+
+// Scenario 1 - 4
+
+// set the data of all scenarios aggregated from several battery packs
+//
+// the power and energy values are summed up, the state of charge
+// is weighted by the capacity of each pack, or averaged if any
+// pack does not provide a capacity
+//
+//   - packs: the data of each battery pack
+func (e *VABD) UpdateBatteryPacks(packs []ucapi.BatteryPackData) error {
+	if len(packs) == 0 {
+		return api.ErrMissingData
+	}
+
+	var power, charged, discharged, capacity, weightedSoC, soc float64
+	allCapacities := true
+	for _, pack := range packs {
+		power += pack.Power
+		charged += pack.EnergyCharged
+		discharged += pack.EnergyDischarged
+		capacity += pack.Capacity
+		weightedSoC += pack.StateOfCharge * pack.Capacity
+		soc += pack.StateOfCharge
+
+		if pack.Capacity <= 0 {
+			allCapacities = false
+		}
+	}
+
+	// a pack without a capacity would otherwise not be part of the state of charge
+	if allCapacities {
+		soc = weightedSoC / capacity
+	} else {
+		soc /= float64(len(packs))
+	}
+
+	// all values are updated at once, so a client never receives a partial aggregate
+	scopes := []model.ScopeTypeType{
+		model.ScopeTypeTypeACPowerTotal,
+		model.ScopeTypeTypeCharge,
+		model.ScopeTypeTypeDischarge,
+		model.ScopeTypeTypeStateOfCharge,
+	}
+	return e.updateMeasurements(scopes, []float64{power, charged, discharged, soc})
+}
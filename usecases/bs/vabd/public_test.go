@@ -0,0 +1,97 @@
+package vabd
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *BsVABDSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *BsVABDSuite) Test_Measurements() {
+	err := s.sut.UpdatePower(-2500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-2500}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+
+	err = s.sut.UpdateEnergyCharged(1000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.valuesForScope(model.ScopeTypeTypeCharge))
+
+	err = s.sut.UpdateEnergyDischarged(800)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{800}, s.valuesForScope(model.ScopeTypeTypeDischarge))
+
+	err = s.sut.UpdateStateOfCharge(75)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{75}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+}
+
+func (s *BsVABDSuite) Test_MissingDescriptions() {
+	sut := NewVABD(s.localEntity, s.Event)
+
+	err := sut.UpdatePower(1000)
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+
+	err = sut.UpdateBatteryPacks([]ucapi.BatteryPackData{{Power: 1000}})
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+}
+
+func (s *BsVABDSuite) Test_UpdateBatteryPacks() {
+	err := s.sut.UpdateBatteryPacks(nil)
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	packs := []ucapi.BatteryPackData{
+		{
+			Power:            1000,
+			EnergyCharged:    500,
+			EnergyDischarged: 200,
+			StateOfCharge:    50,
+			Capacity:         10000,
+		},
+		{
+			Power:            -400,
+			EnergyCharged:    300,
+			EnergyDischarged: 100,
+			StateOfCharge:    80,
+			Capacity:         5000,
+		},
+	}
+	err = s.sut.UpdateBatteryPacks(packs)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{600}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+	assert.Equal(s.T(), []float64{800}, s.valuesForScope(model.ScopeTypeTypeCharge))
+	assert.Equal(s.T(), []float64{300}, s.valuesForScope(model.ScopeTypeTypeDischarge))
+	assert.Equal(s.T(), []float64{60}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+
+	// if a pack has no capacity the state of charge is averaged
+	packs[1].Capacity = 0
+	err = s.sut.UpdateBatteryPacks(packs)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{65}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+
+	packs[0].Capacity = 0
+	err = s.sut.UpdateBatteryPacks(packs)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{65}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+}
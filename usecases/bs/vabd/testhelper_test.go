@@ -0,0 +1,54 @@
+package vabd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBsVABDSuite(t *testing.T) {
+	suite.Run(t, new(BsVABDSuite))
+}
+
+type BsVABDSuite struct {
+	suite.Suite
+
+	sut *VABD
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *BsVABDSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *BsVABDSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeInverter},
+		model.DeviceTypeTypeInverter,
+		[]model.EntityTypeType{model.EntityTypeTypeElectricityStorageSystem},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeElectricityStorageSystem)
+	s.sut = NewVABD(s.localEntity, s.Event)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+}
@@ -0,0 +1,156 @@
+package vabd
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection used for all measurements
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type VABD struct {
+	*usecase.UseCaseBase
+
+	measurementIds map[model.ScopeTypeType]model.MeasurementIdType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.BsVABDInterface = (*VABD)(nil)
+
+// Create a new Battery System VABD use case
+//
+// Several battery packs can be provided as one battery system
+// via UpdateBatteryPacks.
+//
+// parameters:
+//   - localEntity: the local electricity storage system entity providing the battery data
+//   - eventCB: the callback for use case events
+func NewVABD(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *VABD {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(2),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(3),
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(4),
+			Mandatory: true,
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeBatterySystem,
+		model.UseCaseNameTypeVisualizationOfAggregatedBatteryData,
+		"1.0.1",
+		"RC1",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &VABD{
+		UseCaseBase:    usecase,
+		measurementIds: make(map[model.ScopeTypeType]model.MeasurementIdType),
+	}
+
+	return uc
+}
+
+// add the measurement and parameter description for a scope
+func (e *VABD) addMeasurement(
+	measurementType model.MeasurementTypeType,
+	unit model.UnitOfMeasurementType,
+	scope model.ScopeTypeType,
+) {
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(measurementType),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(unit),
+		ScopeType:       util.Ptr(scope),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(electricalConnectionId),
+		VoltageType:            util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		AcMeasurementType:      util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+	}
+
+	id, err := internal.AddMeasurementWithParameterDescription(e.LocalEntity, measurementDesc, paramDesc)
+	if err != nil {
+		logging.Log().Debug("VABD addMeasurement: error adding description", scope, err)
+		return
+	}
+
+	e.measurementIds[scope] = *id
+}
+
+func (e *VABD) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are only added once
+	if len(e.measurementIds) > 0 {
+		return
+	}
+
+	// charging the battery consumes energy, so the power is positive while charging
+	if ec, err := server.NewElectricalConnection(e.LocalEntity); err == nil {
+		_ = ec.AddDescription(model.ElectricalConnectionDescriptionDataType{
+			ElectricalConnectionId:  util.Ptr(electricalConnectionId),
+			PowerSupplyType:         util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			PositiveEnergyDirection: util.Ptr(model.EnergyDirectionTypeConsume),
+		})
+	}
+
+	// Scenario 1
+	e.addMeasurement(model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPowerTotal)
+
+	// Scenario 2
+	e.addMeasurement(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeCharge)
+
+	// Scenario 3
+	e.addMeasurement(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeDischarge)
+
+	// Scenario 4
+	// the state of charge is not an electrical measurement, so there is no parameter description
+	if measurement, err := server.NewMeasurement(e.LocalEntity); err == nil {
+		if id := measurement.AddDescription(model.MeasurementDescriptionDataType{
+			MeasurementType: util.Ptr(model.MeasurementTypeTypePercentage),
+			CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+			Unit:            util.Ptr(model.UnitOfMeasurementTypepct),
+			ScopeType:       util.Ptr(model.ScopeTypeTypeStateOfCharge),
+		}); id != nil {
+			e.measurementIds[model.ScopeTypeTypeStateOfCharge] = *id
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package vabd
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *BsVABDSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *BsVABDSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// power, charged energy, discharged energy, state of charge
+	assert.Equal(s.T(), 4, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4, len(descs))
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descriptions, err := ec.GetDescriptionsForFilter(model.ElectricalConnectionDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descriptions))
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *descriptions[0].PositiveEnergyDirection)
+
+	// the state of charge has no parameter description
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(params))
+
+	socDescs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(model.ScopeTypeTypeStateOfCharge),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(socDescs))
+	params, err = ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		MeasurementId: socDescs[0].MeasurementId,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(params))
+}
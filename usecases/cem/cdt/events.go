@@ -0,0 +1,93 @@
+package cdt
+
+import (
+	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *CDT) HandleEvent(payload spineapi.EventPayload) {
+	// only about events from a DHW circuit entity or device changes for this remote device
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if internal.IsEntityConnected(payload) {
+		e.dhwCircuitConnected(payload.Entity)
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.SetpointDescriptionListDataType:
+		e.dhwCircuitSetpointDescriptionDataUpdate(payload.Entity)
+
+	case *model.SetpointConstraintsListDataType:
+		e.dhwCircuitSetpointConstraintsDataUpdate(payload)
+
+	case *model.SetpointListDataType:
+		e.dhwCircuitSetpointDataUpdate(payload)
+	}
+}
+
+// process required steps when a DHW circuit is connected
+func (e *CDT) dhwCircuitConnected(entity spineapi.EntityRemoteInterface) {
+	if setpoint, err := client.NewSetpoint(e.LocalEntity, entity); err == nil {
+		if !setpoint.HasSubscription() {
+			if _, err := setpoint.Subscribe(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// a binding is required to write setpoints
+		if !setpoint.HasBinding() {
+			if _, err := setpoint.Bind(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// get setpoint parameters
+		if _, err := setpoint.RequestDescriptions(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+
+		if _, err := setpoint.RequestConstraints(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+	}
+}
+
+// the setpoint description data of a DHW circuit was updated
+func (e *CDT) dhwCircuitSetpointDescriptionDataUpdate(entity spineapi.EntityRemoteInterface) {
+	if setpoint, err := client.NewSetpoint(e.LocalEntity, entity); err == nil {
+		// setpoint descriptions received, now get the data
+		if _, err := setpoint.RequestData(nil, nil); err != nil {
+			logging.Log().Error("Error getting setpoint list values:", err)
+		}
+	}
+}
+
+// the setpoint constraints data of a DHW circuit was updated
+func (e *CDT) dhwCircuitSetpointConstraintsDataUpdate(payload spineapi.EventPayload) {
+	if e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSetpointConstraints)
+	}
+}
+
+// the setpoint data of a DHW circuit was updated
+func (e *CDT) dhwCircuitSetpointDataUpdate(payload spineapi.EventPayload) {
+	if setpoint, err := client.NewSetpoint(e.LocalEntity, payload.Entity); err == nil {
+		// Scenario 1
+		if setpoint.CheckEventPayloadDataForFilter(payload.Data, setpointFilter) && e.EventCB != nil {
+			e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSetpoints)
+		}
+	}
+}
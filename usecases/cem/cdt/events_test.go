@@ -0,0 +1,99 @@
+package cdt
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemCDTSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.Entity = s.dhwCircuitEntity
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeEntityChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = util.Ptr(model.SetpointDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.SetpointConstraintsListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.SetpointListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.NodeManagementUseCaseDataType{})
+	s.sut.HandleEvent(payload)
+}
+
+func (s *CemCDTSuite) Test_Failures() {
+	s.sut.dhwCircuitConnected(s.mockRemoteEntity)
+
+	s.sut.dhwCircuitSetpointDescriptionDataUpdate(s.mockRemoteEntity)
+}
+
+func (s *CemCDTSuite) Test_dhwCircuitSetpointConstraintsDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.dhwCircuitEntity,
+	}
+	s.sut.dhwCircuitSetpointConstraintsDataUpdate(payload)
+	assert.True(s.T(), s.eventCalled)
+}
+
+func (s *CemCDTSuite) Test_dhwCircuitSetpointDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.dhwCircuitEntity,
+	}
+	s.sut.dhwCircuitSetpointDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.dhwCircuitEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.dhwCircuitSetpointDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	data := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId: util.Ptr(model.SetpointIdType(0)),
+				Value:      model.NewScaledNumberType(50),
+			},
+		},
+	}
+
+	payload.Data = data
+
+	s.sut.dhwCircuitSetpointDataUpdate(payload)
+	assert.True(s.T(), s.eventCalled)
+}
@@ -0,0 +1,68 @@
+package cdt
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the DHW temperature setpoints are absolute values
+var setpointFilter = model.SetpointDescriptionDataType{
+	SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+}
+
+// Scenario 1
+
+// return the current DHW temperature setpoints (°C)
+//
+// parameters:
+//   - entity: the entity of the DHW circuit
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - and others
+func (e *CDT) Setpoints(entity spineapi.EntityRemoteInterface) ([]ucapi.Setpoint, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return internal.Setpoints(e.LocalEntity, entity, setpointFilter)
+}
+
+// return the constraints of the DHW temperature setpoints (°C)
+//
+// parameters:
+//   - entity: the entity of the DHW circuit
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such constraints are (yet) available
+//   - and others
+func (e *CDT) SetpointConstraints(entity spineapi.EntityRemoteInterface) ([]ucapi.SetpointConstraints, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return internal.SetpointConstraints(e.LocalEntity, entity, setpointFilter)
+}
+
+// write a new DHW temperature setpoint (°C)
+//
+// parameters:
+//   - entity: the entity of the DHW circuit
+//   - setpointId: the id of the setpoint to be changed
+//   - value: the new value of the setpoint
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - ErrNotSupported if the setpoint can not be changed
+//   - and others, e.g. if the value violates the setpoint constraints
+func (e *CDT) WriteSetpoint(entity spineapi.EntityRemoteInterface, setpointId uint, value float64) (*model.MsgCounterType, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	return internal.WriteSetpoint(e.LocalEntity, entity, setpointFilter, setpointId, value)
+}
@@ -0,0 +1,167 @@
+package cdt
+
+import (
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemCDTSuite) Test_Setpoints() {
+	data, err := s.sut.Setpoints(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.Setpoints(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.dhwCircuitEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Setpoints(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	setpointData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(50),
+				ValueMin:             model.NewScaledNumberType(40),
+				ValueMax:             model.NewScaledNumberType(60),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Setpoints(s.dhwCircuitEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(0), data[0].Id)
+	assert.Equal(s.T(), 50.0, data[0].Value)
+	assert.Equal(s.T(), 40.0, data[0].MinValue)
+	assert.Equal(s.T(), 60.0, data[0].MaxValue)
+	assert.True(s.T(), data[0].IsActive)
+	assert.True(s.T(), data[0].IsChangeable)
+}
+
+func (s *CemCDTSuite) Test_SetpointConstraints() {
+	data, err := s.sut.SetpointConstraints(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.SetpointConstraints(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.dhwCircuitEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SetpointConstraints(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	constraintsData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(30),
+				SetpointRangeMax: model.NewScaledNumberType(70),
+				SetpointStepSize: model.NewScaledNumberType(0.5),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, constraintsData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SetpointConstraints(s.dhwCircuitEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 30.0, data[0].MinValue)
+	assert.Equal(s.T(), 70.0, data[0].MaxValue)
+	assert.Equal(s.T(), 0.5, data[0].StepSize)
+}
+
+func (s *CemCDTSuite) Test_WriteSetpoint() {
+	_, err := s.sut.WriteSetpoint(s.mockRemoteEntity, 0, 50)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.WriteSetpoint(s.dhwCircuitEntity, 0, 50)
+	assert.NotNil(s.T(), err)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.dhwCircuitEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	setpointData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(50),
+				IsSetpointChangeable: util.Ptr(false),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.WriteSetpoint(s.dhwCircuitEntity, 0, 55)
+	assert.NotNil(s.T(), err)
+
+	setpointData.SetpointData[0].IsSetpointChangeable = util.Ptr(true)
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	constraintsData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(30),
+				SetpointRangeMax: model.NewScaledNumberType(70),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, constraintsData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.WriteSetpoint(s.dhwCircuitEntity, 0, 80)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.WriteSetpoint(s.dhwCircuitEntity, 0, 55)
+	assert.Nil(s.T(), err)
+}
@@ -0,0 +1,58 @@
+package cdt
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type CDT struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemCDTInterface = (*CDT)(nil)
+
+func NewCDT(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *CDT {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeDHWCircuit,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeDHWCircuit,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:       model.UseCaseScenarioSupportType(1),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeSetpoint},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeConfigurationOfDhwTemperature,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &CDT{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *CDT) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeSetpoint, model.RoleTypeClient)
+}
@@ -0,0 +1,5 @@
+package cdt
+
+func (s *CemCDTSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/features/client"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -125,50 +126,16 @@ func (e *CEVC) WriteIncentiveTableDescriptions(entity spineapi.EntityRemoteInter
 
 			newTariff := model.IncentiveTableDescriptionType{
 				TariffDescription: tariffDesc,
+				Tier:              internal.IncentiveTableDescriptionTiers(tariff.Tiers),
 			}
 
-			tierData := []model.IncentiveTableDescriptionTierType{}
-			for _, tier := range tariff.Tiers {
-				newTier := model.IncentiveTableDescriptionTierType{}
-
-				newTier.TierDescription = &model.TierDescriptionDataType{
-					TierId:   util.Ptr(model.TierIdType(tier.Id)),
-					TierType: util.Ptr(tier.Type),
-				}
-
-				boundaryDescription := []model.TierBoundaryDescriptionDataType{}
-				for _, boundary := range tier.Boundaries {
-					newBoundary := model.TierBoundaryDescriptionDataType{
-						BoundaryId:   util.Ptr(model.TierBoundaryIdType(boundary.Id)),
-						BoundaryType: util.Ptr(boundary.Type),
-						BoundaryUnit: util.Ptr(boundary.Unit),
-					}
-					boundaryDescription = append(boundaryDescription, newBoundary)
-				}
-				newTier.BoundaryDescription = boundaryDescription
-
-				incentiveDescription := []model.IncentiveDescriptionDataType{}
-				for _, incentive := range tier.Incentives {
-					newIncentive := model.IncentiveDescriptionDataType{
-						IncentiveId:   util.Ptr(model.IncentiveIdType(incentive.Id)),
-						IncentiveType: util.Ptr(incentive.Type),
-					}
-					if incentive.Currency != "" {
-						newIncentive.Currency = util.Ptr(incentive.Currency)
-					}
-					incentiveDescription = append(incentiveDescription, newIncentive)
-				}
-				newTier.IncentiveDescription = incentiveDescription
-
-				if len(newTier.BoundaryDescription) > 0 &&
-					len(newTier.IncentiveDescription) > 0 {
+			for _, tier := range newTariff.Tier {
+				if len(tier.BoundaryDescription) > 0 &&
+					len(tier.IncentiveDescription) > 0 {
 					allDataPresent = true
 				}
-				tierData = append(tierData, newTier)
 			}
 
-			newTariff.Tier = tierData
-
 			newDescData = append(newDescData, newTariff)
 		}
 
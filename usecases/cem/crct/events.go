@@ -0,0 +1,139 @@
+package crct
+
+import (
+	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *CRCT) HandleEvent(payload spineapi.EventPayload) {
+	// only about events from a HVAC room entity or device changes for this remote device
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if internal.IsEntityConnected(payload) {
+		e.hvacRoomConnected(payload.Entity)
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.SetpointDescriptionListDataType:
+		e.hvacRoomSetpointDescriptionDataUpdate(payload.Entity)
+
+	case *model.SetpointConstraintsListDataType:
+		e.hvacRoomSetpointConstraintsDataUpdate(payload)
+
+	case *model.SetpointListDataType:
+		e.hvacRoomSetpointDataUpdate(payload)
+
+	case *model.HvacSystemFunctionDescriptionListDataType,
+		*model.HvacSystemFunctionSetpointRelationListDataType:
+		e.hvacRoomSystemFunctionDataUpdate(payload)
+	}
+}
+
+// process required steps when a HVAC room is connected
+func (e *CRCT) hvacRoomConnected(entity spineapi.EntityRemoteInterface) {
+	if setpoint, err := client.NewSetpoint(e.LocalEntity, entity); err == nil {
+		if !setpoint.HasSubscription() {
+			if _, err := setpoint.Subscribe(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// a binding is required to write setpoints
+		if !setpoint.HasBinding() {
+			if _, err := setpoint.Bind(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// get setpoint parameters
+		if _, err := setpoint.RequestDescriptions(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+
+		if _, err := setpoint.RequestConstraints(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+	}
+
+	e.requestSystemFunctionData(entity)
+}
+
+// request the HVAC system functions and their setpoint relations,
+// which define the cooling setpoints of the HVAC room
+//
+// the HVAC feature is not subscribed, so the data is requested again
+// whenever the setpoint descriptions change
+func (e *CRCT) requestSystemFunctionData(entity spineapi.EntityRemoteInterface) {
+	if hvac, err := client.NewHvac(e.LocalEntity, entity); err == nil {
+		if _, err := hvac.RequestSystemFunctionDescriptions(nil, nil); err != nil {
+			logging.Log().Debug(err)
+		}
+
+		if _, err := hvac.RequestSystemFunctionSetpointRelations(nil, nil); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+}
+
+// the setpoint description data of a HVAC room was updated
+func (e *CRCT) hvacRoomSetpointDescriptionDataUpdate(entity spineapi.EntityRemoteInterface) {
+	if setpoint, err := client.NewSetpoint(e.LocalEntity, entity); err == nil {
+		// setpoint descriptions received, now get the data
+		if _, err := setpoint.RequestData(nil, nil); err != nil {
+			logging.Log().Error("Error getting setpoint list values:", err)
+		}
+	}
+
+	e.requestSystemFunctionData(entity)
+}
+
+// the setpoint constraints data of a HVAC room was updated
+func (e *CRCT) hvacRoomSetpointConstraintsDataUpdate(payload spineapi.EventPayload) {
+	if e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSetpointConstraints)
+	}
+}
+
+// the setpoint data of a HVAC room was updated
+func (e *CRCT) hvacRoomSetpointDataUpdate(payload spineapi.EventPayload) {
+	setpoint, err := client.NewSetpoint(e.LocalEntity, payload.Entity)
+	if err != nil {
+		return
+	}
+
+	filters, err := e.setpointFilters(payload.Entity)
+	if err != nil {
+		return
+	}
+
+	// Scenario 1
+	for _, filter := range filters {
+		if setpoint.CheckEventPayloadDataForFilter(payload.Data, filter) {
+			if e.EventCB != nil {
+				e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSetpoints)
+			}
+			return
+		}
+	}
+}
+
+// the HVAC system function data of a HVAC room was updated,
+// which may change the cooling setpoints
+func (e *CRCT) hvacRoomSystemFunctionDataUpdate(payload spineapi.EventPayload) {
+	if _, err := e.setpointFilters(payload.Entity); err == nil && e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSetpoints)
+	}
+}
@@ -0,0 +1,252 @@
+package crct
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemCRCTSuite) Test_Setpoints() {
+	data, err := s.sut.Setpoints(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.Setpoints(s.hvacRoomEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.hvacRoomEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Setpoints(s.hvacRoomEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	setpointData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(21),
+				ValueMin:             model.NewScaledNumberType(16),
+				ValueMax:             model.NewScaledNumberType(26),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Setpoints(s.hvacRoomEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(0), data[0].Id)
+	assert.Equal(s.T(), 21.0, data[0].Value)
+	assert.Equal(s.T(), 16.0, data[0].MinValue)
+	assert.Equal(s.T(), 26.0, data[0].MaxValue)
+	assert.True(s.T(), data[0].IsActive)
+	assert.True(s.T(), data[0].IsChangeable)
+}
+
+func (s *CemCRCTSuite) Test_SetpointConstraints() {
+	data, err := s.sut.SetpointConstraints(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.SetpointConstraints(s.hvacRoomEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.hvacRoomEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SetpointConstraints(s.hvacRoomEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	constraintsData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(10),
+				SetpointRangeMax: model.NewScaledNumberType(30),
+				SetpointStepSize: model.NewScaledNumberType(0.5),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, constraintsData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SetpointConstraints(s.hvacRoomEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 10.0, data[0].MinValue)
+	assert.Equal(s.T(), 30.0, data[0].MaxValue)
+	assert.Equal(s.T(), 0.5, data[0].StepSize)
+}
+
+func (s *CemCRCTSuite) Test_WriteSetpoint() {
+	_, err := s.sut.WriteSetpoint(s.mockRemoteEntity, 0, 21)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 21)
+	assert.NotNil(s.T(), err)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.hvacRoomEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	setpointData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(21),
+				IsSetpointChangeable: util.Ptr(false),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 22)
+	assert.NotNil(s.T(), err)
+
+	setpointData.SetpointData[0].IsSetpointChangeable = util.Ptr(true)
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	constraintsData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(10),
+				SetpointRangeMax: model.NewScaledNumberType(30),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, constraintsData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 35)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 22)
+	assert.Nil(s.T(), err)
+}
+
+func (s *CemCRCTSuite) Test_CoolingAndHeatingSetpoints() {
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(1)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.hvacRoomEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	setpointData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(21),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(1)),
+				Value:                model.NewScaledNumberType(25),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointListData, setpointData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	constraintsData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(10),
+				SetpointRangeMax: model.NewScaledNumberType(30),
+			},
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(1)),
+				SetpointRangeMin: model.NewScaledNumberType(18),
+				SetpointRangeMax: model.NewScaledNumberType(32),
+			},
+		},
+	}
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, constraintsData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	// only the setpoint related to the cooling system function is used
+	data, err := s.sut.Setpoints(s.hvacRoomEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(0), data[0].Id)
+	assert.Equal(s.T(), 21.0, data[0].Value)
+
+	constraints, err := s.sut.SetpointConstraints(s.hvacRoomEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(constraints))
+	assert.Equal(s.T(), uint(0), constraints[0].Id)
+	assert.Equal(s.T(), 10.0, constraints[0].MinValue)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 1, 24)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 22)
+	assert.Nil(s.T(), err)
+
+	// without the system function descriptions the setpoints can not be assigned
+	s.hvacDescriptions = nil
+
+	data, err = s.sut.Setpoints(s.hvacRoomEntity)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	_, err = s.sut.WriteSetpoint(s.hvacRoomEntity, 0, 22)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+}
@@ -0,0 +1,220 @@
+package crct
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCemCRCTSuite(t *testing.T) {
+	suite.Run(t, new(CemCRCTSuite))
+}
+
+type CemCRCTSuite struct {
+	suite.Suite
+
+	sut *CRCT
+
+	service api.ServiceInterface
+
+	remoteDevice     spineapi.DeviceRemoteInterface
+	mockRemoteEntity *spinemocks.EntityRemoteInterface
+	hvacRoomEntity   spineapi.EntityRemoteInterface
+
+	hvacDescriptions *model.HvacSystemFunctionDescriptionListDataType
+	hvacRelations    *model.HvacSystemFunctionSetpointRelationListDataType
+
+	eventCalled bool
+}
+
+func (s *CemCRCTSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.eventCalled = true
+}
+
+func (s *CemCRCTSuite) BeforeTest(suiteName, testName string) {
+	s.eventCalled = false
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	mockRemoteDevice := spinemocks.NewDeviceRemoteInterface(s.T())
+	s.mockRemoteEntity = spinemocks.NewEntityRemoteInterface(s.T())
+	mockRemoteFeature := spinemocks.NewFeatureRemoteInterface(s.T())
+	mockRemoteDevice.EXPECT().FeatureByEntityTypeAndRole(mock.Anything, mock.Anything, mock.Anything).Return(mockRemoteFeature).Maybe()
+	mockRemoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.mockRemoteEntity.EXPECT().Device().Return(mockRemoteDevice).Maybe()
+	s.mockRemoteEntity.EXPECT().EntityType().Return(mock.Anything).Maybe()
+	entityAddress := &model.EntityAddressType{}
+	s.mockRemoteEntity.EXPECT().Address().Return(entityAddress).Maybe()
+	mockRemoteFeature.EXPECT().DataCopy(mock.Anything).Return(mock.Anything).Maybe()
+	mockRemoteFeature.EXPECT().Address().Return(&model.FeatureAddressType{}).Maybe()
+	mockRemoteFeature.EXPECT().Operations().Return(nil).Maybe()
+
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.sut = NewCRCT(localEntity, s.Event)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+
+	s.remoteDevice, s.hvacRoomEntity = setupDevices(s.service, s.T())
+
+	// the HVAC room has a cooling setpoint with id 0 and a heating setpoint with id 1
+	s.hvacDescriptions = &model.HvacSystemFunctionDescriptionListDataType{
+		HvacSystemFunctionDescriptionData: []model.HvacSystemFunctionDescriptionDataType{
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(1)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeCooling),
+			},
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(2)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeHeating),
+			},
+		},
+	}
+	s.hvacRelations = &model.HvacSystemFunctionSetpointRelationListDataType{
+		HvacSystemFunctionSetpointRelationData: []model.HvacSystemFunctionSetpointRelationDataType{
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(1)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+			},
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(2)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(1)),
+			},
+		},
+	}
+
+	// the SPINE function data of this version can not hold the system function
+	// description list, so the HVAC feature of the HVAC room is mocked
+	hvacFeature := spinemocks.NewFeatureRemoteInterface(s.T())
+	hvacFeature.EXPECT().Type().Return(model.FeatureTypeTypeHvac).Maybe()
+	hvacFeature.EXPECT().Role().Return(model.RoleTypeServer).Maybe()
+	hvacFeature.EXPECT().Address().Return(&model.FeatureAddressType{}).Maybe()
+	hvacFeature.EXPECT().Operations().Return(nil).Maybe()
+	hvacFeature.EXPECT().DataCopy(mock.Anything).RunAndReturn(func(function model.FunctionType) any {
+		if function == model.FunctionTypeHvacSystemFunctionDescriptionListData {
+			return s.hvacDescriptions
+		}
+		return s.hvacRelations
+	}).Maybe()
+	s.hvacRoomEntity.AddFeature(hvacFeature)
+}
+
+const remoteSki string = "testremoteski"
+
+func setupDevices(
+	eebusService api.ServiceInterface, t *testing.T) (
+	spineapi.DeviceRemoteInterface,
+	spineapi.EntityRemoteInterface) {
+	localDevice := eebusService.LocalDevice()
+
+	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
+	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+	sender := spine.NewSender(writeHandler)
+	remoteDevice := spine.NewDeviceRemote(localDevice, remoteSki, sender)
+
+	remoteDeviceName := "remote"
+
+	var remoteFeatures = []struct {
+		featureType   model.FeatureTypeType
+		supportedFcts []model.FunctionType
+	}{
+		{model.FeatureTypeTypeSetpoint,
+			[]model.FunctionType{
+				model.FunctionTypeSetpointDescriptionListData,
+				model.FunctionTypeSetpointConstraintsListData,
+				model.FunctionTypeSetpointListData,
+			},
+		},
+	}
+
+	var featureInformations []model.NodeManagementDetailedDiscoveryFeatureInformationType
+	for index, feature := range remoteFeatures {
+		supportedFcts := []model.FunctionPropertyType{}
+		for _, fct := range feature.supportedFcts {
+			supportedFct := model.FunctionPropertyType{
+				Function: util.Ptr(fct),
+				PossibleOperations: &model.PossibleOperationsType{
+					Read:  &model.PossibleOperationsReadType{},
+					Write: &model.PossibleOperationsWriteType{},
+				},
+			}
+			supportedFcts = append(supportedFcts, supportedFct)
+		}
+
+		featureInformation := model.NodeManagementDetailedDiscoveryFeatureInformationType{
+			Description: &model.NetworkManagementFeatureDescriptionDataType{
+				FeatureAddress: &model.FeatureAddressType{
+					Device:  util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+					Entity:  []model.AddressEntityType{1},
+					Feature: util.Ptr(model.AddressFeatureType(index)),
+				},
+				FeatureType:       util.Ptr(feature.featureType),
+				Role:              util.Ptr(model.RoleTypeServer),
+				SupportedFunction: supportedFcts,
+			},
+		}
+		featureInformations = append(featureInformations, featureInformation)
+	}
+
+	detailedData := &model.NodeManagementDetailedDiscoveryDataType{
+		DeviceInformation: &model.NodeManagementDetailedDiscoveryDeviceInformationType{
+			Description: &model.NetworkManagementDeviceDescriptionDataType{
+				DeviceAddress: &model.DeviceAddressType{
+					Device: util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+				},
+			},
+		},
+		EntityInformation: []model.NodeManagementDetailedDiscoveryEntityInformationType{
+			{
+				Description: &model.NetworkManagementEntityDescriptionDataType{
+					EntityAddress: &model.EntityAddressType{
+						Device: util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+						Entity: []model.AddressEntityType{1},
+					},
+					EntityType: util.Ptr(model.EntityTypeTypeHvacRoom),
+				},
+			},
+		},
+		FeatureInformation: featureInformations,
+	}
+
+	entities, err := remoteDevice.AddEntityAndFeatures(true, detailedData)
+	if err != nil {
+		fmt.Println(err)
+	}
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
+
+	for _, entity := range entities {
+		entity.UpdateDeviceAddress(*remoteDevice.Address())
+	}
+
+	localDevice.AddRemoteDeviceForSki(remoteSki, remoteDevice)
+
+	return remoteDevice, entities[0]
+}
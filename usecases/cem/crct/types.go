@@ -0,0 +1,24 @@
+package crct
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "cem-crct-UseCaseSupportUpdate"
+
+	// room cooling temperature setpoint constraints data updated
+	//
+	// Use `SetpointConstraints` to get the current data
+	//
+	// Use Case CRCT, Scenario 1
+	DataUpdateSetpointConstraints api.EventType = "cem-crct-DataUpdateSetpointConstraints"
+
+	// room cooling temperature setpoint data updated
+	//
+	// Use `Setpoints` to get the current data
+	//
+	// Use Case CRCT, Scenario 1
+	DataUpdateSetpoints api.EventType = "cem-crct-DataUpdateSetpoints"
+)
@@ -0,0 +1,62 @@
+package crct
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type CRCT struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemCRCTInterface = (*CRCT)(nil)
+
+func NewCRCT(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *CRCT {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeHVACRoom,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeHvacRoom,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+			ServerFeatures: []model.FeatureTypeType{
+				model.FeatureTypeTypeHvac,
+				model.FeatureTypeTypeSetpoint,
+			},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeConfigurationOfRoomCoolingTemperature,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &CRCT{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *CRCT) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeHvac, model.RoleTypeClient)
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeSetpoint, model.RoleTypeClient)
+}
@@ -0,0 +1,5 @@
+package crct
+
+func (s *CemCRCTSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
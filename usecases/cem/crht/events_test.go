@@ -0,0 +1,136 @@
+package crht
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemCRHTSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.Entity = s.hvacRoomEntity
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeEntityChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = util.Ptr(model.SetpointDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.SetpointConstraintsListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.SetpointListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.HvacSystemFunctionDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.HvacSystemFunctionSetpointRelationListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.NodeManagementUseCaseDataType{})
+	s.sut.HandleEvent(payload)
+}
+
+func (s *CemCRHTSuite) Test_Failures() {
+	s.sut.hvacRoomConnected(s.mockRemoteEntity)
+
+	s.sut.hvacRoomSetpointDescriptionDataUpdate(s.mockRemoteEntity)
+}
+
+func (s *CemCRHTSuite) Test_hvacRoomSetpointConstraintsDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.hvacRoomEntity,
+	}
+	s.sut.hvacRoomSetpointConstraintsDataUpdate(payload)
+	assert.True(s.T(), s.eventCalled)
+}
+
+func (s *CemCRHTSuite) Test_hvacRoomSetpointDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.hvacRoomEntity,
+	}
+	s.sut.hvacRoomSetpointDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	descData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+		},
+	}
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.hvacRoomEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.hvacRoomSetpointDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	data := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId: util.Ptr(model.SetpointIdType(0)),
+				Value:      model.NewScaledNumberType(21),
+			},
+		},
+	}
+
+	payload.Data = data
+
+	s.sut.hvacRoomSetpointDataUpdate(payload)
+	assert.True(s.T(), s.eventCalled)
+
+	// the cooling setpoint of the HVAC room does not trigger an event
+	s.eventCalled = false
+	payload.Data = &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId: util.Ptr(model.SetpointIdType(1)),
+				Value:      model.NewScaledNumberType(25),
+			},
+		},
+	}
+
+	s.sut.hvacRoomSetpointDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+}
+
+func (s *CemCRHTSuite) Test_hvacRoomSystemFunctionDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.hvacRoomEntity,
+	}
+
+	s.sut.hvacRoomSystemFunctionDataUpdate(payload)
+	assert.True(s.T(), s.eventCalled)
+
+	s.eventCalled = false
+	s.hvacRelations = nil
+
+	s.sut.hvacRoomSystemFunctionDataUpdate(payload)
+	assert.False(s.T(), s.eventCalled)
+}
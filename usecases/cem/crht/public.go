@@ -0,0 +1,120 @@
+package crht
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the room heating temperature setpoints are absolute values
+var setpointFilter = model.SetpointDescriptionDataType{
+	SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+}
+
+// return the filters of the room heating temperature setpoints
+//
+// the setpoints are related to the heating system function of the HVAC room
+func (e *CRHT) setpointFilters(entity spineapi.EntityRemoteInterface) ([]model.SetpointDescriptionDataType, error) {
+	return internal.HvacSetpointFilters(e.LocalEntity, entity, model.HvacSystemFunctionTypeTypeHeating, setpointFilter)
+}
+
+// Scenario 1
+
+// return the current room heating temperature setpoints (°C)
+//
+// parameters:
+//   - entity: the entity of the HVAC room
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - and others
+func (e *CRHT) Setpoints(entity spineapi.EntityRemoteInterface) ([]ucapi.Setpoint, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	filters, err := e.setpointFilters(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ucapi.Setpoint
+
+	for _, filter := range filters {
+		if setpoints, err := internal.Setpoints(e.LocalEntity, entity, filter); err == nil {
+			result = append(result, setpoints...)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// return the constraints of the room heating temperature setpoints (°C)
+//
+// parameters:
+//   - entity: the entity of the HVAC room
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such constraints are (yet) available
+//   - and others
+func (e *CRHT) SetpointConstraints(entity spineapi.EntityRemoteInterface) ([]ucapi.SetpointConstraints, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	filters, err := e.setpointFilters(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ucapi.SetpointConstraints
+
+	for _, filter := range filters {
+		if constraints, err := internal.SetpointConstraints(e.LocalEntity, entity, filter); err == nil {
+			result = append(result, constraints...)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// write a new room heating temperature setpoint (°C)
+//
+// parameters:
+//   - entity: the entity of the HVAC room
+//   - setpointId: the id of the setpoint to be changed
+//   - value: the new value of the setpoint
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - ErrNotSupported if the setpoint can not be changed
+//   - and others, e.g. if the value violates the setpoint constraints
+func (e *CRHT) WriteSetpoint(entity spineapi.EntityRemoteInterface, setpointId uint, value float64) (*model.MsgCounterType, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	filters, err := e.setpointFilters(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filter := range filters {
+		if uint(*filter.SetpointId) == setpointId {
+			return internal.WriteSetpoint(e.LocalEntity, entity, filter, setpointId, value)
+		}
+	}
+
+	return nil, api.ErrDataNotAvailable
+}
@@ -0,0 +1,24 @@
+package crht
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "cem-crht-UseCaseSupportUpdate"
+
+	// room heating temperature setpoint constraints data updated
+	//
+	// Use `SetpointConstraints` to get the current data
+	//
+	// Use Case CRHT, Scenario 1
+	DataUpdateSetpointConstraints api.EventType = "cem-crht-DataUpdateSetpointConstraints"
+
+	// room heating temperature setpoint data updated
+	//
+	// Use `Setpoints` to get the current data
+	//
+	// Use Case CRHT, Scenario 1
+	DataUpdateSetpoints api.EventType = "cem-crht-DataUpdateSetpoints"
+)
@@ -0,0 +1,62 @@
+package crht
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type CRHT struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemCRHTInterface = (*CRHT)(nil)
+
+func NewCRHT(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *CRHT {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeHVACRoom,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeHvacRoom,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+			ServerFeatures: []model.FeatureTypeType{
+				model.FeatureTypeTypeHvac,
+				model.FeatureTypeTypeSetpoint,
+			},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeConfigurationOfRoomHeatingTemperature,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &CRHT{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *CRHT) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeHvac, model.RoleTypeClient)
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeSetpoint, model.RoleTypeClient)
+}
@@ -0,0 +1,5 @@
+package crht
+
+func (s *CemCRHTSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
@@ -0,0 +1,106 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *MCSGR) HandleEvent(payload spineapi.EventPayload) {
+	// only about events from a controllable system entity or device changes for this remote device
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if internal.IsEntityConnected(payload) {
+		e.connected(payload.Entity)
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.HvacOverrunDescriptionListDataType:
+		e.overrunDescriptionDataUpdate(payload)
+
+	case *model.HvacOverrunListDataType:
+		e.overrunDataUpdate(payload)
+	}
+}
+
+// process required steps when a controllable system is connected
+func (e *MCSGR) connected(entity spineapi.EntityRemoteInterface) {
+	if hvac, err := client.NewHvac(e.LocalEntity, entity); err == nil {
+		if !hvac.HasSubscription() {
+			if _, err := hvac.Subscribe(); err != nil {
+				logging.Log().Debug(err)
+			}
+		}
+
+		// a binding is required to write the SG-Ready state
+		if !hvac.HasBinding() {
+			if _, err := hvac.Bind(); err != nil {
+				logging.Log().Debug(err)
+			}
+		}
+
+		// get descriptions
+		if _, err := hvac.RequestOverrunDescriptions(nil, nil); err != nil {
+			logging.Log().Debug(err)
+		}
+	}
+}
+
+// the overrun description data was updated
+func (e *MCSGR) overrunDescriptionDataUpdate(payload spineapi.EventPayload) {
+	if hvac, err := client.NewHvac(e.LocalEntity, payload.Entity); err == nil {
+		// overrun descriptions received, now get the data
+		if _, err := hvac.RequestOverrunData(nil, nil); err != nil {
+			logging.Log().Debug(err)
+		}
+
+		if e.EventCB != nil {
+			e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSupportedStates)
+		}
+	}
+}
+
+// the overrun data was updated
+func (e *MCSGR) overrunDataUpdate(payload spineapi.EventPayload) {
+	hvac, err := client.NewHvac(e.LocalEntity, payload.Entity)
+	if err != nil {
+		return
+	}
+
+	data, ok := payload.Data.(*model.HvacOverrunListDataType)
+	if !ok || e.EventCB == nil {
+		return
+	}
+
+	// only report updates containing SG-Ready overruns
+	descriptions, err := hvac.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return
+	}
+
+	for _, desc := range descriptions {
+		if !internal.IsSGReadyOverrun(desc) {
+			continue
+		}
+
+		for _, item := range data.HvacOverrunData {
+			if item.OverrunId != nil && desc.OverrunId != nil &&
+				*item.OverrunId == *desc.OverrunId {
+				e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSGReadyState)
+				return
+			}
+		}
+	}
+}
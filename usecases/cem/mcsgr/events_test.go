@@ -0,0 +1,95 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemMCSGRSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.Entity = s.heatPumpEntity
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeEntityChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = util.Ptr(model.HvacOverrunDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.HvacOverrunListDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.NodeManagementUseCaseDataType{})
+	s.sut.HandleEvent(payload)
+}
+
+func (s *CemMCSGRSuite) Test_Failures() {
+	s.sut.connected(s.mockRemoteEntity)
+
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.overrunDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+}
+
+func (s *CemMCSGRSuite) Test_overrunDescriptionDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.heatPumpEntity,
+	}
+	s.sut.overrunDescriptionDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateSupportedStates}, s.events)
+}
+
+func (s *CemMCSGRSuite) Test_overrunDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.heatPumpEntity,
+	}
+	s.sut.overrunDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+
+	data := overrunData(0, true)
+	payload.Data = data
+	s.sut.overrunDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.heatPumpEntity, model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	descriptions := &model.HvacOverrunDescriptionListDataType{
+		HvacOverrunDescriptionData: []model.HvacOverrunDescriptionDataType{
+			{
+				OverrunId:   util.Ptr(model.HvacOverrunIdType(5)),
+				OverrunType: util.Ptr(model.HvacOverrunTypeTypeParty),
+			},
+		},
+	}
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, descriptions, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.overrunDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, overrunDescriptions(), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.overrunDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateSGReadyState}, s.events)
+}
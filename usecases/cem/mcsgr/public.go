@@ -0,0 +1,121 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// return the SG-Ready states supported by the Controllable System
+//
+// parameters:
+//   - entity: the entity of the Controllable System
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such data is (yet) available
+//   - and others
+func (e *MCSGR) SupportedSGReadyStates(entity spineapi.EntityRemoteInterface) ([]ucapi.SGReadyStateType, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	hvac, err := client.NewHvac(e.LocalEntity, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions, err := hvac.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	states := internal.SGReadyStates(descriptions)
+	if len(states) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return states, nil
+}
+
+// return the current SG-Ready state of the Controllable System
+//
+// parameters:
+//   - entity: the entity of the Controllable System
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such data is (yet) available
+//   - and others
+func (e *MCSGR) SGReadyState(entity spineapi.EntityRemoteInterface) (ucapi.SGReadyStateType, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return 0, api.ErrNoCompatibleEntity
+	}
+
+	hvac, err := client.NewHvac(e.LocalEntity, entity)
+	if err != nil {
+		return 0, err
+	}
+
+	descriptions, err := hvac.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	data, err := hvac.GetOverrunDataForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	return internal.SGReadyState(descriptions, data)
+}
+
+// Scenario 2
+
+// send a new SG-Ready state to the Controllable System
+//
+// parameters:
+//   - entity: the entity of the Controllable System
+//   - state: the SG-Ready state to be set
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such data is (yet) available
+//   - ErrNotSupported if the state is not supported or can not be changed
+//   - and others
+func (e *MCSGR) WriteSGReadyState(entity spineapi.EntityRemoteInterface, state ucapi.SGReadyStateType) (*model.MsgCounterType, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	hvac, err := client.NewHvac(e.LocalEntity, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions, err := hvac.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	overrunData, err := internal.SGReadyOverrunData(descriptions, state)
+	if err != nil {
+		return nil, err
+	}
+
+	// all SG-Ready overruns are written, so all of them have to be changeable
+	for _, item := range overrunData {
+		current, err := hvac.GetOverrunDataForId(*item.OverrunId)
+		if err != nil {
+			return nil, api.ErrDataNotAvailable
+		}
+
+		if current.IsOverrunStatusChangeable == nil || !*current.IsOverrunStatusChangeable {
+			return nil, api.ErrNotSupported
+		}
+	}
+
+	return hvac.WriteOverrunData(overrunData)
+}
@@ -0,0 +1,102 @@
+package mcsgr
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemMCSGRSuite) Test_SupportedSGReadyStates() {
+	data, err := s.sut.SupportedSGReadyStates(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.SupportedSGReadyStates(s.heatPumpEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.heatPumpEntity, model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, overrunDescriptions(), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SupportedSGReadyStates(s.heatPumpEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.SGReadyStateType{
+		ucapi.SGReadyStateTypeBlocked,
+		ucapi.SGReadyStateTypeNormal,
+		ucapi.SGReadyStateTypeRecommendedIncrease,
+		ucapi.SGReadyStateTypeForcedIncrease,
+	}, data)
+}
+
+func (s *CemMCSGRSuite) Test_SGReadyState() {
+	data, err := s.sut.SGReadyState(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateType(0), data)
+
+	data, err = s.sut.SGReadyState(s.heatPumpEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateType(0), data)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.heatPumpEntity, model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, overrunDescriptions(), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SGReadyState(s.heatPumpEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateType(0), data)
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeHvacOverrunListData, overrunData(2, true), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SGReadyState(s.heatPumpEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeForcedIncrease, data)
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeHvacOverrunListData, overrunData(10, true), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SGReadyState(s.heatPumpEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeNormal, data)
+}
+
+func (s *CemMCSGRSuite) Test_WriteSGReadyState() {
+	counter, err := s.sut.WriteSGReadyState(s.mockRemoteEntity, ucapi.SGReadyStateTypeBlocked)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateTypeBlocked)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.heatPumpEntity, model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeHvacOverrunDescriptionListData, overrunDescriptions(), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateTypeBlocked)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeHvacOverrunListData, overrunData(10, false), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateTypeBlocked)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeHvacOverrunListData, overrunData(10, true), nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateType(5))
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), counter)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateTypeBlocked)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+
+	counter, err = s.sut.WriteSGReadyState(s.heatPumpEntity, ucapi.SGReadyStateTypeNormal)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), counter)
+}
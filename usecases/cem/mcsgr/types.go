@@ -0,0 +1,24 @@
+package mcsgr
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "cem-mcsgr-UseCaseSupportUpdate"
+
+	// The SG-Ready states supported by the Controllable System changed
+	//
+	// Use `SupportedSGReadyStates` to get the current data
+	//
+	// Use Case MCSGR, Scenario 1
+	DataUpdateSupportedStates api.EventType = "cem-mcsgr-DataUpdateSupportedStates"
+
+	// SG-Ready state data updated
+	//
+	// Use `SGReadyState` to get the current data
+	//
+	// Use Case MCSGR, Scenario 1
+	DataUpdateSGReadyState api.EventType = "cem-mcsgr-DataUpdateSGReadyState"
+)
@@ -0,0 +1,66 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type MCSGR struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemMCSGRInterface = (*MCSGR)(nil)
+
+func NewMCSGR(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *MCSGR {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeControllableSystem,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCompressor,
+		model.EntityTypeTypeControllableSystem,
+		model.EntityTypeTypeHeatPumpAppliance,
+		model.EntityTypeTypeHvacController,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:       model.UseCaseScenarioSupportType(1),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeHvac},
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(2),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeHvac},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeMonitoringAndControlOfSmartGridReadyConditions,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &MCSGR{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *MCSGR) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeHvac, model.RoleTypeClient)
+}
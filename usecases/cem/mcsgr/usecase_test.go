@@ -0,0 +1,5 @@
+package mcsgr
+
+func (s *CemMCSGRSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
@@ -0,0 +1,81 @@
+package mdt
+
+import (
+	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// handle SPINE events
+func (e *MDT) HandleEvent(payload spineapi.EventPayload) {
+	// only about events from a DHW circuit entity or device changes for this remote device
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if internal.IsEntityConnected(payload) {
+		e.dhwCircuitConnected(payload.Entity)
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.MeasurementDescriptionListDataType:
+		e.dhwCircuitMeasurementDescriptionDataUpdate(payload.Entity)
+
+	case *model.MeasurementListDataType:
+		e.dhwCircuitMeasurementDataUpdate(payload)
+	}
+}
+
+// process required steps when a DHW circuit is connected
+func (e *MDT) dhwCircuitConnected(entity spineapi.EntityRemoteInterface) {
+	if measurement, err := client.NewMeasurement(e.LocalEntity, entity); err == nil {
+		if !measurement.HasSubscription() {
+			if _, err := measurement.Subscribe(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// get measurement parameters
+		if _, err := measurement.RequestDescriptions(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+
+		if _, err := measurement.RequestConstraints(nil, nil); err != nil {
+			logging.Log().Error(err)
+		}
+	}
+}
+
+// the measurement description data of a DHW circuit was updated
+func (e *MDT) dhwCircuitMeasurementDescriptionDataUpdate(entity spineapi.EntityRemoteInterface) {
+	if measurement, err := client.NewMeasurement(e.LocalEntity, entity); err == nil {
+		// measurement descriptions received, now get the data
+		if _, err := measurement.RequestData(nil, nil); err != nil {
+			logging.Log().Error("Error getting measurement list values:", err)
+		}
+	}
+}
+
+// the measurement data of a DHW circuit was updated
+func (e *MDT) dhwCircuitMeasurementDataUpdate(payload spineapi.EventPayload) {
+	if measurement, err := client.NewMeasurement(e.LocalEntity, payload.Entity); err == nil {
+		// Scenario 1
+		filter := model.MeasurementDescriptionDataType{
+			MeasurementType: util.Ptr(model.MeasurementTypeTypeTemperature),
+			ScopeType:       util.Ptr(model.ScopeTypeTypeDhwTemperature),
+		}
+		if measurement.CheckEventPayloadDataForFilter(payload.Data, filter) && e.EventCB != nil {
+			e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateTemperature)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package mdt
+
+import (
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemMDTSuite) Test_Temperature() {
+	data, err := s.sut.Temperature(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data)
+
+	data, err = s.sut.Temperature(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data)
+
+	descData := &model.MeasurementDescriptionListDataType{
+		MeasurementDescriptionData: []model.MeasurementDescriptionDataType{
+			{
+				MeasurementId:   util.Ptr(model.MeasurementIdType(0)),
+				MeasurementType: util.Ptr(model.MeasurementTypeTypeTemperature),
+				ScopeType:       util.Ptr(model.ScopeTypeTypeDhwTemperature),
+				Unit:            util.Ptr(model.UnitOfMeasurementTypedegC),
+			},
+		},
+	}
+
+	measurementFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.dhwCircuitEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	_, fErr := measurementFeature.UpdateData(true, model.FunctionTypeMeasurementDescriptionListData, descData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Temperature(s.dhwCircuitEntity)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), 0.0, data)
+
+	measData := &model.MeasurementListDataType{
+		MeasurementData: []model.MeasurementDataType{
+			{
+				MeasurementId: util.Ptr(model.MeasurementIdType(0)),
+				Value:         model.NewScaledNumberType(48.5),
+			},
+		},
+	}
+
+	_, fErr = measurementFeature.UpdateData(true, model.FunctionTypeMeasurementListData, measData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.Temperature(s.dhwCircuitEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 48.5, data)
+}
@@ -0,0 +1,58 @@
+package mdt
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type MDT struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemMDTInterface = (*MDT)(nil)
+
+func NewMDT(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *MDT {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeDHWCircuit,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeDHWCircuit,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:       model.UseCaseScenarioSupportType(1),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeMeasurement},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeMonitoringOfDhwTemperature,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &MDT{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *MDT) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeClient)
+}
@@ -0,0 +1,5 @@
+package mdt
+
+func (s *CemMDTSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
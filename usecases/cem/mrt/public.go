@@ -0,0 +1,41 @@
+package mrt
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// Scenario 1
+
+// return the current room temperature (°C)
+//
+// parameters:
+//   - entity: the entity of the HVAC room
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such measurement is (yet) available
+//   - and others
+func (e *MRT) Temperature(entity spineapi.EntityRemoteInterface) (float64, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return 0, api.ErrNoCompatibleEntity
+	}
+
+	measurement, err := client.NewMeasurement(e.LocalEntity, entity)
+	if err != nil {
+		return 0, api.ErrFunctionNotSupported
+	}
+
+	filter := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypeTemperature),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeRoomAirTemperature),
+	}
+	result, err := measurement.GetDataForFilter(filter)
+	if err != nil || len(result) == 0 || result[0].Value == nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	return result[0].Value.GetValue(), nil
+}
@@ -0,0 +1,58 @@
+package mrt
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type MRT struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.CemMRTInterface = (*MRT)(nil)
+
+func NewMRT(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *MRT {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeHVACRoom,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeHvacRoom,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:       model.UseCaseScenarioSupportType(1),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeMeasurement},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeMonitoringOfRoomTemperature,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &MRT{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *MRT) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeClient)
+}
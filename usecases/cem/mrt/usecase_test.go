@@ -0,0 +1,5 @@
+package mrt
+
+func (s *CemMRTSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
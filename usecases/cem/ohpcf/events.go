@@ -0,0 +1,141 @@
+package ohpcf
+
+import (
+	"reflect"
+
+	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *OHPCF) HandleEvent(payload spineapi.EventPayload) {
+	// only about events from a compressor entity or device changes for this remote device
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if internal.IsEntityConnected(payload) {
+		e.compressorConnected(payload.Entity)
+		return
+	}
+
+	if internal.IsEntityDisconnected(payload) {
+		e.compressorDisconnected(payload.Entity)
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.SmartEnergyManagementPsDataType:
+		e.compressorDataUpdate(payload)
+	}
+}
+
+// process required steps when a compressor is connected
+func (e *OHPCF) compressorConnected(entity spineapi.EntityRemoteInterface) {
+	if smartEnergyManagementPs, err := client.NewSmartEnergyManagementPs(e.LocalEntity, entity); err == nil {
+		if !smartEnergyManagementPs.HasSubscription() {
+			if _, err := smartEnergyManagementPs.Subscribe(); err != nil {
+				logging.Log().Error(err)
+			}
+		}
+
+		// a binding is required to schedule power sequences
+		if !smartEnergyManagementPs.HasBinding() {
+			if _, err := smartEnergyManagementPs.Bind(); err != nil {
+				logging.Log().Debug(err)
+			}
+		}
+
+		// get the power sequences
+		if _, err := smartEnergyManagementPs.RequestData(); err != nil {
+			logging.Log().Error(err)
+		}
+	}
+}
+
+// process required steps when a compressor is removed
+func (e *OHPCF) compressorDisconnected(entity spineapi.EntityRemoteInterface) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	delete(e.knownData, entity.Address().String())
+}
+
+// the power sequence data of a compressor was updated
+func (e *OHPCF) compressorDataUpdate(payload spineapi.EventPayload) {
+	smartEnergyManagementPs, err := client.NewSmartEnergyManagementPs(e.LocalEntity, payload.Entity)
+	if err != nil {
+		return
+	}
+
+	data, err := smartEnergyManagementPs.GetData()
+	if err != nil {
+		return
+	}
+
+	e.mux.Lock()
+	key := payload.Entity.Address().String()
+	oldData := e.knownData[key]
+	e.knownData[key] = data
+	e.mux.Unlock()
+
+	if e.EventCB == nil {
+		return
+	}
+
+	// Scenario 1
+	if oldData == nil || !reflect.DeepEqual(flexibilityData(oldData), flexibilityData(data)) {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateFlexibility)
+	}
+
+	// Scenario 3
+	if oldData == nil || !reflect.DeepEqual(stateData(oldData), stateData(data)) {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateState)
+	}
+}
+
+// return a copy of the data without the state and schedule of the power sequences
+func flexibilityData(data *model.SmartEnergyManagementPsDataType) *model.SmartEnergyManagementPsDataType {
+	result := &model.SmartEnergyManagementPsDataType{
+		NodeScheduleInformation: data.NodeScheduleInformation,
+	}
+
+	for _, alternative := range data.Alternatives {
+		item := model.SmartEnergyManagementPsAlternativesType{
+			Relation: alternative.Relation,
+		}
+		for _, sequence := range alternative.PowerSequence {
+			sequence.State = nil
+			sequence.Schedule = nil
+			item.PowerSequence = append(item.PowerSequence, sequence)
+		}
+		result.Alternatives = append(result.Alternatives, item)
+	}
+
+	return result
+}
+
+// return the state and schedule of all power sequences
+func stateData(data *model.SmartEnergyManagementPsDataType) []model.SmartEnergyManagementPsPowerSequenceType {
+	var result []model.SmartEnergyManagementPsPowerSequenceType
+
+	for _, alternative := range data.Alternatives {
+		for _, sequence := range alternative.PowerSequence {
+			result = append(result, model.SmartEnergyManagementPsPowerSequenceType{
+				State:    sequence.State,
+				Schedule: sequence.Schedule,
+			})
+		}
+	}
+
+	return result
+}
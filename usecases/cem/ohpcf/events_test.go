@@ -0,0 +1,96 @@
+package ohpcf
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemOHPCFSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.Entity = s.compressorEntity
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeEntityChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.ChangeType = spineapi.ElementChangeRemove
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeAdd
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.Data = util.Ptr(model.SmartEnergyManagementPsDataType{})
+	s.sut.HandleEvent(payload)
+
+	payload.Data = util.Ptr(model.NodeManagementUseCaseDataType{})
+	s.sut.HandleEvent(payload)
+}
+
+func (s *CemOHPCFSuite) Test_Failures() {
+	s.sut.compressorConnected(s.mockRemoteEntity)
+
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Entity: s.mockRemoteEntity,
+	}
+	s.sut.compressorDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+}
+
+func (s *CemOHPCFSuite) Test_compressorDataUpdate() {
+	payload := spineapi.EventPayload{
+		Ski:    remoteSki,
+		Device: s.remoteDevice,
+		Entity: s.compressorEntity,
+	}
+	s.sut.compressorDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	data := powerSequenceData(model.PowerSequenceStateTypeInactive, true)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, data, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.compressorDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateFlexibility, DataUpdateState}, s.events)
+
+	// the same data again should not trigger any event
+	s.events = nil
+	s.sut.compressorDataUpdate(payload)
+	assert.Nil(s.T(), s.events)
+
+	// a changed state only triggers the state event
+	data = powerSequenceData(model.PowerSequenceStateTypeRunning, true)
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, data, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.compressorDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateState}, s.events)
+
+	// changed slots only trigger the flexibility event
+	s.events = nil
+	data = powerSequenceData(model.PowerSequenceStateTypeRunning, true)
+	data.Alternatives[0].PowerSequence[0].PowerTimeSlot = data.Alternatives[0].PowerSequence[0].PowerTimeSlot[:1]
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, data, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	s.sut.compressorDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateFlexibility}, s.events)
+
+	// after a disconnect all events are triggered again
+	s.events = nil
+	s.sut.compressorDisconnected(s.compressorEntity)
+	s.sut.compressorDataUpdate(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateFlexibility, DataUpdateState}, s.events)
+}
@@ -0,0 +1,196 @@
+package ohpcf
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// Scenario 1
+
+// return the power sequences the compressor currently offers
+//
+// possible errors:
+//   - ErrDataNotAvailable if no power sequences are (yet) available
+//   - and others
+func (e *OHPCF) PowerSequences(entity spineapi.EntityRemoteInterface) ([]ucapi.PowerSequence, error) {
+	if !e.IsCompatibleEntityType(entity) {
+		return nil, api.ErrNoCompatibleEntity
+	}
+
+	smartEnergyManagementPs, err := client.NewSmartEnergyManagementPs(e.LocalEntity, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := smartEnergyManagementPs.GetData()
+	if err != nil {
+		return nil, err
+	}
+
+	result := internal.PowerSequences(data)
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// Scenario 2
+
+// schedule a power sequence to be started at the given time
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence is not available
+//   - ErrNotSupported if the power sequence can not be controlled remotely
+//   - and others
+func (e *OHPCF) ScheduleSequence(
+	entity spineapi.EntityRemoteInterface,
+	sequenceId uint,
+	startTime time.Time,
+) (*model.MsgCounterType, error) {
+	sequence, err := e.remoteControllableSequence(entity, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sequence.EarliestStartTime.IsZero() && startTime.Before(sequence.EarliestStartTime) {
+		return nil, errors.New("start time is before the earliest start time of the power sequence")
+	}
+
+	if !sequence.LatestEndTime.IsZero() {
+		var duration time.Duration
+		for _, slot := range sequence.Slots {
+			duration += slot.Duration
+		}
+
+		if startTime.Add(duration).After(sequence.LatestEndTime) {
+			return nil, errors.New("power sequence would end after its latest end time")
+		}
+	}
+
+	return e.writeSchedule(entity, sequence, model.NewAbsoluteOrRelativeTimeTypeFromTime(startTime))
+}
+
+// start a power sequence immediately
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence is not available
+//   - ErrNotSupported if the power sequence can not be controlled remotely
+//   - and others
+func (e *OHPCF) StartSequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*model.MsgCounterType, error) {
+	sequence, err := e.remoteControllableSequence(entity, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.writeSchedule(entity, sequence, model.NewAbsoluteOrRelativeTimeTypeFromDuration(0))
+}
+
+// cancel a previously scheduled power sequence
+//
+// the schedule is written without a start time, which removes it
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence is not available
+//   - ErrNotSupported if the power sequence can not be controlled remotely
+//   - and others
+func (e *OHPCF) CancelSequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*model.MsgCounterType, error) {
+	sequence, err := e.remoteControllableSequence(entity, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.writeSchedule(entity, sequence, nil)
+}
+
+// return the power sequence with the given id, if it can be controlled remotely
+func (e *OHPCF) remoteControllableSequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*ucapi.PowerSequence, error) {
+	sequence, err := e.sequence(entity, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sequence.RemoteControllable {
+		return nil, api.ErrNotSupported
+	}
+
+	return sequence, nil
+}
+
+// write the schedule of a power sequence
+func (e *OHPCF) writeSchedule(
+	entity spineapi.EntityRemoteInterface,
+	sequence *ucapi.PowerSequence,
+	startTime *model.AbsoluteOrRelativeTimeType,
+) (*model.MsgCounterType, error) {
+	smartEnergyManagementPs, err := client.NewSmartEnergyManagementPs(e.LocalEntity, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	sequenceId := model.PowerSequenceIdType(sequence.Id)
+	data := &model.SmartEnergyManagementPsDataType{
+		Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+			{
+				Relation: &model.SmartEnergyManagementPsAlternativesRelationType{
+					AlternativesId: util.Ptr(model.AlternativesIdType(sequence.AlternativesId)),
+					SequenceId:     []model.PowerSequenceIdType{sequenceId},
+				},
+				PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{
+					{
+						Schedule: &model.PowerSequenceScheduleDataType{
+							SequenceId: util.Ptr(sequenceId),
+							StartTime:  startTime,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return smartEnergyManagementPs.WriteData(data)
+}
+
+// Scenario 3
+
+// return the current state of a power sequence
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence or its state is not available
+//   - and others
+func (e *OHPCF) SequenceState(entity spineapi.EntityRemoteInterface, sequenceId uint) (model.PowerSequenceStateType, error) {
+	sequence, err := e.sequence(entity, sequenceId)
+	if err != nil {
+		return "", err
+	}
+
+	if sequence.State == "" {
+		return "", api.ErrDataNotAvailable
+	}
+
+	return sequence.State, nil
+}
+
+// return the power sequence with the given id
+func (e *OHPCF) sequence(entity spineapi.EntityRemoteInterface, sequenceId uint) (*ucapi.PowerSequence, error) {
+	sequences, err := e.PowerSequences(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sequence := range sequences {
+		if sequence.Id == sequenceId {
+			return &sequence, nil
+		}
+	}
+
+	return nil, api.ErrDataNotAvailable
+}
@@ -0,0 +1,150 @@
+package ohpcf
+
+import (
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CemOHPCFSuite) Test_PowerSequences() {
+	data, err := s.sut.PowerSequences(s.mockRemoteEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	data, err = s.sut.PowerSequences(s.compressorEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, &model.SmartEnergyManagementPsDataType{}, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerSequences(s.compressorEntity)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	psData := powerSequenceData(model.PowerSequenceStateTypeScheduled, true)
+	psData.Alternatives[0].PowerSequence[0].Schedule = &model.PowerSequenceScheduleDataType{
+		StartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour * 2),
+	}
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.PowerSequences(s.compressorEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(2), data[0].Id)
+	assert.Equal(s.T(), uint(1), data[0].AlternativesId)
+	assert.Equal(s.T(), model.PowerSequenceStateTypeScheduled, data[0].State)
+	assert.True(s.T(), data[0].RemoteControllable)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour), data[0].EarliestStartTime, time.Minute)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour*6), data[0].LatestEndTime, time.Minute)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour*2), data[0].StartTime, time.Minute)
+	assert.Equal(s.T(), 2, len(data[0].Slots))
+	assert.Equal(s.T(), uint(0), data[0].Slots[0].Number)
+	assert.Equal(s.T(), time.Hour, data[0].Slots[0].Duration)
+	assert.Equal(s.T(), 2000.0, data[0].Slots[0].Power)
+	assert.Equal(s.T(), 0.0, data[0].Slots[0].PowerMin)
+	assert.Equal(s.T(), 2500.0, data[0].Slots[0].PowerMax)
+	assert.Equal(s.T(), uint(1), data[0].Slots[1].Number)
+	assert.Equal(s.T(), time.Minute*30, data[0].Slots[1].Duration)
+	assert.Equal(s.T(), 1000.0, data[0].Slots[1].Power)
+}
+
+func (s *CemOHPCFSuite) Test_ScheduleSequence() {
+	startTime := time.Now().Add(time.Hour * 2)
+
+	_, err := s.sut.ScheduleSequence(s.mockRemoteEntity, 2, startTime)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 2, startTime)
+	assert.NotNil(s.T(), err)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	psData := powerSequenceData(model.PowerSequenceStateTypeInactive, false)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 1, startTime)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 2, startTime)
+	assert.Equal(s.T(), api.ErrNotSupported, err)
+
+	psData = powerSequenceData(model.PowerSequenceStateTypeInactive, true)
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	// before the earliest start time
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 2, time.Now())
+	assert.NotNil(s.T(), err)
+
+	// ends after the latest end time
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 2, time.Now().Add(time.Hour*5))
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.ScheduleSequence(s.compressorEntity, 2, startTime)
+	assert.Nil(s.T(), err)
+}
+
+func (s *CemOHPCFSuite) Test_StartSequence() {
+	_, err := s.sut.StartSequence(s.mockRemoteEntity, 2)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.StartSequence(s.compressorEntity, 2)
+	assert.NotNil(s.T(), err)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	psData := powerSequenceData(model.PowerSequenceStateTypeInactive, true)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.StartSequence(s.compressorEntity, 2)
+	assert.Nil(s.T(), err)
+}
+
+func (s *CemOHPCFSuite) Test_CancelSequence() {
+	_, err := s.sut.CancelSequence(s.mockRemoteEntity, 2)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.CancelSequence(s.compressorEntity, 2)
+	assert.NotNil(s.T(), err)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	psData := powerSequenceData(model.PowerSequenceStateTypeScheduled, true)
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	_, err = s.sut.CancelSequence(s.compressorEntity, 2)
+	assert.Nil(s.T(), err)
+}
+
+func (s *CemOHPCFSuite) Test_SequenceState() {
+	data, err := s.sut.SequenceState(s.mockRemoteEntity, 2)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), model.PowerSequenceStateType(""), data)
+
+	data, err = s.sut.SequenceState(s.compressorEntity, 2)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), model.PowerSequenceStateType(""), data)
+
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.compressorEntity, model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	psData := powerSequenceData(model.PowerSequenceStateTypeRunning, true)
+	psData.Alternatives[0].PowerSequence[0].State.State = nil
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SequenceState(s.compressorEntity, 2)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Equal(s.T(), model.PowerSequenceStateType(""), data)
+
+	psData = powerSequenceData(model.PowerSequenceStateTypeRunning, true)
+	_, fErr = rFeature.UpdateData(true, model.FunctionTypeSmartEnergyManagementPsData, psData, nil, nil)
+	assert.Nil(s.T(), fErr)
+
+	data, err = s.sut.SequenceState(s.compressorEntity, 2)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.PowerSequenceStateTypeRunning, data)
+}
@@ -0,0 +1,249 @@
+package ohpcf
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	shipmocks "github.com/enbility/ship-go/mocks"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCemOHPCFSuite(t *testing.T) {
+	suite.Run(t, new(CemOHPCFSuite))
+}
+
+type CemOHPCFSuite struct {
+	suite.Suite
+
+	sut *OHPCF
+
+	service api.ServiceInterface
+
+	remoteDevice     spineapi.DeviceRemoteInterface
+	mockRemoteEntity *spinemocks.EntityRemoteInterface
+	compressorEntity spineapi.EntityRemoteInterface
+
+	events []api.EventType
+}
+
+func (s *CemOHPCFSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.events = append(s.events, event)
+}
+
+func (s *CemOHPCFSuite) BeforeTest(suiteName, testName string) {
+	s.events = nil
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEnergyManagementSystem},
+		model.DeviceTypeTypeEnergyManagementSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCEM},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	mockRemoteDevice := spinemocks.NewDeviceRemoteInterface(s.T())
+	s.mockRemoteEntity = spinemocks.NewEntityRemoteInterface(s.T())
+	mockRemoteFeature := spinemocks.NewFeatureRemoteInterface(s.T())
+	mockRemoteDevice.EXPECT().FeatureByEntityTypeAndRole(mock.Anything, mock.Anything, mock.Anything).Return(mockRemoteFeature).Maybe()
+	mockRemoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.mockRemoteEntity.EXPECT().Device().Return(mockRemoteDevice).Maybe()
+	s.mockRemoteEntity.EXPECT().EntityType().Return(mock.Anything).Maybe()
+	entityAddress := &model.EntityAddressType{}
+	s.mockRemoteEntity.EXPECT().Address().Return(entityAddress).Maybe()
+	mockRemoteFeature.EXPECT().DataCopy(mock.Anything).Return(mock.Anything).Maybe()
+	mockRemoteFeature.EXPECT().Address().Return(&model.FeatureAddressType{}).Maybe()
+	mockRemoteFeature.EXPECT().Operations().Return(nil).Maybe()
+
+	localEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeCEM)
+	s.sut = NewOHPCF(localEntity, s.Event)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+
+	s.remoteDevice, s.compressorEntity = setupDevices(s.service, s.T())
+}
+
+const remoteSki string = "testremoteski"
+
+func setupDevices(
+	eebusService api.ServiceInterface, t *testing.T) (
+	spineapi.DeviceRemoteInterface,
+	spineapi.EntityRemoteInterface) {
+	localDevice := eebusService.LocalDevice()
+
+	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
+	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
+	sender := spine.NewSender(writeHandler)
+	remoteDevice := spine.NewDeviceRemote(localDevice, remoteSki, sender)
+
+	remoteDeviceName := "remote"
+
+	var remoteFeatures = []struct {
+		featureType   model.FeatureTypeType
+		supportedFcts []model.FunctionType
+	}{
+		{model.FeatureTypeTypeSmartEnergyManagementPs,
+			[]model.FunctionType{
+				model.FunctionTypeSmartEnergyManagementPsData,
+			},
+		},
+	}
+
+	var featureInformations []model.NodeManagementDetailedDiscoveryFeatureInformationType
+	for index, feature := range remoteFeatures {
+		supportedFcts := []model.FunctionPropertyType{}
+		for _, fct := range feature.supportedFcts {
+			supportedFct := model.FunctionPropertyType{
+				Function: util.Ptr(fct),
+				PossibleOperations: &model.PossibleOperationsType{
+					Read: &model.PossibleOperationsReadType{},
+				},
+			}
+			supportedFcts = append(supportedFcts, supportedFct)
+		}
+
+		featureInformation := model.NodeManagementDetailedDiscoveryFeatureInformationType{
+			Description: &model.NetworkManagementFeatureDescriptionDataType{
+				FeatureAddress: &model.FeatureAddressType{
+					Device:  util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+					Entity:  []model.AddressEntityType{1},
+					Feature: util.Ptr(model.AddressFeatureType(index)),
+				},
+				FeatureType:       util.Ptr(feature.featureType),
+				Role:              util.Ptr(model.RoleTypeServer),
+				SupportedFunction: supportedFcts,
+			},
+		}
+		featureInformations = append(featureInformations, featureInformation)
+	}
+
+	detailedData := &model.NodeManagementDetailedDiscoveryDataType{
+		DeviceInformation: &model.NodeManagementDetailedDiscoveryDeviceInformationType{
+			Description: &model.NetworkManagementDeviceDescriptionDataType{
+				DeviceAddress: &model.DeviceAddressType{
+					Device: util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+				},
+			},
+		},
+		EntityInformation: []model.NodeManagementDetailedDiscoveryEntityInformationType{
+			{
+				Description: &model.NetworkManagementEntityDescriptionDataType{
+					EntityAddress: &model.EntityAddressType{
+						Device: util.Ptr(model.AddressDeviceType(remoteDeviceName)),
+						Entity: []model.AddressEntityType{1},
+					},
+					EntityType: util.Ptr(model.EntityTypeTypeCompressor),
+				},
+			},
+		},
+		FeatureInformation: featureInformations,
+	}
+
+	entities, err := remoteDevice.AddEntityAndFeatures(true, detailedData)
+	if err != nil {
+		fmt.Println(err)
+	}
+	remoteDevice.UpdateDevice(detailedData.DeviceInformation.Description)
+
+	for _, entity := range entities {
+		entity.UpdateDeviceAddress(*remoteDevice.Address())
+	}
+
+	localDevice.AddRemoteDeviceForSki(remoteSki, remoteDevice)
+
+	return remoteDevice, entities[0]
+}
+
+// return power sequence data with a single alternative containing one sequence with two slots
+func powerSequenceData(state model.PowerSequenceStateType, remoteControllable bool) *model.SmartEnergyManagementPsDataType {
+	return &model.SmartEnergyManagementPsDataType{
+		NodeScheduleInformation: &model.PowerSequenceNodeScheduleInformationDataType{
+			NodeRemoteControllable: util.Ptr(true),
+			AlternativesCount:      util.Ptr(uint(1)),
+		},
+		Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+			{
+				Relation: &model.SmartEnergyManagementPsAlternativesRelationType{
+					AlternativesId: util.Ptr(model.AlternativesIdType(1)),
+					SequenceId:     []model.PowerSequenceIdType{2},
+				},
+				PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{
+					{
+						Description: &model.PowerSequenceDescriptionDataType{
+							SequenceId: util.Ptr(model.PowerSequenceIdType(2)),
+							PowerUnit:  util.Ptr(model.UnitOfMeasurementTypeW),
+						},
+						State: &model.PowerSequenceStateDataType{
+							SequenceId:                 util.Ptr(model.PowerSequenceIdType(2)),
+							State:                      util.Ptr(state),
+							SequenceRemoteControllable: util.Ptr(remoteControllable),
+						},
+						ScheduleConstraints: &model.PowerSequenceScheduleConstraintsDataType{
+							SequenceId:        util.Ptr(model.PowerSequenceIdType(2)),
+							EarliestStartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour),
+							LatestEndTime:     model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour * 6),
+						},
+						PowerTimeSlot: []model.SmartEnergyManagementPsPowerTimeSlotType{
+							{
+								Schedule: &model.PowerTimeSlotScheduleDataType{
+									SequenceId:      util.Ptr(model.PowerSequenceIdType(2)),
+									SlotNumber:      util.Ptr(model.PowerTimeSlotNumberType(0)),
+									DefaultDuration: model.NewDurationType(time.Hour),
+								},
+								ValueList: &model.SmartEnergyManagementPsPowerTimeSlotValueListType{
+									Value: []model.PowerTimeSlotValueDataType{
+										{
+											SequenceId: util.Ptr(model.PowerSequenceIdType(2)),
+											SlotNumber: util.Ptr(model.PowerTimeSlotNumberType(0)),
+											ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePower),
+											Value:      model.NewScaledNumberType(2000),
+										},
+										{
+											SequenceId: util.Ptr(model.PowerSequenceIdType(2)),
+											SlotNumber: util.Ptr(model.PowerTimeSlotNumberType(0)),
+											ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePowerMax),
+											Value:      model.NewScaledNumberType(2500),
+										},
+									},
+								},
+							},
+							{
+								Schedule: &model.PowerTimeSlotScheduleDataType{
+									SequenceId:      util.Ptr(model.PowerSequenceIdType(2)),
+									SlotNumber:      util.Ptr(model.PowerTimeSlotNumberType(1)),
+									DefaultDuration: model.NewDurationType(time.Minute * 30),
+								},
+								ValueList: &model.SmartEnergyManagementPsPowerTimeSlotValueListType{
+									Value: []model.PowerTimeSlotValueDataType{
+										{
+											SequenceId: util.Ptr(model.PowerSequenceIdType(2)),
+											SlotNumber: util.Ptr(model.PowerTimeSlotNumberType(1)),
+											ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePower),
+											Value:      model.NewScaledNumberType(1000),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
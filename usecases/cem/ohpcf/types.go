@@ -0,0 +1,25 @@
+package ohpcf
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "cem-ohpcf-UseCaseSupportUpdate"
+
+	// The power sequences offered by the compressor changed,
+	// e.g. new alternatives, slots or schedule constraints
+	//
+	// Use `PowerSequences` to get the current data
+	//
+	// Use Case OHPCF, Scenario 1
+	DataUpdateFlexibility api.EventType = "cem-ohpcf-DataUpdateFlexibility"
+
+	// The state or schedule of a power sequence changed
+	//
+	// Use `PowerSequences` or `SequenceState` to get the current data
+	//
+	// Use Case OHPCF, Scenario 3
+	DataUpdateState api.EventType = "cem-ohpcf-DataUpdateState"
+)
@@ -0,0 +1,77 @@
+package ohpcf
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+type OHPCF struct {
+	*usecase.UseCaseBase
+
+	// the last known power sequence data per remote entity address,
+	// used to only trigger events if the data actually changed
+	knownData map[string]*model.SmartEnergyManagementPsDataType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.CemOHPCFInterface = (*OHPCF)(nil)
+
+func NewOHPCF(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *OHPCF {
+	validActorTypes := []model.UseCaseActorType{
+		model.UseCaseActorTypeCompressor,
+	}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCompressor,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:       model.UseCaseScenarioSupportType(1),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeSmartEnergyManagementPs},
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(2),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeSmartEnergyManagementPs},
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(3),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeSmartEnergyManagementPs},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCEM,
+		model.UseCaseNameTypeOptimizationOfSelfConsumptionByHeatPumpCompressorFlexibility,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &OHPCF{
+		UseCaseBase: usecase,
+		knownData:   make(map[string]*model.SmartEnergyManagementPsDataType),
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *OHPCF) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeClient)
+}
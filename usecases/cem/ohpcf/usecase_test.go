@@ -0,0 +1,5 @@
+package ohpcf
+
+func (s *CemOHPCFSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
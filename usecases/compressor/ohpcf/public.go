@@ -0,0 +1,140 @@
+package ohpcf
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// Scenario 1
+
+// return the power sequences currently offered to the CEM
+func (e *OHPCF) PowerSequences() []ucapi.PowerSequence {
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return nil
+	}
+
+	return e.powerSequences(sem)
+}
+
+// offer a new alternative of power sequences and return its id
+//
+// the ids of the power sequences are set automatically,
+// use `PowerSequences` to get them
+//
+// possible errors:
+//   - ErrMissingData if no power sequences are provided
+//   - and others
+func (e *OHPCF) AddAlternative(sequences []ucapi.PowerSequence) (uint, error) {
+	if len(sequences) == 0 {
+		return 0, api.ErrMissingData
+	}
+
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return 0, err
+	}
+
+	var powerSequences []model.SmartEnergyManagementPsPowerSequenceType
+	for _, sequence := range sequences {
+		powerSequences = append(powerSequences, internal.PowerSequenceData(sequence))
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	alternativesId := sem.AddAlternative(powerSequences)
+	if alternativesId == nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	return uint(*alternativesId), nil
+}
+
+// remove an alternative including all of its power sequences
+//
+// possible errors:
+//   - ErrDataNotAvailable if the alternative does not exist
+//   - and others
+func (e *OHPCF) RemoveAlternative(alternativesId uint) error {
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return sem.RemoveAlternative(model.AlternativesIdType(alternativesId))
+}
+
+// replace the time slots of a power sequence
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence does not exist
+//   - and others
+func (e *OHPCF) UpdateSequenceSlots(sequenceId uint, slots []ucapi.PowerSequenceSlot) error {
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return sem.UpdateSequenceTimeSlots(model.PowerSequenceIdType(sequenceId), internal.PowerTimeSlotData(slots))
+}
+
+// Scenario 3
+
+// update the state of a power sequence
+//
+// possible errors:
+//   - ErrDataNotAvailable if the power sequence does not exist
+//   - and others
+func (e *OHPCF) UpdateSequenceState(sequenceId uint, state model.PowerSequenceStateType, activeSlot uint) error {
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	var current *ucapi.PowerSequence
+	sequences := e.powerSequences(sem)
+	for i := range sequences {
+		if sequences[i].Id == sequenceId {
+			current = &sequences[i]
+			break
+		}
+	}
+	if current == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	data := model.PowerSequenceStateDataType{
+		SequenceId:                 util.Ptr(model.PowerSequenceIdType(sequenceId)),
+		State:                      util.Ptr(state),
+		SequenceRemoteControllable: util.Ptr(current.RemoteControllable),
+	}
+	if state == model.PowerSequenceStateTypeRunning {
+		data.ActiveSlotNumber = util.Ptr(model.PowerTimeSlotNumberType(activeSlot))
+	}
+
+	return sem.UpdateSequenceState(data)
+}
+
+// return the power sequences of the server feature
+func (e *OHPCF) powerSequences(sem *server.SmartEnergyManagementPs) []ucapi.PowerSequence {
+	data, err := sem.GetData()
+	if err != nil {
+		return nil
+	}
+
+	return internal.PowerSequences(data)
+}
@@ -0,0 +1,93 @@
+package ohpcf
+
+import (
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CompressorOHPCFSuite) Test_Alternatives() {
+	data := s.sut.PowerSequences()
+	assert.Equal(s.T(), 0, len(data))
+
+	_, err := s.sut.AddAlternative(nil)
+	assert.NotNil(s.T(), err)
+
+	id, err := s.sut.AddAlternative([]ucapi.PowerSequence{powerSequence(true), powerSequence(false)})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(0), id)
+
+	sequence := powerSequence(true)
+	sequence.EarliestStartTime = time.Now().Add(time.Hour)
+	id, err = s.sut.AddAlternative([]ucapi.PowerSequence{sequence})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(1), id)
+
+	data = s.sut.PowerSequences()
+	assert.Equal(s.T(), 3, len(data))
+	assert.Equal(s.T(), uint(0), data[0].Id)
+	assert.Equal(s.T(), uint(0), data[0].AlternativesId)
+	assert.True(s.T(), data[0].RemoteControllable)
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, data[0].State)
+	assert.Equal(s.T(), uint(1), data[1].Id)
+	assert.False(s.T(), data[1].RemoteControllable)
+	assert.Equal(s.T(), uint(2), data[2].Id)
+	assert.Equal(s.T(), uint(1), data[2].AlternativesId)
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour), data[2].EarliestStartTime, time.Minute)
+	assert.Equal(s.T(), 2, len(data[2].Slots))
+	assert.Equal(s.T(), 2000.0, data[2].Slots[0].Power)
+
+	err = s.sut.RemoveAlternative(5)
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.RemoveAlternative(0)
+	assert.Nil(s.T(), err)
+
+	data = s.sut.PowerSequences()
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(2), data[0].Id)
+}
+
+func (s *CompressorOHPCFSuite) Test_UpdateSequenceSlots() {
+	err := s.sut.UpdateSequenceSlots(0, nil)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.AddAlternative([]ucapi.PowerSequence{powerSequence(true)})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceSlots(0, []ucapi.PowerSequenceSlot{
+		{Duration: time.Hour * 2, Power: 1500, PowerMax: 2500},
+	})
+	assert.Nil(s.T(), err)
+
+	data := s.sut.PowerSequences()
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), []ucapi.PowerSequenceSlot{
+		{Number: 0, Duration: time.Hour * 2, Power: 1500, PowerMax: 2500},
+	}, data[0].Slots)
+}
+
+func (s *CompressorOHPCFSuite) Test_UpdateSequenceState() {
+	err := s.sut.UpdateSequenceState(0, model.PowerSequenceStateTypeRunning, 0)
+	assert.NotNil(s.T(), err)
+
+	_, err = s.sut.AddAlternative([]ucapi.PowerSequence{powerSequence(true)})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateSequenceState(0, model.PowerSequenceStateTypeRunning, 1)
+	assert.Nil(s.T(), err)
+
+	data := s.sut.PowerSequences()
+	assert.Equal(s.T(), model.PowerSequenceStateTypeRunning, data[0].State)
+	assert.Equal(s.T(), uint(1), data[0].ActiveSlot)
+	assert.True(s.T(), data[0].RemoteControllable)
+
+	err = s.sut.UpdateSequenceState(0, model.PowerSequenceStateTypeCompleted, 1)
+	assert.Nil(s.T(), err)
+
+	data = s.sut.PowerSequences()
+	assert.Equal(s.T(), model.PowerSequenceStateTypeCompleted, data[0].State)
+	assert.Equal(s.T(), uint(0), data[0].ActiveSlot)
+}
@@ -0,0 +1,135 @@
+package ohpcf
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const remoteSki string = "testremoteski"
+
+func TestCompressorOHPCFSuite(t *testing.T) {
+	suite.Run(t, new(CompressorOHPCFSuite))
+}
+
+type CompressorOHPCFSuite struct {
+	suite.Suite
+
+	sut *OHPCF
+
+	service api.ServiceInterface
+
+	compressorEntity spineapi.EntityLocalInterface
+
+	remoteDevice *spinemocks.DeviceRemoteInterface
+	cemEntity    *spinemocks.EntityRemoteInterface
+
+	mux       sync.Mutex
+	scheduled map[uint]time.Time
+	cancelled []uint
+}
+
+func (s *CompressorOHPCFSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *CompressorOHPCFSuite) Schedule(sequenceId uint, startTime time.Time) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.scheduled[sequenceId] = startTime
+}
+
+func (s *CompressorOHPCFSuite) Cancel(sequenceId uint) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.cancelled = append(s.cancelled, sequenceId)
+}
+
+func (s *CompressorOHPCFSuite) BeforeTest(suiteName, testName string) {
+	s.scheduled = make(map[uint]time.Time)
+	s.cancelled = nil
+
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeHVAC},
+		model.DeviceTypeTypeHeatgenerationSystem,
+		[]model.EntityTypeType{model.EntityTypeTypeCompressor},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.remoteDevice = spinemocks.NewDeviceRemoteInterface(s.T())
+	s.remoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.cemEntity = spinemocks.NewEntityRemoteInterface(s.T())
+	s.cemEntity.EXPECT().Device().Return(s.remoteDevice).Maybe()
+	s.cemEntity.EXPECT().EntityType().Return(model.EntityTypeTypeCEM).Maybe()
+
+	s.compressorEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeCompressor)
+
+	s.sut = NewOHPCF(s.compressorEntity, s.Event, s.Schedule, s.Cancel)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+}
+
+// return an incoming write message of the CEM
+func (s *CompressorOHPCFSuite) writeMessage(msgCounter model.MsgCounterType, data *model.SmartEnergyManagementPsDataType) *spineapi.Message {
+	return &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: &msgCounter,
+		},
+		Cmd: model.CmdType{
+			SmartEnergyManagementPsData: data,
+		},
+		FilterPartial: model.NewFilterTypePartial(),
+		DeviceRemote:  s.remoteDevice,
+		EntityRemote:  s.cemEntity,
+	}
+}
+
+// return a schedule as written by the CEM OHPCF implementation
+func scheduleData(sequenceId uint, startTime *model.AbsoluteOrRelativeTimeType) *model.SmartEnergyManagementPsDataType {
+	return &model.SmartEnergyManagementPsDataType{
+		Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+			{
+				PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{
+					{
+						Schedule: &model.PowerSequenceScheduleDataType{
+							SequenceId: util.Ptr(model.PowerSequenceIdType(sequenceId)),
+							StartTime:  startTime,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// return a power sequence with two slots
+func powerSequence(remoteControllable bool) ucapi.PowerSequence {
+	return ucapi.PowerSequence{
+		RemoteControllable: remoteControllable,
+		Slots: []ucapi.PowerSequenceSlot{
+			{Duration: time.Hour, Power: 2000},
+			{Duration: time.Minute * 30, Power: 1000},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package ohpcf
+
+import (
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+)
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "compressor-ohpcf-UseCaseSupportUpdate"
+)
+
+// Callback invoked when the CEM scheduled a power sequence
+//
+// parameters:
+//   - sequenceId: the id of the scheduled power sequence
+//   - startTime: the time the power sequence should be started,
+//     which is the time of the request if it should be started immediately
+type ScheduleCallback func(sequenceId uint, startTime time.Time)
+
+// Callback invoked when the CEM cancelled a scheduled power sequence
+//
+// parameters:
+//   - sequenceId: the id of the cancelled power sequence
+type CancelCallback func(sequenceId uint)
@@ -0,0 +1,224 @@
+package ohpcf
+
+import (
+	"sync"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+type OHPCF struct {
+	*usecase.UseCaseBase
+
+	scheduleCB ScheduleCallback
+	cancelCB   CancelCallback
+
+	mux sync.Mutex
+}
+
+var _ ucapi.CompressorOHPCFInterface = (*OHPCF)(nil)
+
+// Create a new Compressor OHPCF use case
+//
+// Schedules written by the CEM are only accepted for power sequences which
+// are remote controllable. Accepted schedules change the state of the power
+// sequence to scheduled, cancelled ones to inactive. Starting and running the
+// power sequence is up to the application, which has to update the state accordingly.
+//
+// parameters:
+//   - localEntity: the local compressor entity providing the power sequences
+//   - eventCB: the callback for use case events
+//   - scheduleCB: the callback invoked when the CEM scheduled a power sequence
+//   - cancelCB: the callback invoked when the CEM cancelled a scheduled power sequence
+func NewOHPCF(
+	localEntity spineapi.EntityLocalInterface,
+	eventCB api.EntityEventCallback,
+	scheduleCB ScheduleCallback,
+	cancelCB CancelCallback,
+) *OHPCF {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(3),
+			Mandatory: true,
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeCompressor,
+		model.UseCaseNameTypeOptimizationOfSelfConsumptionByHeatPumpCompressorFlexibility,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &OHPCF{
+		UseCaseBase: usecase,
+		scheduleCB:  scheduleCB,
+		cancelCB:    cancelCB,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+// approve or deny a write message with the provided reason
+func (e *OHPCF) approveOrDenyWrite(feature spineapi.FeatureLocalInterface, msg *spineapi.Message, err error) {
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if err != nil {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(err.Error()))
+	}
+	feature.ApproveOrDenyWrite(msg, result)
+}
+
+// callback invoked on incoming write messages to this
+// smartenergymanagementps server feature.
+// the written schedules are applied onto the power sequences,
+// the incoming message itself is not modified
+func (e *OHPCF) smartEnergyManagementPsWriteCB(msg *spineapi.Message) {
+	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil ||
+		msg.Cmd.SmartEnergyManagementPsData == nil {
+		logging.Log().Debug("OHPCF smartEnergyManagementPsWriteCB: invalid message")
+		return
+	}
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		e.approveOrDenyWrite(f, msg, err)
+		return
+	}
+
+	e.mux.Lock()
+
+	data, err := sem.ApplyScheduleWrite(msg.Cmd.SmartEnergyManagementPsData)
+	if err != nil {
+		e.mux.Unlock()
+		e.approveOrDenyWrite(f, msg, err)
+		return
+	}
+
+	scheduled := make(map[uint]time.Time)
+	var cancelled []uint
+	for i := range data.Alternatives {
+		for j := range data.Alternatives[i].PowerSequence {
+			sequence := &data.Alternatives[i].PowerSequence[j]
+			if !isWrittenSchedule(msg.Cmd.SmartEnergyManagementPsData, sequence.Schedule) {
+				continue
+			}
+
+			sequenceId := uint(*sequence.Schedule.SequenceId)
+			newState := model.PowerSequenceStateTypeInactive
+			if sequence.Schedule.StartTime != nil {
+				startTime, _ := sequence.Schedule.StartTime.GetTime()
+				scheduled[sequenceId] = startTime
+				newState = model.PowerSequenceStateTypeScheduled
+			} else {
+				cancelled = append(cancelled, sequenceId)
+			}
+
+			state := model.PowerSequenceStateDataType{SequenceId: sequence.Schedule.SequenceId}
+			if sequence.State != nil {
+				state = *sequence.State
+			}
+			state.State = util.Ptr(newState)
+			sequence.State = &state
+		}
+	}
+
+	e.approveOrDenyWrite(f, msg, nil)
+
+	// the data type does not support partial updates, so SPINE can not apply
+	// the written data itself and the complete data set including the
+	// written schedules is stored instead
+	if err := sem.SetData(data); err != nil {
+		logging.Log().Debug("OHPCF smartEnergyManagementPsWriteCB: error storing data", err)
+	}
+
+	e.mux.Unlock()
+
+	for sequenceId, startTime := range scheduled {
+		if e.scheduleCB != nil {
+			e.scheduleCB(sequenceId, startTime)
+		}
+	}
+
+	for _, sequenceId := range cancelled {
+		if e.cancelCB != nil {
+			e.cancelCB(sequenceId)
+		}
+	}
+}
+
+// check if the schedule is part of the written data
+func isWrittenSchedule(data *model.SmartEnergyManagementPsDataType, schedule *model.PowerSequenceScheduleDataType) bool {
+	if schedule == nil || schedule.SequenceId == nil {
+		return false
+	}
+
+	for _, alternative := range data.Alternatives {
+		for _, sequence := range alternative.PowerSequence {
+			if sequence.Schedule != nil && sequence.Schedule.SequenceId != nil &&
+				*sequence.Schedule.SequenceId == *schedule.SequenceId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (e *OHPCF) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeSmartEnergyManagementPs, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeSmartEnergyManagementPsData, true, true)
+	_ = f.AddWriteApprovalCallback(e.smartEnergyManagementPsWriteCB)
+
+	sem, err := server.NewSmartEnergyManagementPs(e.LocalEntity)
+	if err != nil {
+		return
+	}
+
+	if _, err := sem.GetData(); err == nil {
+		return
+	}
+
+	// the power sequences are scheduled as a whole
+	if err := sem.SetNodeScheduleInformation(model.PowerSequenceNodeScheduleInformationDataType{
+		NodeRemoteControllable:           util.Ptr(true),
+		SupportsSingleSlotSchedulingOnly: util.Ptr(false),
+		SupportsReselection:              util.Ptr(false),
+	}); err != nil {
+		logging.Log().Debug("OHPCF AddFeatures: error setting node schedule information", err)
+	}
+}
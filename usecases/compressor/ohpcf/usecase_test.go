@@ -0,0 +1,81 @@
+package ohpcf
+
+import (
+	"time"
+
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CompressorOHPCFSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *CompressorOHPCFSuite) Test_AddFeatures() {
+	data := s.sut.PowerSequences()
+	assert.Equal(s.T(), 0, len(data))
+
+	// adding the features again keeps the data
+	_, err := s.sut.AddAlternative([]ucapi.PowerSequence{powerSequence(true)})
+	assert.Nil(s.T(), err)
+
+	s.sut.AddFeatures()
+
+	data = s.sut.PowerSequences()
+	assert.Equal(s.T(), 1, len(data))
+}
+
+func (s *CompressorOHPCFSuite) Test_smartEnergyManagementPsWriteCB() {
+	msg0 := &spineapi.Message{}
+	s.sut.smartEnergyManagementPsWriteCB(msg0)
+
+	_, err := s.sut.AddAlternative([]ucapi.PowerSequence{powerSequence(true), powerSequence(false)})
+	assert.Nil(s.T(), err)
+
+	// unknown power sequence
+	msg1 := s.writeMessage(500, scheduleData(5, model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour)))
+	s.sut.smartEnergyManagementPsWriteCB(msg1)
+	assert.NotNil(s.T(), msg1.FilterPartial)
+
+	// power sequence is not remote controllable
+	msg2 := s.writeMessage(501, scheduleData(1, model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour)))
+	s.sut.smartEnergyManagementPsWriteCB(msg2)
+	assert.NotNil(s.T(), msg2.FilterPartial)
+	assert.Equal(s.T(), 0, len(s.scheduled))
+
+	written := scheduleData(0, model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour))
+	msg3 := s.writeMessage(502, written)
+	s.sut.smartEnergyManagementPsWriteCB(msg3)
+	assert.Equal(s.T(), 1, len(s.scheduled))
+	assert.WithinDuration(s.T(), time.Now().Add(time.Hour), s.scheduled[0], time.Minute)
+
+	// the incoming message is not modified
+	assert.NotNil(s.T(), msg3.FilterPartial)
+	assert.Equal(s.T(), written, msg3.Cmd.SmartEnergyManagementPsData)
+	assert.Equal(s.T(), scheduleData(0, model.NewAbsoluteOrRelativeTimeTypeFromDuration(time.Hour)), written)
+
+	// the complete data set with the schedule and the new state is stored
+	sem, err := server.NewSmartEnergyManagementPs(s.compressorEntity)
+	assert.Nil(s.T(), err)
+	data, err := sem.GetData()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data.Alternatives[0].PowerSequence))
+	item := data.Alternatives[0].PowerSequence[0]
+	assert.Equal(s.T(), model.PowerSequenceStateTypeScheduled, *item.State.State)
+	assert.False(s.T(), item.Schedule.StartTime.IsRelativeTime())
+	assert.Nil(s.T(), data.Alternatives[0].PowerSequence[1].Schedule)
+
+	msg4 := s.writeMessage(503, scheduleData(0, nil))
+	s.sut.smartEnergyManagementPsWriteCB(msg4)
+	assert.NotNil(s.T(), msg4.FilterPartial)
+	assert.Equal(s.T(), []uint{0}, s.cancelled)
+
+	data, err = sem.GetData()
+	assert.Nil(s.T(), err)
+	item = data.Alternatives[0].PowerSequence[0]
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, *item.State.State)
+	assert.Nil(s.T(), item.Schedule.StartTime)
+}
@@ -32,8 +32,13 @@ func (e *LPP) HandleEvent(payload spineapi.EventPayload) {
 		return
 	}
 
-	if internal.IsHeartbeat(payload) && e.EventCB != nil {
-		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateHeartbeat)
+	if internal.IsHeartbeat(payload) {
+		e.setStateEntity(payload.Entity)
+		e.stateMachine.Evaluate()
+
+		if e.EventCB != nil {
+			e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateHeartbeat)
+		}
 		return
 	}
 
@@ -101,7 +106,7 @@ func (e *LPP) deviceConnected(payload spineapi.EventPayload) {
 	// we only found one matching entity, as it should be, subscribe
 	if len(deviceDiagEntities) == 1 {
 		if localDeviceDiag, err := client.NewDeviceDiagnosis(e.LocalEntity, deviceDiagEntities[0]); err == nil {
-			e.heartbeatDiag = localDeviceDiag
+			e.setHeartbeatDiag(localDeviceDiag)
 			if !localDeviceDiag.HasSubscription() {
 				if _, err := localDeviceDiag.Subscribe(); err != nil {
 					logging.Log().Debug(err)
@@ -127,7 +132,7 @@ func (e *LPP) subscribeHeartbeatWorkaround(payload spineapi.EventPayload) {
 	// is the workaround is needed?
 	if e.heartbeatKeoWorkaround {
 		if localDeviceDiag, err := client.NewDeviceDiagnosis(e.LocalEntity, payload.Entity); err == nil {
-			e.heartbeatDiag = localDeviceDiag
+			e.setHeartbeatDiag(localDeviceDiag)
 			if !localDeviceDiag.HasSubscription() {
 				if _, err := localDeviceDiag.Subscribe(); err != nil {
 					logging.Log().Debug(err)
@@ -150,14 +155,23 @@ func (e *LPP) loadControlLimitDataUpdate(payload spineapi.EventPayload) {
 			ScopeType:      util.Ptr(model.ScopeTypeTypeActivePowerLimit),
 			LimitDirection: util.Ptr(model.EnergyDirectionTypeProduce),
 		}
-		if lc.CheckEventPayloadDataForFilter(payload.Data, filter) && e.EventCB != nil {
-			e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+		if lc.CheckEventPayloadDataForFilter(payload.Data, filter) {
+			e.setStateEntity(payload.Entity)
+			e.updateLimitExpiry()
+			e.saveToStore()
+			e.stateMachine.LimitReceived()
+
+			if e.EventCB != nil {
+				e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+			}
 		}
 	}
 }
 
 // the configuration key data was updated
 func (e *LPP) configurationDataUpdate(payload spineapi.EventPayload) {
+	e.saveToStore()
+
 	if dc, err := server.NewDeviceConfiguration(e.LocalEntity); err == nil {
 		filter := model.DeviceConfigurationKeyValueDescriptionDataType{
 			KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypeFailsafeProductionActivePowerLimit),
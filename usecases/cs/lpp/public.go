@@ -53,6 +53,8 @@ func (e *LPP) ProductionLimit() (limit ucapi.LoadLimit, resultErr error) {
 }
 
 // set the current production limit data
+//
+// an active limit with a duration is automatically deactivated once the duration elapsed
 func (e *LPP) SetProductionLimit(limit ucapi.LoadLimit) (resultErr error) {
 	loadControlf, limidId, err := e.loadControlServerAndLimitId()
 	if err != nil {
@@ -86,7 +88,14 @@ func (e *LPP) SetProductionLimit(limit ucapi.LoadLimit) (resultErr error) {
 		TimePeriod: util.Ptr(model.TimePeriodElementsType{}),
 	}
 
-	return loadControlf.UpdateLimitDataForFilters(limitData, deleteSelector, deleteTimePeriod)
+	if err := loadControlf.UpdateLimitDataForFilters(limitData, deleteSelector, deleteTimePeriod); err != nil {
+		return err
+	}
+
+	e.updateLimitExpiry()
+	e.saveToStore()
+
+	return nil
 }
 
 // return the currently pending incoming production write limits
@@ -153,6 +162,44 @@ func (e *LPP) ApproveOrDenyProductionLimit(msgCounter model.MsgCounterType, appr
 	e.approveOrDenyProductionLimit(msg, approve, reason)
 
 	delete(e.pendingLimits, msgCounter)
+
+	if timer, ok := e.pendingTimers[msgCounter]; ok {
+		timer.Stop()
+		delete(e.pendingTimers, msgCounter)
+	}
+}
+
+// set the timeout for pending incoming production write limits
+//
+// If a pending write limit is not approved or denied within the timeout,
+// it is approved or denied according to the policy and the
+// `WriteApprovalTimeout` event is triggered.
+// The timeout applies to write limits received afterwards.
+//
+// parameters:
+//   - timeout: the timeout, 0 disables the timeout (default)
+//   - policy: if the write limit is approved or denied once the timeout elapsed
+//   - reason: the reason provided if the write limit is denied
+func (e *LPP) SetWriteApprovalTimeout(timeout time.Duration, policy ucapi.WriteApprovalPolicyType, reason string) {
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	e.approvalTimeout = timeout
+	e.approvalPolicy = policy
+	e.approvalReason = reason
+}
+
+// set the store used to persist the production limit, the failsafe values and the nominal max
+//
+// The stored data is restored into the local server features right away, or once
+// `AddFeatures` is invoked if the features were not yet added.
+// Afterwards every change of the data is stored.
+func (e *LPP) SetLimitStore(store ucapi.LimitStoreInterface) {
+	e.storeMux.Lock()
+	e.store = store
+	e.storeMux.Unlock()
+
+	e.restoreFromStore()
 }
 
 // Scenario 2
@@ -203,7 +250,13 @@ func (e *LPP) SetFailsafeProductionActivePowerLimit(value float64, changeable bo
 	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
 		KeyName: util.Ptr(keyName),
 	}
-	return dc.UpdateKeyValueDataForFilter(data, nil, filter)
+	if err := dc.UpdateKeyValueDataForFilter(data, nil, filter); err != nil {
+		return err
+	}
+
+	e.saveToStore()
+
+	return nil
 }
 
 // return minimum time the Controllable System remains in "failsafe state" unless conditions
@@ -264,7 +317,13 @@ func (e *LPP) SetFailsafeDurationMinimum(duration time.Duration, changeable bool
 	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
 		KeyName: util.Ptr(keyName),
 	}
-	return dc.UpdateKeyValueDataForFilter(data, nil, filter)
+	if err := dc.UpdateKeyValueDataForFilter(data, nil, filter); err != nil {
+		return err
+	}
+
+	e.saveToStore()
+
+	return nil
 }
 
 // Scenario 3
@@ -286,11 +345,12 @@ func (e *LPP) StopHeartbeat() {
 }
 
 func (e *LPP) IsHeartbeatWithinDuration() bool {
-	if e.heartbeatDiag == nil {
+	heartbeatDiag := e.heartbeatDiagnosis()
+	if heartbeatDiag == nil {
 		return false
 	}
 
-	return e.heartbeatDiag.IsHeartbeatWithinDuration(2 * time.Minute)
+	return heartbeatDiag.IsHeartbeatWithinDuration(2 * time.Minute)
 }
 
 // Scenario 4
@@ -356,7 +416,58 @@ func (e *LPP) SetProductionNominalMax(value float64) error {
 		CharacteristicId:       charList[0].CharacteristicId,
 		Value:                  model.NewScaledNumberType(value),
 	}
-	return ec.UpdateCharacteristic(data, nil)
+	if err := ec.UpdateCharacteristic(data, nil); err != nil {
+		return err
+	}
+
+	e.saveToStore()
+
+	return nil
+}
+
+// State machine
+
+// start the Controllable System state machine
+//
+// The state machine is not running by default. Once started it begins in "init" state
+// and switches the states depending on the received heartbeats and limits, the
+// failsafe production active power limit and the failsafe duration minimum.
+// Each state change is reported using the `DataUpdateState` event.
+func (e *LPP) StartStateMachine() {
+	e.stateMachine.Start()
+}
+
+// stop the Controllable System state machine
+//
+// the last state is kept
+func (e *LPP) StopStateMachine() {
+	e.stateMachine.Stop()
+}
+
+// set the clock used by the Controllable System state machine and
+// for the end time of limits with a duration
+//
+// the system time is used by default or if clock is nil
+func (e *LPP) SetClock(clock ucapi.ClockInterface) {
+	e.stateMachine.SetClock(clock)
+}
+
+// return the current state of the Controllable System state machine
+//
+// returns an empty value if the state machine was never started
+func (e *LPP) CurrentState() ucapi.LimitationStateType {
+	return e.stateMachine.State()
+}
+
+// return the production limit the Controllable System has to apply in the current state
+//
+// If the state machine was never started, the active production limit is returned.
+//
+// return values:
+//   - value: the power limit in W
+//   - isLimited: false if no limit has to be applied
+func (e *LPP) EffectiveProductionLimit() (value float64, isLimited bool) {
+	return e.stateMachine.EffectiveLimit()
 }
 
 // returns the characteristictype depending on the local entities device devicetype
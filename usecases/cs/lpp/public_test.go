@@ -1,10 +1,14 @@
 package lpp
 
 import (
+	"path/filepath"
 	"time"
 
+	"github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/features/server"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/store"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 	"github.com/enbility/spine-go/util"
@@ -118,7 +122,7 @@ func (s *CsLPPSuite) Test_Failsafe() {
 }
 
 func (s *CsLPPSuite) Test_Heartbeat() {
-	assert.Nil(s.T(), s.sut.heartbeatDiag)
+	assert.Nil(s.T(), s.sut.heartbeatDiagnosis())
 
 	value := s.sut.IsHeartbeatWithinDuration()
 	assert.False(s.T(), value)
@@ -126,10 +130,10 @@ func (s *CsLPPSuite) Test_Heartbeat() {
 	remoteDiagServer := s.monitoredEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
 	assert.NotNil(s.T(), remoteDiagServer)
 
-	var err error
-	s.sut.heartbeatDiag, err = client.NewDeviceDiagnosis(s.sut.LocalEntity, s.monitoredEntity)
-	assert.NotNil(s.T(), s.sut.heartbeatDiag)
+	heartbeatDiag, err := client.NewDeviceDiagnosis(s.sut.LocalEntity, s.monitoredEntity)
+	assert.NotNil(s.T(), heartbeatDiag)
 	assert.Nil(s.T(), err)
+	s.sut.setHeartbeatDiag(heartbeatDiag)
 
 	// add heartbeat data to the remoteDiagServer
 	timestamp := time.Now().Add(-time.Second * 121)
@@ -169,3 +173,462 @@ func (s *CsLPPSuite) Test_ContractualProductionNominalMax() {
 	assert.Equal(s.T(), 10.0, value)
 	assert.Nil(s.T(), err)
 }
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (s *CsLPPSuite) Test_StateMachine() {
+	state := s.sut.CurrentState()
+	assert.Equal(s.T(), ucapi.LimitationStateType(""), state)
+
+	value, isLimited := s.sut.EffectiveProductionLimit()
+	assert.Equal(s.T(), 0.0, value)
+	assert.False(s.T(), isLimited)
+
+	clock := &testClock{now: time.Now()}
+	s.sut.SetClock(clock)
+
+	err := s.sut.SetFailsafeProductionActivePowerLimit(4200, true)
+	assert.Nil(s.T(), err)
+
+	s.sut.StartStateMachine()
+	defer s.sut.StopStateMachine()
+
+	// no remote entity is known yet, so no event is sent
+	assert.False(s.T(), s.eventCalled)
+	state = s.sut.CurrentState()
+	assert.Equal(s.T(), ucapi.LimitationStateTypeInit, state)
+
+	value, isLimited = s.sut.EffectiveProductionLimit()
+	assert.Equal(s.T(), 4200.0, value)
+	assert.True(s.T(), isLimited)
+
+	remoteDiagServer := s.monitoredEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	heartbeatDiag, err := client.NewDeviceDiagnosis(s.sut.LocalEntity, s.monitoredEntity)
+	assert.Nil(s.T(), err)
+	s.sut.setHeartbeatDiag(heartbeatDiag)
+
+	heartbeat := &model.DeviceDiagnosisHeartbeatDataType{
+		Timestamp:        model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now()),
+		HeartbeatCounter: util.Ptr(uint64(1)),
+		HeartbeatTimeout: model.NewDurationType(time.Second * 120),
+	}
+	_, err1 := remoteDiagServer.UpdateData(true, model.FunctionTypeDeviceDiagnosisHeartbeatData, heartbeat, nil, nil)
+	assert.Nil(s.T(), err1)
+
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+
+	// the heartbeat alone does not leave the init state
+	payload := spineapi.EventPayload{
+		Ski:           remoteSki,
+		Device:        s.remoteDevice,
+		Entity:        s.monitoredEntity,
+		EventType:     spineapi.EventTypeDataChange,
+		ChangeType:    spineapi.ElementChangeUpdate,
+		Function:      model.FunctionTypeDeviceDiagnosisHeartbeatData,
+		CmdClassifier: util.Ptr(model.CmdClassifierTypeNotify),
+		Data:          heartbeat,
+	}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), ucapi.LimitationStateTypeInit, s.sut.CurrentState())
+
+	s.eventCalled = false
+	payload = spineapi.EventPayload{
+		Ski:           remoteSki,
+		Device:        s.remoteDevice,
+		Entity:        s.monitoredEntity,
+		EventType:     spineapi.EventTypeDataChange,
+		ChangeType:    spineapi.ElementChangeUpdate,
+		Function:      model.FunctionTypeLoadControlLimitListData,
+		CmdClassifier: util.Ptr(model.CmdClassifierTypeWrite),
+		LocalFeature:  s.loadControlFeature,
+		Data: &model.LoadControlLimitListDataType{
+			LoadControlLimitData: []model.LoadControlLimitDataType{
+				{
+					LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+					IsLimitActive: util.Ptr(true),
+					Value:         model.NewScaledNumberType(1000),
+				},
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.True(s.T(), s.eventCalled)
+	assert.Equal(s.T(), ucapi.LimitationStateTypeLimited, s.sut.CurrentState())
+
+	value, isLimited = s.sut.EffectiveProductionLimit()
+	assert.Equal(s.T(), 1000.0, value)
+	assert.True(s.T(), isLimited)
+
+	// the heartbeat is missing
+	heartbeat.Timestamp = model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now().Add(-time.Second * 121))
+	_, err1 = remoteDiagServer.UpdateData(true, model.FunctionTypeDeviceDiagnosisHeartbeatData, heartbeat, nil, nil)
+	assert.Nil(s.T(), err1)
+
+	s.sut.stateMachine.Evaluate()
+	assert.Equal(s.T(), ucapi.LimitationStateTypeFailsafe, s.sut.CurrentState())
+
+	value, isLimited = s.sut.EffectiveProductionLimit()
+	assert.Equal(s.T(), 4200.0, value)
+	assert.True(s.T(), isLimited)
+
+	err = s.sut.SetFailsafeDurationMinimum(time.Hour*2, true)
+	assert.Nil(s.T(), err)
+
+	clock.now = clock.now.Add(time.Hour * 2)
+	s.sut.stateMachine.Evaluate()
+	assert.Equal(s.T(), ucapi.LimitationStateTypeUnlimitedAutonomous, s.sut.CurrentState())
+
+	value, isLimited = s.sut.EffectiveProductionLimit()
+	assert.Equal(s.T(), 0.0, value)
+	assert.False(s.T(), isLimited)
+
+	s.sut.StopStateMachine()
+
+	// events without a known remote entity are dropped
+	s.eventCalled = false
+	s.sut.stateEntity = nil
+	s.sut.stateUpdate(ucapi.LimitationStateTypeInit)
+	assert.False(s.T(), s.eventCalled)
+
+	s.sut.SetClock(nil)
+}
+
+func (s *CsLPPSuite) Test_LimitExpiry() {
+	err := s.sut.SetProductionLimit(ucapi.LoadLimit{
+		Duration:     time.Hour * 2,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), s.sut.limitExpiry.IsScheduled())
+
+	// a limit without a duration does not expire
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), s.sut.limitExpiry.IsScheduled())
+
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		Duration:     time.Hour * 2,
+		IsActive:     false,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), s.sut.limitExpiry.IsScheduled())
+
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		Duration:     time.Hour * 2,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), s.sut.limitExpiry.IsScheduled())
+
+	// the event is sent for the remote entity of the last received heartbeat or limit
+	s.sut.setStateEntity(s.monitoredEntity)
+	s.eventCalled = false
+	s.sut.limitExpired()
+	assert.True(s.T(), s.eventCalled)
+
+	limit, err := s.sut.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), limit.IsActive)
+	assert.Equal(s.T(), time.Duration(0), limit.Duration)
+	assert.Equal(s.T(), 1000.0, limit.Value)
+
+	// an incoming write with an already elapsed end time expires immediately
+	lc, err := server.NewLoadControl(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+
+	err = lc.UpdateLimitDataForIds([]api.LoadControlLimitDataForID{
+		{
+			Data: model.LoadControlLimitDataType{
+				IsLimitActive: util.Ptr(true),
+				Value:         model.NewScaledNumberType(2000),
+				TimePeriod: &model.TimePeriodType{
+					EndTime: model.NewAbsoluteOrRelativeTimeTypeFromTime(time.Now().Add(-time.Second)),
+				},
+			},
+			Id: model.LoadControlLimitIdType(0),
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	payload := spineapi.EventPayload{
+		Ski:           remoteSki,
+		Device:        s.remoteDevice,
+		Entity:        s.monitoredEntity,
+		EventType:     spineapi.EventTypeDataChange,
+		ChangeType:    spineapi.ElementChangeUpdate,
+		Function:      model.FunctionTypeLoadControlLimitListData,
+		CmdClassifier: util.Ptr(model.CmdClassifierTypeWrite),
+		LocalFeature:  s.loadControlFeature,
+		Data: &model.LoadControlLimitListDataType{
+			LoadControlLimitData: []model.LoadControlLimitDataType{
+				{
+					LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+					IsLimitActive: util.Ptr(true),
+					Value:         model.NewScaledNumberType(2000),
+				},
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+
+	assert.Eventually(s.T(), func() bool {
+		limit, err := s.sut.ProductionLimit()
+		return err == nil && !limit.IsActive
+	}, time.Second, time.Millisecond*10)
+}
+
+func (s *CsLPPSuite) Test_WriteApprovalTimeout() {
+	msgCounter := model.MsgCounterType(500)
+
+	msg := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: util.Ptr(msgCounter),
+		},
+		Cmd: model.CmdType{
+			LoadControlLimitListData: &model.LoadControlLimitListDataType{
+				LoadControlLimitData: []model.LoadControlLimitDataType{
+					{
+						LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+						IsLimitActive: util.Ptr(true),
+						Value:         model.NewScaledNumberType(1000),
+					},
+				},
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.monitoredEntity,
+	}
+
+	s.sut.SetWriteApprovalTimeout(time.Millisecond*20, ucapi.WriteApprovalPolicyTypeDeny, "timeout")
+
+	s.sut.loadControlWriteCB(msg)
+	assert.Equal(s.T(), 1, len(s.sut.PendingProductionLimits()))
+
+	assert.Eventually(s.T(), func() bool {
+		return len(s.sut.PendingProductionLimits()) == 0
+	}, time.Second, time.Millisecond*5)
+
+	s.sut.pendingMux.Lock()
+	assert.Equal(s.T(), 0, len(s.sut.pendingTimers))
+	s.sut.pendingMux.Unlock()
+
+	// the application answers before the timeout
+	s.sut.SetWriteApprovalTimeout(time.Hour, ucapi.WriteApprovalPolicyTypeApprove, "")
+
+	msgCounter = model.MsgCounterType(501)
+	msg.RequestHeader.MsgCounter = util.Ptr(msgCounter)
+	s.sut.loadControlWriteCB(msg)
+	assert.Equal(s.T(), 1, len(s.sut.PendingProductionLimits()))
+
+	s.sut.pendingMux.Lock()
+	assert.Equal(s.T(), 1, len(s.sut.pendingTimers))
+	s.sut.pendingMux.Unlock()
+
+	s.sut.ApproveOrDenyProductionLimit(msgCounter, true, "")
+	assert.Equal(s.T(), 0, len(s.sut.PendingProductionLimits()))
+
+	s.sut.pendingMux.Lock()
+	assert.Equal(s.T(), 0, len(s.sut.pendingTimers))
+	s.sut.pendingMux.Unlock()
+
+	// a timeout for an already answered write does nothing
+	s.sut.pendingLimitTimeout(msgCounter)
+}
+
+func (s *CsLPPSuite) Test_LimitStore() {
+	path := filepath.Join(s.T().TempDir(), "limits.json")
+	limitStore := store.NewJSONFileLimitStore(path)
+
+	// nothing stored yet, the current data is kept
+	s.sut.SetLimitStore(limitStore)
+	data, err := limitStore.Load()
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		Duration:     time.Hour * 2,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetFailsafeProductionActivePowerLimit(4200, true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetFailsafeDurationMinimum(time.Hour*3, true)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetProductionNominalMax(10000)
+	assert.Nil(s.T(), err)
+
+	data, err = limitStore.Load()
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), 1000.0, data.LimitValue)
+	assert.True(s.T(), data.LimitActive)
+	assert.NotNil(s.T(), data.LimitEndTime)
+	assert.Equal(s.T(), 4200.0, data.FailsafeLimit)
+	assert.Equal(s.T(), time.Hour*3, data.FailsafeDuration)
+	assert.Equal(s.T(), 10000.0, data.NominalMax)
+	endTime := *data.LimitEndTime
+
+	// storing other values later does not move the end time of the limit
+	time.Sleep(time.Millisecond * 10)
+	err = s.sut.SetProductionNominalMax(11000)
+	assert.Nil(s.T(), err)
+
+	data, err = limitStore.Load()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 11000.0, data.NominalMax)
+	assert.True(s.T(), endTime.Equal(*data.LimitEndTime))
+
+	err = s.sut.SetProductionNominalMax(10000)
+	assert.Nil(s.T(), err)
+
+	// restore the stored data into a new instance
+	s.BeforeTest("", "")
+	s.sut.SetLimitStore(limitStore)
+
+	// the restored instance keeps the stored end time
+	time.Sleep(time.Millisecond * 10)
+	err = s.sut.SetProductionNominalMax(10000)
+	assert.Nil(s.T(), err)
+
+	data, err = limitStore.Load()
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), endTime.Equal(*data.LimitEndTime))
+
+	limit, err := s.sut.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, limit.Value)
+	assert.True(s.T(), limit.IsActive)
+	assert.InDelta(s.T(), float64(time.Hour*2), float64(limit.Duration), float64(time.Minute))
+	assert.True(s.T(), s.sut.limitExpiry.IsScheduled())
+
+	failsafe, _, err := s.sut.FailsafeProductionActivePowerLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4200.0, failsafe)
+
+	duration, _, err := s.sut.FailsafeDurationMinimum()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Hour*3, duration)
+
+	nominal, err := s.sut.ProductionNominalMax()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10000.0, nominal)
+
+	// a limit which expired while offline is restored inactive
+	endTime = time.Now().Add(-time.Minute)
+	data.LimitEndTime = &endTime
+	err = limitStore.Save(*data)
+	assert.Nil(s.T(), err)
+
+	s.BeforeTest("", "")
+	s.sut.SetLimitStore(limitStore)
+
+	limit, err = s.sut.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1000.0, limit.Value)
+	assert.False(s.T(), limit.IsActive)
+	assert.False(s.T(), s.sut.limitExpiry.IsScheduled())
+}
+
+func (s *CsLPPSuite) Test_LimitEndTimeClock() {
+	path := filepath.Join(s.T().TempDir(), "limits.json")
+	limitStore := store.NewJSONFileLimitStore(path)
+	s.sut.SetLimitStore(limitStore)
+
+	// the end time of a limit is based on the clock of the state machine
+	clock := &testClock{now: time.Now().Add(-time.Hour * 24)}
+	s.sut.SetClock(clock)
+
+	err := s.sut.SetProductionLimit(ucapi.LoadLimit{
+		Duration:     time.Hour,
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+
+	data, err := limitStore.Load()
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data.LimitEndTime)
+	assert.True(s.T(), clock.now.Add(time.Hour).Equal(*data.LimitEndTime))
+}
+
+func (s *CsLPPSuite) Test_LimitStore_ApplicationValues() {
+	path := filepath.Join(s.T().TempDir(), "limits.json")
+	limitStore := store.NewJSONFileLimitStore(path)
+
+	// the store only contains the defaults of the server features
+	err := limitStore.Save(ucapi.LimitStoreData{})
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetFailsafeProductionActivePowerLimit(4200, true)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetFailsafeDurationMinimum(time.Hour*3, true)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetProductionNominalMax(10000)
+	assert.Nil(s.T(), err)
+	err = s.sut.SetProductionLimit(ucapi.LoadLimit{
+		IsActive:     true,
+		IsChangeable: true,
+		Value:        1000,
+	})
+	assert.Nil(s.T(), err)
+
+	// the values set by the application are kept
+	s.sut.SetLimitStore(limitStore)
+
+	failsafe, _, err := s.sut.FailsafeProductionActivePowerLimit()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4200.0, failsafe)
+
+	duration, _, err := s.sut.FailsafeDurationMinimum()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Hour*3, duration)
+
+	nominal, err := s.sut.ProductionNominalMax()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10000.0, nominal)
+
+	limit, err := s.sut.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), limit.IsActive)
+	assert.Equal(s.T(), 1000.0, limit.Value)
+
+	// an active limit of 0 is restored
+	err = limitStore.Save(ucapi.LimitStoreData{
+		LimitActive: true,
+	})
+	assert.Nil(s.T(), err)
+
+	s.sut.SetLimitStore(limitStore)
+
+	limit, err = s.sut.ProductionLimit()
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), limit.IsActive)
+	assert.Equal(s.T(), 0.0, limit.Value)
+}
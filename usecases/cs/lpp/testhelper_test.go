@@ -2,6 +2,7 @@ package lpp
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -39,14 +40,21 @@ type CsLPPSuite struct {
 	deviceConfigurationFeature spineapi.FeatureLocalInterface
 
 	eventCalled bool
+	mux         sync.Mutex
 }
 
 func (s *CsLPPSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
 	s.eventCalled = true
 }
 
 func (s *CsLPPSuite) BeforeTest(suiteName, testName string) {
+	s.mux.Lock()
 	s.eventCalled = false
+	s.mux.Unlock()
+
 	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
 	configuration, _ := api.NewConfiguration(
 		"test", "test", "test", "test",
@@ -9,6 +9,8 @@ const (
 	UseCaseSupportUpdate api.EventType = "cs-lpp-UseCaseSupportUpdate"
 
 	// Load control obligation limit data update received
+	// or the duration of the active limit elapsed, the latter is only
+	// sent once a heartbeat or limit of a remote entity was received
 	//
 	// Use `ProductionLimit` to get the current data
 	//
@@ -23,6 +25,12 @@ const (
 	// Use Case LPC, Scenario 1
 	WriteApprovalRequired api.EventType = "cs-lpp-WriteApprovalRequired"
 
+	// An incoming load control obligation limit was not approved or denied in time
+	// and got approved or denied according to the policy set with `SetWriteApprovalTimeout`
+	//
+	// Use Case LPP, Scenario 1
+	WriteApprovalTimeout api.EventType = "cs-lpp-WriteApprovalTimeout"
+
 	// Failsafe limit for the produced active (real) power of the
 	// Controllable System data update received
 	//
@@ -44,4 +52,13 @@ const (
 	//
 	// Use Case LPP, Scenario 3
 	DataUpdateHeartbeat api.EventType = "uclpcserver-DataUpdateHeartbeat"
+
+	// The state of the Controllable System state machine changed
+	//
+	// Use `CurrentState` to get the current state and `EffectiveProductionLimit`
+	// to get the limit that has to be applied
+	//
+	// Only used if the state machine was started using `StartStateMachine`, and only
+	// sent once a heartbeat or limit of a remote entity was received
+	DataUpdateState api.EventType = "cs-lpp-DataUpdateState"
 )
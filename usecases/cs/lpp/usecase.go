@@ -2,11 +2,13 @@ package lpp
 
 import (
 	"sync"
+	"time"
 
 	"github.com/enbility/eebus-go/api"
 	features "github.com/enbility/eebus-go/features/client"
 	"github.com/enbility/eebus-go/features/server"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
 	"github.com/enbility/eebus-go/usecases/usecase"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
@@ -20,10 +22,26 @@ type LPP struct {
 
 	pendingMux    sync.Mutex
 	pendingLimits map[model.MsgCounterType]*spineapi.Message
+	pendingTimers map[model.MsgCounterType]*time.Timer
 
-	heartbeatDiag *features.DeviceDiagnosis
+	approvalTimeout time.Duration // 0 if pending limits do not time out
+	approvalPolicy  ucapi.WriteApprovalPolicyType
+	approvalReason  string
+
+	heartbeatDiag *features.DeviceDiagnosis // the DeviceDiagnosis server providing the heartbeat, guarded by stateMux
 
 	heartbeatKeoWorkaround bool // required because KEO Stack uses multiple identical entities for the same functionality, and it is not clear which to use
+
+	stateMachine *internal.LimitStateMachine
+	stateMux     sync.Mutex
+	stateEntity  spineapi.EntityRemoteInterface // the remote entity of the last received heartbeat or limit
+
+	limitExpiry internal.LimitExpiryTimer
+
+	store          ucapi.LimitStoreInterface
+	storeRestoring bool       // set while the stored data is restored, so it is not stored again
+	limitEndTime   *time.Time // the absolute end time of the active limit, recorded when the limit is set or received
+	storeMux       sync.Mutex
 }
 
 var _ ucapi.CsLPPInterface = (*LPP)(nil)
@@ -69,8 +87,17 @@ func NewLPP(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCa
 	uc := &LPP{
 		UseCaseBase:   usecase,
 		pendingLimits: make(map[model.MsgCounterType]*spineapi.Message),
+		pendingTimers: make(map[model.MsgCounterType]*time.Timer),
 	}
 
+	uc.stateMachine = internal.NewLimitStateMachine(
+		uc.IsHeartbeatWithinDuration,
+		uc.ProductionLimit,
+		uc.FailsafeProductionActivePowerLimit,
+		uc.FailsafeDurationMinimum,
+		uc.stateUpdate,
+	)
+
 	_ = spine.Events.Subscribe(uc)
 
 	return uc
@@ -103,6 +130,267 @@ func (e *LPP) loadControlServerAndLimitId() (lc *server.LoadControl, limitid mod
 	return lc, *description.LimitId, nil
 }
 
+// set the DeviceDiagnosis server providing the heartbeat of the Energy Guard
+func (e *LPP) setHeartbeatDiag(diag *features.DeviceDiagnosis) {
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	e.heartbeatDiag = diag
+}
+
+// return the DeviceDiagnosis server providing the heartbeat of the Energy Guard,
+// nil if none is known yet
+func (e *LPP) heartbeatDiagnosis() *features.DeviceDiagnosis {
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	return e.heartbeatDiag
+}
+
+// remember the remote entity used for state change events
+func (e *LPP) setStateEntity(entity spineapi.EntityRemoteInterface) {
+	if entity == nil {
+		return
+	}
+
+	e.stateMux.Lock()
+	defer e.stateMux.Unlock()
+
+	e.stateEntity = entity
+}
+
+// invoke the event callback for the remote entity of the last received heartbeat or limit
+//
+// used for events not triggered by an incoming message,
+// the event is dropped if no remote entity is known yet
+func (e *LPP) stateEvent(event api.EventType) {
+	if e.EventCB == nil {
+		return
+	}
+
+	e.stateMux.Lock()
+	entity := e.stateEntity
+	e.stateMux.Unlock()
+
+	if entity == nil || entity.Device() == nil {
+		return
+	}
+
+	e.EventCB(entity.Device().Ski(), entity.Device(), entity, event)
+}
+
+// callback invoked by the state machine whenever the state changed
+func (e *LPP) stateUpdate(state ucapi.LimitationStateType) {
+	e.stateEvent(DataUpdateState)
+}
+
+// schedule the deactivation of the production limit if it is active and has a duration,
+// otherwise cancel a previously scheduled deactivation
+func (e *LPP) updateLimitExpiry() {
+	lc, limitId, err := e.loadControlServerAndLimitId()
+	if err != nil {
+		e.cancelLimitExpiry()
+		return
+	}
+
+	data, err := lc.GetLimitDataForId(limitId)
+	if err != nil || data == nil ||
+		data.IsLimitActive == nil || !*data.IsLimitActive ||
+		data.TimePeriod == nil || data.TimePeriod.EndTime == nil {
+		e.cancelLimitExpiry()
+		return
+	}
+
+	duration, err := data.TimePeriod.GetDuration()
+	if err != nil {
+		e.cancelLimitExpiry()
+		return
+	}
+
+	if duration < 0 {
+		duration = 0
+	}
+
+	// the end time is only recorded here, so storing the limit again does not extend it
+	e.setLimitEndTime(util.Ptr(e.stateMachine.Now().Add(duration)))
+	e.limitExpiry.Schedule(duration, e.limitExpired)
+}
+
+// cancel a scheduled deactivation of the limit
+func (e *LPP) cancelLimitExpiry() {
+	e.setLimitEndTime(nil)
+	e.limitExpiry.Cancel()
+}
+
+// set the absolute end time of the active limit, nil if the limit has no duration
+func (e *LPP) setLimitEndTime(endTime *time.Time) {
+	e.storeMux.Lock()
+	defer e.storeMux.Unlock()
+
+	e.limitEndTime = endTime
+}
+
+// the duration of the active production limit elapsed
+func (e *LPP) limitExpired() {
+	lc, limitId, err := e.loadControlServerAndLimitId()
+	if err != nil {
+		return
+	}
+
+	limitData := []api.LoadControlLimitDataForFilter{
+		{
+			Data: model.LoadControlLimitDataType{
+				IsLimitActive: util.Ptr(false),
+			},
+			Filter: model.LoadControlLimitDescriptionDataType{
+				LimitId: &limitId,
+			},
+		},
+	}
+	deleteSelector := &model.LoadControlLimitListDataSelectorsType{
+		LimitId: &limitId,
+	}
+	deleteTimePeriod := &model.LoadControlLimitDataElementsType{
+		TimePeriod: util.Ptr(model.TimePeriodElementsType{}),
+	}
+
+	if err := lc.UpdateLimitDataForFilters(limitData, deleteSelector, deleteTimePeriod); err != nil {
+		logging.Log().Debug("LPP limitExpired: error deactivating limit", err)
+		return
+	}
+
+	e.saveToStore()
+	e.stateMachine.Evaluate()
+	e.stateEvent(DataUpdateLimit)
+}
+
+// store the current limit, the failsafe values and the nominal max, if a store is set
+func (e *LPP) saveToStore() {
+	e.storeMux.Lock()
+	store := e.store
+	restoring := e.storeRestoring
+	endTime := e.limitEndTime
+	e.storeMux.Unlock()
+
+	if store == nil || restoring {
+		return
+	}
+
+	data := ucapi.LimitStoreData{}
+
+	if limit, err := e.ProductionLimit(); err == nil {
+		data.LimitValue = limit.Value
+		data.LimitActive = limit.IsActive
+		if limit.IsActive {
+			data.LimitEndTime = endTime
+		}
+	}
+
+	if value, _, err := e.FailsafeProductionActivePowerLimit(); err == nil {
+		data.FailsafeLimit = value
+	}
+
+	if duration, _, err := e.FailsafeDurationMinimum(); err == nil {
+		data.FailsafeDuration = duration
+	}
+
+	if value, err := e.ProductionNominalMax(); err == nil {
+		data.NominalMax = value
+	}
+
+	if err := store.Save(data); err != nil {
+		logging.Log().Debug("LPP saveToStore: error saving data", err)
+	}
+}
+
+// restore the stored limit, failsafe values and nominal max into the local server features
+//
+// does nothing if no store is set or the features are not yet added
+func (e *LPP) restoreFromStore() {
+	e.storeMux.Lock()
+	store := e.store
+	e.storeMux.Unlock()
+
+	if store == nil {
+		return
+	}
+
+	if _, _, err := e.loadControlServerAndLimitId(); err != nil {
+		return
+	}
+
+	data, err := store.Load()
+	if err != nil {
+		logging.Log().Debug("LPP restoreFromStore: error loading data", err)
+		return
+	}
+	if data == nil {
+		return
+	}
+
+	e.storeMux.Lock()
+	e.storeRestoring = true
+	e.storeMux.Unlock()
+
+	defer func() {
+		e.storeMux.Lock()
+		e.storeRestoring = false
+		e.storeMux.Unlock()
+	}()
+
+	// zero values are the defaults of the server features and not restored,
+	// so values the application set before the store was attached are kept
+	if data.LimitActive || data.LimitValue != 0 {
+		e.restoreLimit(data)
+	}
+
+	if data.FailsafeLimit != 0 {
+		_, changeable, _ := e.FailsafeProductionActivePowerLimit()
+		if err := e.SetFailsafeProductionActivePowerLimit(data.FailsafeLimit, changeable); err != nil {
+			logging.Log().Debug("LPP restoreFromStore: error restoring failsafe limit", err)
+		}
+	}
+
+	// a duration outside of the allowed range is never stored by a successful write
+	if data.FailsafeDuration > 0 {
+		_, changeable, _ := e.FailsafeDurationMinimum()
+		if err := e.SetFailsafeDurationMinimum(data.FailsafeDuration, changeable); err != nil {
+			logging.Log().Debug("LPP restoreFromStore: error restoring failsafe duration", err)
+		}
+	}
+
+	if data.NominalMax != 0 {
+		if err := e.SetProductionNominalMax(data.NominalMax); err != nil {
+			logging.Log().Debug("LPP restoreFromStore: error restoring nominal max", err)
+		}
+	}
+}
+
+// restore the stored limit
+func (e *LPP) restoreLimit(data *ucapi.LimitStoreData) {
+	// the changeable flags are defined by the application and not stored
+	limit, _ := e.ProductionLimit()
+	newLimit := ucapi.LoadLimit{
+		IsChangeable: limit.IsChangeable,
+		IsActive:     data.LimitActive,
+		Value:        data.LimitValue,
+	}
+	if newLimit.IsActive && data.LimitEndTime != nil {
+		// the limit may have expired while the device was offline
+		newLimit.Duration = data.LimitEndTime.Sub(e.stateMachine.Now()).Round(time.Second)
+		if newLimit.Duration <= 0 {
+			newLimit.Duration = 0
+			newLimit.IsActive = false
+		}
+	}
+	if err := e.SetProductionLimit(newLimit); err != nil {
+		logging.Log().Debug("LPP restoreFromStore: error restoring limit", err)
+	} else if newLimit.IsActive && data.LimitEndTime != nil {
+		// keep the stored end time, the restored duration is rounded
+		e.setLimitEndTime(data.LimitEndTime)
+	}
+}
+
 func (e *LPP) approveOrDenyProductionLimit(msg *spineapi.Message, approve bool, reason string) {
 	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
 
@@ -117,6 +405,31 @@ func (e *LPP) approveOrDenyProductionLimit(msg *spineapi.Message, approve bool,
 	f.ApproveOrDenyWrite(msg, result)
 }
 
+// the application did not approve or deny a pending write in time,
+// so apply the configured policy
+func (e *LPP) pendingLimitTimeout(msgCounter model.MsgCounterType) {
+	e.pendingMux.Lock()
+
+	msg, ok := e.pendingLimits[msgCounter]
+	if !ok {
+		// the application approved or denied it in the meantime
+		e.pendingMux.Unlock()
+		return
+	}
+
+	approve := e.approvalPolicy == ucapi.WriteApprovalPolicyTypeApprove
+	e.approveOrDenyProductionLimit(msg, approve, e.approvalReason)
+
+	delete(e.pendingLimits, msgCounter)
+	delete(e.pendingTimers, msgCounter)
+
+	e.pendingMux.Unlock()
+
+	if e.EventCB != nil {
+		e.EventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, WriteApprovalTimeout)
+	}
+}
+
 // callback invoked on incoming write messages to this
 // loadcontrol server feature.
 // the implementation only considers write messages for this use case and
@@ -153,7 +466,13 @@ func (e *LPP) loadControlWriteCB(msg *spineapi.Message) {
 		}
 
 		if _, ok := e.pendingLimits[*msg.RequestHeader.MsgCounter]; !ok {
-			e.pendingLimits[*msg.RequestHeader.MsgCounter] = msg
+			msgCounter := *msg.RequestHeader.MsgCounter
+			e.pendingLimits[msgCounter] = msg
+			if e.approvalTimeout > 0 {
+				e.pendingTimers[msgCounter] = time.AfterFunc(e.approvalTimeout, func() {
+					e.pendingLimitTimeout(msgCounter)
+				})
+			}
 			e.pendingMux.Unlock()
 			e.EventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, WriteApprovalRequired)
 			return
@@ -273,4 +592,6 @@ func (e *LPP) AddFeatures() {
 		}
 		_, _ = ec.AddCharacteristic(newCharData)
 	}
+
+	e.restoreFromStore()
 }
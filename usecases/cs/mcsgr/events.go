@@ -0,0 +1,39 @@
+package mcsgr
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *MCSGR) HandleEvent(payload spineapi.EventPayload) {
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate ||
+		payload.CmdClassifier == nil ||
+		*payload.CmdClassifier != model.CmdClassifierTypeWrite {
+		return
+	}
+
+	switch payload.Data.(type) {
+	case *model.HvacOverrunListDataType:
+		serverF := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+
+		if payload.Function != model.FunctionTypeHvacOverrunListData ||
+			payload.LocalFeature != serverF {
+			return
+		}
+
+		e.overrunDataUpdate(payload)
+	}
+}
+
+// the SG-Ready state was written by the CEM
+func (e *MCSGR) overrunDataUpdate(payload spineapi.EventPayload) {
+	if e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateSGReadyState)
+	}
+}
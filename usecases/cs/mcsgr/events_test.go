@@ -0,0 +1,40 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CsMCSGRSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity:    s.mockRemoteEntity,
+		EventType: spineapi.EventTypeSubscriptionChange,
+	}
+	s.sut.HandleEvent(payload)
+
+	payload.Device = s.monitoredEntity.Device()
+	payload.Entity = s.monitoredEntity
+	s.sut.HandleEvent(payload)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.CmdClassifier = util.Ptr(model.CmdClassifierTypeWrite)
+	s.sut.HandleEvent(payload)
+
+	payload.Function = model.FunctionTypeHvacOverrunListData
+	payload.Data = util.Ptr(model.HvacOverrunListDataType{})
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 0, len(s.receivedEvents()))
+
+	payload.LocalFeature = s.hvacFeature
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), []api.EventType{DataUpdateSGReadyState}, s.receivedEvents())
+
+	payload.Function = model.FunctionTypeHvacOverrunDescriptionListData
+	payload.Data = util.Ptr(model.HvacOverrunDescriptionListDataType{})
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 1, len(s.receivedEvents()))
+}
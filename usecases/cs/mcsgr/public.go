@@ -0,0 +1,105 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// return the current SG-Ready state
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such data is (yet) available
+//   - and others
+func (e *MCSGR) SGReadyState() (ucapi.SGReadyStateType, error) {
+	hvac, descriptions, err := e.hvacServerAndDescriptions()
+	if err != nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	data, err := hvac.GetOverrunDataForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	return internal.SGReadyState(descriptions, data)
+}
+
+// set the current SG-Ready state
+//
+// parameters:
+//   - state: the SG-Ready state to be set
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such data is (yet) available
+//   - ErrNotSupported if the state is not supported
+//   - and others
+func (e *MCSGR) SetSGReadyState(state ucapi.SGReadyStateType) error {
+	hvac, descriptions, err := e.hvacServerAndDescriptions()
+	if err != nil {
+		return api.ErrDataNotAvailable
+	}
+
+	overrunData, err := internal.SGReadyOverrunData(descriptions, state)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range overrunData {
+		data := model.HvacOverrunDataType{
+			OverrunStatus: item.OverrunStatus,
+		}
+		if err := hvac.UpdateOverrunDataForId(data, nil, *item.OverrunId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Scenario 2
+
+// return the currently pending incoming SG-Ready state write requests
+func (e *MCSGR) PendingSGReadyStates() map[model.MsgCounterType]ucapi.SGReadyStateType {
+	result := make(map[model.MsgCounterType]ucapi.SGReadyStateType)
+
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	for key, msg := range e.pendingStates {
+		state, _, err := e.requestedState(msg, false)
+		if err != nil {
+			continue
+		}
+
+		result[key] = state
+	}
+
+	return result
+}
+
+// accept or deny an incoming SG-Ready state
+//
+// parameters:
+//   - msgCounter: the message counter of the pending request
+//   - approve: if the write SG-Ready state for the msgCounter should be approved or not
+//   - reason: the reason if it is denied
+//
+// use PendingSGReadyStates to get the list of currently pending requests
+func (e *MCSGR) ApproveOrDenySGReadyState(msgCounter model.MsgCounterType, approve bool, reason string) {
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	msg, ok := e.pendingStates[msgCounter]
+	if !ok {
+		// no pending state for this msgCounter, this is a caller error
+		return
+	}
+
+	e.approveOrDenySGReadyState(msg, approve, reason)
+
+	delete(e.pendingStates, msgCounter)
+}
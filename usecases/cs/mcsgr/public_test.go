@@ -0,0 +1,59 @@
+package mcsgr
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CsMCSGRSuite) Test_SGReadyState() {
+	state, err := s.sut.SGReadyState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeNormal, state)
+
+	err = s.sut.SetSGReadyState(ucapi.SGReadyStateType(5))
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetSGReadyState(ucapi.SGReadyStateTypeForcedIncrease)
+	assert.Nil(s.T(), err)
+
+	state, err = s.sut.SGReadyState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeForcedIncrease, state)
+
+	err = s.sut.SetSGReadyState(ucapi.SGReadyStateTypeBlocked)
+	assert.Nil(s.T(), err)
+
+	state, err = s.sut.SGReadyState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeBlocked, state)
+
+	s.sut.LocalEntity = nil
+	_, err = s.sut.SGReadyState()
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetSGReadyState(ucapi.SGReadyStateTypeNormal)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *CsMCSGRSuite) Test_PendingSGReadyStates() {
+	data := s.sut.PendingSGReadyStates()
+	assert.Equal(s.T(), 0, len(data))
+
+	msgCounter := model.MsgCounterType(500)
+	s.sut.hvacWriteCB(s.writeMessage(msgCounter, 2))
+
+	data = s.sut.PendingSGReadyStates()
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeForcedIncrease, data[msgCounter])
+
+	s.sut.ApproveOrDenySGReadyState(model.MsgCounterType(499), true, "")
+
+	data = s.sut.PendingSGReadyStates()
+	assert.Equal(s.T(), 1, len(data))
+
+	s.sut.ApproveOrDenySGReadyState(msgCounter, false, "leave me alone")
+
+	data = s.sut.PendingSGReadyStates()
+	assert.Equal(s.T(), 0, len(data))
+}
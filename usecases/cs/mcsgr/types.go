@@ -0,0 +1,25 @@
+package mcsgr
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "cs-mcsgr-UseCaseSupportUpdate"
+
+	// The SG-Ready state was changed by the CEM
+	//
+	// Use `SGReadyState` to get the current data
+	//
+	// Use Case MCSGR, Scenario 2
+	DataUpdateSGReadyState api.EventType = "cs-mcsgr-DataUpdateSGReadyState"
+
+	// An incoming SG-Ready state needs to be approved or denied
+	//
+	// Use `PendingSGReadyStates` to get the currently pending write approval requests
+	// and invoke `ApproveOrDenySGReadyState` for each
+	//
+	// Use Case MCSGR, Scenario 2
+	WriteApprovalRequired api.EventType = "cs-mcsgr-WriteApprovalRequired"
+)
@@ -0,0 +1,257 @@
+package mcsgr
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+type MCSGR struct {
+	*usecase.UseCaseBase
+
+	pendingMux    sync.Mutex
+	pendingStates map[model.MsgCounterType]*spineapi.Message
+}
+
+var _ ucapi.CsMCSGRInterface = (*MCSGR)(nil)
+
+// Create a new Controllable System MCSGR use case
+//
+// The SG-Ready conditions 1, 3 and 4 are provided as HVAC overruns, condition 2
+// is represented by none of them being active. SG-Ready states written by the CEM
+// have to be approved or denied by the application, see `PendingSGReadyStates`.
+func NewMCSGR(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *MCSGR {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeControllableSystem,
+		model.UseCaseNameTypeMonitoringAndControlOfSmartGridReadyConditions,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &MCSGR{
+		UseCaseBase:   usecase,
+		pendingStates: make(map[model.MsgCounterType]*spineapi.Message),
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+// return the HVAC server feature and the overrun descriptions
+func (e *MCSGR) hvacServerAndDescriptions() (*server.Hvac, []model.HvacOverrunDescriptionDataType, error) {
+	hvac, err := server.NewHvac(e.LocalEntity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	descriptions, err := hvac.GetOverrunDescriptionsForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hvac, descriptions, nil
+}
+
+// return the SG-Ready state requested by a write message
+//
+// the written overrun data is merged into the current data,
+// only one SG-Ready overrun may be active afterwards
+//
+// if checkChangeable is true, an error is returned if the status
+// of an overrun, which is not changeable, would be changed
+//
+// returns false, if the message does not contain any SG-Ready overrun
+func (e *MCSGR) requestedState(msg *spineapi.Message, checkChangeable bool) (ucapi.SGReadyStateType, bool, error) {
+	hvac, descriptions, err := e.hvacServerAndDescriptions()
+	if err != nil {
+		return 0, false, err
+	}
+
+	data, err := hvac.GetOverrunDataForFilter(model.HvacOverrunDescriptionDataType{})
+	if err != nil {
+		return 0, false, err
+	}
+	// copy the data, as it is merged with the written data
+	current := slices.Clone(data)
+
+	found := false
+	for _, item := range msg.Cmd.HvacOverrunListData.HvacOverrunData {
+		if item.OverrunId == nil {
+			continue
+		}
+
+		for _, desc := range descriptions {
+			if !internal.IsSGReadyOverrun(desc) || *desc.OverrunId != *item.OverrunId {
+				continue
+			}
+
+			found = true
+
+			for i := range current {
+				if current[i].OverrunId == nil || *current[i].OverrunId != *item.OverrunId ||
+					item.OverrunStatus == nil {
+					continue
+				}
+
+				changed := current[i].OverrunStatus == nil || *current[i].OverrunStatus != *item.OverrunStatus
+				if checkChangeable && changed &&
+					(current[i].IsOverrunStatusChangeable == nil || !*current[i].IsOverrunStatusChangeable) {
+					return 0, true, errors.New("the SG-Ready state is not changeable")
+				}
+
+				current[i].OverrunStatus = util.Ptr(*item.OverrunStatus)
+			}
+		}
+	}
+
+	if !found {
+		return 0, false, nil
+	}
+
+	active := 0
+	for _, desc := range descriptions {
+		if !internal.IsSGReadyOverrun(desc) {
+			continue
+		}
+
+		for _, item := range current {
+			if item.OverrunId != nil && *item.OverrunId == *desc.OverrunId &&
+				item.OverrunStatus != nil &&
+				(*item.OverrunStatus == model.HvacOverrunStatusTypeActive ||
+					*item.OverrunStatus == model.HvacOverrunStatusTypeRunning) {
+				active++
+			}
+		}
+	}
+	if active > 1 {
+		return 0, true, errors.New("only one SG-Ready condition can be active")
+	}
+
+	state, err := internal.SGReadyState(descriptions, current)
+	return state, true, err
+}
+
+func (e *MCSGR) approveOrDenySGReadyState(msg *spineapi.Message, approve bool, reason string) {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if !approve {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(reason))
+	}
+	f.ApproveOrDenyWrite(msg, result)
+}
+
+// callback invoked on incoming write messages to this
+// hvac server feature.
+// the implementation only considers write messages for this use case and
+// approves all others
+func (e *MCSGR) hvacWriteCB(msg *spineapi.Message) {
+	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil ||
+		msg.Cmd.HvacOverrunListData == nil {
+		logging.Log().Debug("MCSGR hvacWriteCB: invalid message")
+		return
+	}
+
+	_, isSGReady, err := e.requestedState(msg, true)
+	if !isSGReady {
+		// approve, because this is no request for this usecase
+		e.approveOrDenySGReadyState(msg, true, "")
+		return
+	}
+
+	if err != nil {
+		e.approveOrDenySGReadyState(msg, false, err.Error())
+		return
+	}
+
+	e.pendingMux.Lock()
+	if _, ok := e.pendingStates[*msg.RequestHeader.MsgCounter]; ok {
+		e.pendingMux.Unlock()
+		return
+	}
+	e.pendingStates[*msg.RequestHeader.MsgCounter] = msg
+	e.pendingMux.Unlock()
+
+	if e.EventCB != nil {
+		e.EventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, WriteApprovalRequired)
+	}
+}
+
+func (e *MCSGR) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeHvac, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeHvacOverrunDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeHvacOverrunListData, true, true)
+	_ = f.AddWriteApprovalCallback(e.hvacWriteCB)
+
+	hvac, err := server.NewHvac(e.LocalEntity)
+	if err != nil {
+		return
+	}
+
+	overrunTypes := []model.HvacOverrunTypeType{
+		model.HvacOverrunTypeTypeSgReadyCondition1,
+		model.HvacOverrunTypeTypeSgReadyCondition3,
+		model.HvacOverrunTypeTypeSgReadyCondition4,
+	}
+	for _, overrunType := range overrunTypes {
+		// only add if it doesn't exist yet
+		filter := model.HvacOverrunDescriptionDataType{
+			OverrunType: util.Ptr(overrunType),
+		}
+		if data, err := hvac.GetOverrunDescriptionsForFilter(filter); err == nil && len(data) > 0 {
+			continue
+		}
+
+		overrunId := hvac.AddOverrunDescription(filter)
+		if overrunId == nil {
+			logging.Log().Debug("MCSGR AddFeatures: error adding overrun description")
+			continue
+		}
+
+		data := model.HvacOverrunDataType{
+			OverrunStatus:             util.Ptr(model.HvacOverrunStatusTypeInactive),
+			IsOverrunStatusChangeable: util.Ptr(true),
+		}
+		if err := hvac.UpdateOverrunDataForId(data, nil, *overrunId); err != nil {
+			logging.Log().Debug("MCSGR AddFeatures: error setting overrun data", err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package mcsgr
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CsMCSGRSuite) Test_AddFeatures() {
+	// adding the features again must not add the descriptions twice
+	s.sut.AddFeatures()
+
+	_, descriptions, err := s.sut.hvacServerAndDescriptions()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(descriptions))
+
+	s.sut.LocalEntity = nil
+	_, _, err = s.sut.hvacServerAndDescriptions()
+	assert.NotNil(s.T(), err)
+}
+
+func (s *CsMCSGRSuite) Test_hvacWriteCB() {
+	msg0 := &spineapi.Message{}
+
+	s.sut.hvacWriteCB(msg0)
+	assert.Equal(s.T(), 0, len(s.receivedEvents()))
+
+	// no SG-Ready overrun, gets approved
+	msg1 := &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: util.Ptr(model.MsgCounterType(500)),
+		},
+		Cmd: model.CmdType{
+			HvacOverrunListData: &model.HvacOverrunListDataType{
+				HvacOverrunData: []model.HvacOverrunDataType{
+					{
+						OverrunId:     util.Ptr(model.HvacOverrunIdType(10)),
+						OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeActive),
+					},
+				},
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.monitoredEntity,
+	}
+
+	s.sut.hvacWriteCB(msg1)
+	assert.Equal(s.T(), 0, len(s.receivedEvents()))
+	assert.Equal(s.T(), 0, len(s.sut.PendingSGReadyStates()))
+
+	// multiple active SG-Ready overruns, gets denied
+	msg2 := s.writeMessage(501, 0)
+	msg2.Cmd.HvacOverrunListData.HvacOverrunData[1].OverrunStatus = util.Ptr(model.HvacOverrunStatusTypeActive)
+
+	s.sut.hvacWriteCB(msg2)
+	assert.Equal(s.T(), 0, len(s.receivedEvents()))
+	assert.Equal(s.T(), 0, len(s.sut.PendingSGReadyStates()))
+
+	msg3 := s.writeMessage(502, 1)
+
+	s.sut.hvacWriteCB(msg3)
+	assert.Equal(s.T(), []api.EventType{WriteApprovalRequired}, s.receivedEvents())
+	assert.Equal(s.T(), 1, len(s.sut.PendingSGReadyStates()))
+
+	// the same message is only handled once
+	s.sut.hvacWriteCB(msg3)
+	assert.Equal(s.T(), 1, len(s.receivedEvents()))
+
+	// not changeable SG-Ready overrun, gets denied
+	hvac, _, err := s.sut.hvacServerAndDescriptions()
+	assert.Nil(s.T(), err)
+	err = hvac.UpdateOverrunDataForId(model.HvacOverrunDataType{
+		IsOverrunStatusChangeable: util.Ptr(false),
+	}, nil, model.HvacOverrunIdType(2))
+	assert.Nil(s.T(), err)
+
+	msg4 := s.writeMessage(503, 2)
+
+	s.sut.hvacWriteCB(msg4)
+	assert.Equal(s.T(), 1, len(s.receivedEvents()))
+	assert.Equal(s.T(), 1, len(s.sut.PendingSGReadyStates()))
+}
+
+func (s *CsMCSGRSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
@@ -0,0 +1,54 @@
+package cevc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *CEVC) HandleEvent(payload spineapi.EventPayload) {
+	// only about written data of the time series and incentive table servers of this entity
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate ||
+		payload.CmdClassifier == nil ||
+		*payload.CmdClassifier != model.CmdClassifierTypeWrite {
+		return
+	}
+
+	timeSeriesF := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	incentiveTableF := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+
+	var event api.EventType
+	switch payload.Data.(type) {
+	case *model.TimeSeriesListDataType:
+		if payload.LocalFeature != timeSeriesF {
+			return
+		}
+		event = DataUpdatePowerLimits
+
+	case *model.IncentiveTableDescriptionDataType:
+		if payload.LocalFeature != incentiveTableF {
+			return
+		}
+		event = DataUpdateIncentiveTableDescriptions
+
+	case *model.IncentiveTableDataType:
+		if payload.LocalFeature != incentiveTableF {
+			return
+		}
+		event = DataUpdateIncentives
+
+	default:
+		return
+	}
+
+	if e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, event)
+	}
+}
@@ -0,0 +1,43 @@
+package cevc
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvCEVCSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.cemEntity,
+	}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.CmdClassifier = util.Ptr(model.CmdClassifierTypeWrite)
+	payload.Data = &model.TimeSeriesListDataType{}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	payload.LocalFeature = s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	s.sut.HandleEvent(payload)
+	assert.True(s.T(), s.isEventCalled(DataUpdatePowerLimits))
+
+	payload.Data = &model.IncentiveTableDescriptionDataType{}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.isEventCalled(DataUpdateIncentiveTableDescriptions))
+
+	payload.LocalFeature = s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	s.sut.HandleEvent(payload)
+	assert.True(s.T(), s.isEventCalled(DataUpdateIncentiveTableDescriptions))
+
+	payload.Data = &model.IncentiveTableDataType{}
+	s.sut.HandleEvent(payload)
+	assert.True(s.T(), s.isEventCalled(DataUpdateIncentives))
+
+	payload.Data = &model.IncentiveTableConstraintsDataType{}
+	s.sut.HandleEvent(payload)
+	assert.Equal(s.T(), 3, len(s.eventsCalled))
+}
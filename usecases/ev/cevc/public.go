@@ -0,0 +1,334 @@
+package cevc
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+// remove the demand, the plan and all received data,
+// invoked once the EV is disconnected
+func (e *CEVC) ResetEVData() {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.resetData()
+}
+
+// update the data of a local time series
+func (e *CEVC) updateTimeSeriesData(data model.TimeSeriesDataType) error {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	listData := &model.TimeSeriesListDataType{
+		TimeSeriesData: []model.TimeSeriesDataType{data},
+	}
+	if err := f.UpdateData(model.FunctionTypeTimeSeriesListData, listData, model.NewFilterTypePartial(), nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// Scenario 1
+
+// set the current energy demand of the EV
+//
+//   - demand: details about the actual demand of the EV,
+//     if DurationUntilEnd is 0, direct charging is active, otherwise timed charging
+func (e *CEVC) SetEnergyDemand(demand ucapi.Demand) error {
+	slot := model.TimeSeriesSlotType{
+		TimeSeriesSlotId: util.Ptr(model.TimeSeriesSlotIdType(0)),
+		Value:            model.NewScaledNumberType(demand.OptDemand),
+		MinValue:         model.NewScaledNumberType(demand.MinDemand),
+		MaxValue:         model.NewScaledNumberType(demand.MaxDemand),
+	}
+	if demand.DurationUntilEnd > 0 {
+		slot.Duration = model.NewDurationType(time.Duration(demand.DurationUntilEnd * float64(time.Second)))
+	}
+
+	data := model.TimeSeriesDataType{
+		TimeSeriesId: util.Ptr(singleDemandTimeSeriesId),
+		TimePeriod: &model.TimePeriodType{
+			StartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(
+				time.Duration(demand.DurationUntilStart * float64(time.Second))),
+		},
+		TimeSeriesSlot: []model.TimeSeriesSlotType{slot},
+	}
+
+	return e.updateTimeSeriesData(data)
+}
+
+// Scenario 2
+
+// set the constraints the power limits of the CEM have to fullfill
+//
+// incoming power limits not matching these constraints are denied
+//
+//   - constraints: the time slot constraints
+func (e *CEVC) SetTimeSlotConstraints(constraints ucapi.TimeSlotConstraints) error {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	item := model.TimeSeriesConstraintsDataType{
+		TimeSeriesId: util.Ptr(constraintsTimeSeriesId),
+	}
+	if constraints.MinSlots > 0 {
+		item.SlotCountMin = util.Ptr(model.TimeSeriesSlotCountType(constraints.MinSlots))
+	}
+	if constraints.MaxSlots > 0 {
+		item.SlotCountMax = util.Ptr(model.TimeSeriesSlotCountType(constraints.MaxSlots))
+	}
+	if constraints.MinSlotDuration > 0 {
+		item.SlotDurationMin = model.NewDurationType(constraints.MinSlotDuration)
+	}
+	if constraints.MaxSlotDuration > 0 {
+		item.SlotDurationMax = model.NewDurationType(constraints.MaxSlotDuration)
+	}
+	if constraints.SlotDurationStepSize > 0 {
+		item.SlotDurationStepSize = model.NewDurationType(constraints.SlotDurationStepSize)
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// replace all data, so previously set constraints are removed
+	f.SetData(model.FunctionTypeTimeSeriesConstraintsListData, &model.TimeSeriesConstraintsListDataType{
+		TimeSeriesConstraintsData: []model.TimeSeriesConstraintsDataType{item},
+	})
+
+	return nil
+}
+
+// request new power limits from the CEM
+//
+//   - required: if true, the CEM is requested to send new power limits
+func (e *CEVC) SetPowerLimitsUpdateRequired(required bool) error {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	data := &model.TimeSeriesDescriptionListDataType{
+		TimeSeriesDescriptionData: []model.TimeSeriesDescriptionDataType{
+			{
+				TimeSeriesId:   util.Ptr(constraintsTimeSeriesId),
+				UpdateRequired: util.Ptr(required),
+			},
+		},
+	}
+	if err := f.UpdateData(model.FunctionTypeTimeSeriesDescriptionListData, data, model.NewFilterTypePartial(), nil); err != nil {
+		return errors.New(err.String())
+	}
+
+	return nil
+}
+
+// return the power limits received from the CEM
+func (e *CEVC) PowerLimits() ([]ucapi.DurationSlotValue, error) {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesListDataType](f, model.FunctionTypeTimeSeriesListData)
+	if err != nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	for _, item := range data.TimeSeriesData {
+		if item.TimeSeriesId == nil || *item.TimeSeriesId != constraintsTimeSeriesId {
+			continue
+		}
+
+		slots, err := timeSeriesSlotValues(item)
+		if err != nil || len(slots) == 0 {
+			return nil, api.ErrDataNotAvailable
+		}
+
+		return slots, nil
+	}
+
+	return nil, api.ErrDataNotAvailable
+}
+
+// Scenario 3
+
+// set the constraints the incentives of the CEM have to fullfill
+//
+// incoming incentives not matching these constraints are denied
+//
+//   - constraints: the incentive slot constraints
+func (e *CEVC) SetIncentiveConstraints(constraints ucapi.IncentiveSlotConstraints) error {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	slotConstraints := &model.TimeTableConstraintsDataType{}
+	if constraints.MinSlots > 0 {
+		slotConstraints.SlotCountMin = util.Ptr(model.TimeSlotCountType(constraints.MinSlots))
+	}
+	if constraints.MaxSlots > 0 {
+		slotConstraints.SlotCountMax = util.Ptr(model.TimeSlotCountType(constraints.MaxSlots))
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	f.SetData(model.FunctionTypeIncentiveTableConstraintsData, &model.IncentiveTableConstraintsDataType{
+		IncentiveTableConstraints: []model.IncentiveTableConstraintsType{
+			{
+				Tariff: &model.TariffDataType{
+					TariffId: util.Ptr(tariffId),
+				},
+				TariffConstraints: &model.TariffOverallConstraintsDataType{
+					MaxTiersPerTariff:    util.Ptr(model.TierCountType(maxTiersPerTariff)),
+					MaxIncentivesPerTier: util.Ptr(model.IncentiveCountType(maxIncentivesPerTier)),
+				},
+				IncentiveSlotConstraints: slotConstraints,
+			},
+		},
+	})
+
+	return nil
+}
+
+// request new incentive table descriptions from the CEM
+//
+//   - required: if true, the CEM is requested to send new incentive table descriptions
+func (e *CEVC) SetIncentivesUpdateRequired(required bool) error {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return api.ErrDataNotAvailable
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableDescriptionDataType](
+		f, model.FunctionTypeIncentiveTableDescriptionData)
+	if err != nil {
+		return api.ErrDataNotAvailable
+	}
+
+	// the incentive table descriptions do not support partial updates,
+	// and the copy still references the current data
+	descriptions := &model.IncentiveTableDescriptionDataType{}
+	for _, item := range data.IncentiveTableDescription {
+		if item.TariffDescription != nil {
+			tariffDescription := *item.TariffDescription
+			tariffDescription.UpdateRequired = util.Ptr(required)
+			item.TariffDescription = &tariffDescription
+		}
+		descriptions.IncentiveTableDescription = append(descriptions.IncentiveTableDescription, item)
+	}
+
+	f.SetData(model.FunctionTypeIncentiveTableDescriptionData, descriptions)
+
+	return nil
+}
+
+// return the incentive table descriptions received from the CEM
+func (e *CEVC) IncentiveTableDescriptions() ([]ucapi.IncentiveTariffDescription, error) {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableDescriptionDataType](
+		f, model.FunctionTypeIncentiveTableDescriptionData)
+	if err != nil || len(data.IncentiveTableDescription) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return internal.IncentiveTariffDescriptions(data.IncentiveTableDescription), nil
+}
+
+// return the incentives received from the CEM
+//
+// the value of each slot is the first incentive of the first tier
+func (e *CEVC) Incentives() ([]ucapi.DurationSlotValue, error) {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableDataType](f, model.FunctionTypeIncentiveTableData)
+	if err != nil {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	for _, item := range data.IncentiveTable {
+		if item.Tariff == nil || item.Tariff.TariffId == nil || *item.Tariff.TariffId != tariffId {
+			continue
+		}
+
+		slots, err := incentiveSlotValues(item)
+		if err != nil || len(slots) == 0 {
+			return nil, api.ErrDataNotAvailable
+		}
+
+		return slots, nil
+	}
+
+	return nil, api.ErrDataNotAvailable
+}
+
+// Scenario 4
+
+// set the charge plan of the EV
+//
+//   - plan: the planned power of each slot
+func (e *CEVC) SetChargePlan(plan ucapi.ChargePlan) error {
+	if len(plan.Slots) == 0 {
+		return api.ErrMissingData
+	}
+
+	// the plan starts relative to now, which is the earliest possible start
+	start := time.Until(plan.Slots[0].Start)
+	if start < 0 {
+		start = 0
+	}
+
+	var slots []model.TimeSeriesSlotType
+	for index, slot := range plan.Slots {
+		if !slot.End.After(slot.Start) {
+			return errors.New("slot ends before it starts")
+		}
+
+		slots = append(slots, model.TimeSeriesSlotType{
+			TimeSeriesSlotId: util.Ptr(model.TimeSeriesSlotIdType(index)),
+			Duration:         model.NewDurationType(slot.End.Sub(slot.Start)),
+			Value:            model.NewScaledNumberType(slot.Value),
+			MinValue:         model.NewScaledNumberType(slot.MinValue),
+			MaxValue:         model.NewScaledNumberType(slot.MaxValue),
+		})
+	}
+
+	data := model.TimeSeriesDataType{
+		TimeSeriesId: util.Ptr(planTimeSeriesId),
+		TimePeriod: &model.TimePeriodType{
+			StartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(start),
+		},
+		TimeSeriesSlot: slots,
+	}
+
+	return e.updateTimeSeriesData(data)
+}
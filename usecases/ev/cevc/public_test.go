@@ -0,0 +1,191 @@
+package cevc
+
+import (
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+// return the local data of a time series
+func (s *EvCEVCSuite) timeSeriesData(id model.TimeSeriesIdType) *model.TimeSeriesDataType {
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	data, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesListDataType](f, model.FunctionTypeTimeSeriesListData)
+	if err != nil {
+		return nil
+	}
+
+	for _, item := range data.TimeSeriesData {
+		if item.TimeSeriesId != nil && *item.TimeSeriesId == id {
+			return &item
+		}
+	}
+
+	return nil
+}
+
+func (s *EvCEVCSuite) Test_SetEnergyDemand() {
+	err := s.sut.SetEnergyDemand(ucapi.Demand{
+		MinDemand:          1000,
+		OptDemand:          20000,
+		MaxDemand:          40000,
+		DurationUntilStart: 0,
+		DurationUntilEnd:   7200,
+	})
+	assert.Nil(s.T(), err)
+
+	data := s.timeSeriesData(singleDemandTimeSeriesId)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), 1, len(data.TimeSeriesSlot))
+	assert.Equal(s.T(), 20000.0, data.TimeSeriesSlot[0].Value.GetValue())
+	duration, err := data.TimeSeriesSlot[0].Duration.GetTimeDuration()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2*time.Hour, duration)
+
+	// direct charging has no duration
+	err = s.sut.SetEnergyDemand(ucapi.Demand{
+		OptDemand: 20000,
+		MaxDemand: 40000,
+	})
+	assert.Nil(s.T(), err)
+
+	data = s.timeSeriesData(singleDemandTimeSeriesId)
+	assert.NotNil(s.T(), data)
+	assert.Nil(s.T(), data.TimeSeriesSlot[0].Duration)
+}
+
+func (s *EvCEVCSuite) Test_SetTimeSlotConstraints() {
+	err := s.sut.SetTimeSlotConstraints(ucapi.TimeSlotConstraints{
+		MinSlots:        1,
+		MaxSlots:        10,
+		MaxSlotDuration: time.Hour,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.TimeSlotConstraints{
+		MinSlots:        1,
+		MaxSlots:        10,
+		MaxSlotDuration: time.Hour,
+	}, s.sut.timeSlotConstraints())
+
+	// previous constraints are replaced
+	err = s.sut.SetTimeSlotConstraints(ucapi.TimeSlotConstraints{
+		MaxSlots: 5,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.TimeSlotConstraints{MaxSlots: 5}, s.sut.timeSlotConstraints())
+}
+
+func (s *EvCEVCSuite) Test_SetPowerLimitsUpdateRequired() {
+	err := s.sut.SetPowerLimitsUpdateRequired(true)
+	assert.Nil(s.T(), err)
+
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	descs, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesDescriptionListDataType](
+		f, model.FunctionTypeTimeSeriesDescriptionListData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(descs.TimeSeriesDescriptionData))
+	assert.True(s.T(), *descs.TimeSeriesDescriptionData[0].UpdateRequired)
+	assert.Equal(s.T(), model.TimeSeriesTypeTypeConstraints, *descs.TimeSeriesDescriptionData[0].TimeSeriesType)
+}
+
+func (s *EvCEVCSuite) Test_PowerLimits() {
+	_, err := s.sut.PowerLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	f.SetData(model.FunctionTypeTimeSeriesListData, &model.TimeSeriesListDataType{
+		TimeSeriesData: []model.TimeSeriesDataType{
+			powerLimitsData(constraintsTimeSeriesId, []time.Duration{time.Hour, time.Hour}, []float64{11000, 4000}),
+		},
+	})
+
+	limits, err := s.sut.PowerLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 11000},
+		{Duration: time.Hour, Value: 4000},
+	}, limits)
+
+	// the data is removed once the EV disconnects
+	s.evcc.EVDisconnected()
+
+	_, err = s.sut.PowerLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.NotNil(s.T(), s.timeSeriesData(constraintsTimeSeriesId))
+}
+
+func (s *EvCEVCSuite) Test_SetIncentiveConstraints() {
+	err := s.sut.SetIncentiveConstraints(ucapi.IncentiveSlotConstraints{
+		MinSlots: 1,
+		MaxSlots: 24,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.IncentiveSlotConstraints{
+		MinSlots: 1,
+		MaxSlots: 24,
+	}, s.sut.incentiveSlotConstraints())
+}
+
+func (s *EvCEVCSuite) Test_SetIncentivesUpdateRequired() {
+	err := s.sut.SetIncentivesUpdateRequired(true)
+	assert.Nil(s.T(), err)
+
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	descs, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableDescriptionDataType](
+		f, model.FunctionTypeIncentiveTableDescriptionData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descs.IncentiveTableDescription))
+	assert.True(s.T(), *descs.IncentiveTableDescription[0].TariffDescription.UpdateRequired)
+	assert.Equal(s.T(), 1, len(descs.IncentiveTableDescription[0].Tier))
+}
+
+func (s *EvCEVCSuite) Test_Incentives() {
+	_, err := s.sut.Incentives()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	f.SetData(model.FunctionTypeIncentiveTableData, &model.IncentiveTableDataType{
+		IncentiveTable: []model.IncentiveTableType{
+			incentivesData(tariffId, []time.Duration{time.Hour, 2 * time.Hour}, []float64{0.25, 0.5}),
+		},
+	})
+
+	incentives, err := s.sut.Incentives()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 0.25},
+		{Duration: 2 * time.Hour, Value: 0.5},
+	}, incentives)
+}
+
+func (s *EvCEVCSuite) Test_SetChargePlan() {
+	err := s.sut.SetChargePlan(ucapi.ChargePlan{})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	now := time.Now()
+	err = s.sut.SetChargePlan(ucapi.ChargePlan{
+		Slots: []ucapi.ChargePlanSlotValue{
+			{Start: now, End: now},
+		},
+	})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetChargePlan(ucapi.ChargePlan{
+		Slots: []ucapi.ChargePlanSlotValue{
+			{Start: now, End: now.Add(time.Hour), Value: 11000, MaxValue: 11000},
+			{Start: now.Add(time.Hour), End: now.Add(3 * time.Hour), Value: 4000, MaxValue: 11000},
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	data := s.timeSeriesData(planTimeSeriesId)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), 2, len(data.TimeSeriesSlot))
+	duration, err := data.TimeSeriesSlot[1].Duration.GetTimeDuration()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2*time.Hour, duration)
+	assert.Equal(s.T(), 4000.0, data.TimeSeriesSlot[1].Value.GetValue())
+}
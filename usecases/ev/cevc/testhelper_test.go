@@ -0,0 +1,187 @@
+package cevc
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	"github.com/enbility/eebus-go/usecases/ev/evcc"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const remoteSki string = "testremoteski"
+
+func TestEvCEVCSuite(t *testing.T) {
+	suite.Run(t, new(EvCEVCSuite))
+}
+
+type EvCEVCSuite struct {
+	suite.Suite
+
+	sut *CEVC
+
+	service api.ServiceInterface
+
+	evcc     *evcc.EVCC
+	evEntity spineapi.EntityLocalInterface
+
+	remoteDevice *spinemocks.DeviceRemoteInterface
+	cemEntity    *spinemocks.EntityRemoteInterface
+
+	mux          sync.Mutex
+	eventCalled  bool
+	eventsCalled []api.EventType
+}
+
+func (s *EvCEVCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.eventCalled = true
+	s.eventsCalled = append(s.eventsCalled, event)
+}
+
+func (s *EvCEVCSuite) BeforeTest(suiteName, testName string) {
+	s.eventCalled = false
+	s.eventsCalled = nil
+
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.remoteDevice = spinemocks.NewDeviceRemoteInterface(s.T())
+	s.remoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.cemEntity = spinemocks.NewEntityRemoteInterface(s.T())
+	s.cemEntity.EXPECT().Device().Return(s.remoteDevice).Maybe()
+	s.cemEntity.EXPECT().EntityType().Return(model.EntityTypeTypeCEM).Maybe()
+
+	evseEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+	s.evcc = evcc.NewEVCC(s.service, evseEntity, s.Event)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+	s.evEntity = s.evcc.LocalEntity
+
+	s.sut = NewCEVC(s.evEntity, s.Event)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+
+	s.evcc.AddEVDataUseCase(s.sut)
+	s.evcc.EVConnected()
+}
+
+// return if an event was invoked
+func (s *EvCEVCSuite) isEventCalled(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, item := range s.eventsCalled {
+		if item == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// return an incoming write message of the CEM
+func (s *EvCEVCSuite) writeMessage(msgCounter model.MsgCounterType, cmd model.CmdType) *spineapi.Message {
+	return &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: &msgCounter,
+		},
+		Cmd:          cmd,
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.cemEntity,
+	}
+}
+
+// return power limits as written by the CEM CEVC implementation
+func powerLimitsData(timeSeriesId model.TimeSeriesIdType, durations []time.Duration, values []float64) model.TimeSeriesDataType {
+	var slots []model.TimeSeriesSlotType
+	var total time.Duration
+	for index, duration := range durations {
+		slot := model.TimeSeriesSlotType{
+			TimeSeriesSlotId: util.Ptr(model.TimeSeriesSlotIdType(index)),
+			TimePeriod: &model.TimePeriodType{
+				StartTime: model.NewAbsoluteOrRelativeTimeTypeFromDuration(total),
+			},
+			MaxValue: model.NewScaledNumberType(values[index]),
+		}
+		total += duration
+		if index == len(durations)-1 {
+			slot.TimePeriod.EndTime = model.NewAbsoluteOrRelativeTimeTypeFromDuration(total)
+		}
+		slots = append(slots, slot)
+	}
+
+	return model.TimeSeriesDataType{
+		TimeSeriesId: util.Ptr(timeSeriesId),
+		TimePeriod: &model.TimePeriodType{
+			StartTime: model.NewAbsoluteOrRelativeTimeType("PT0S"),
+			EndTime:   model.NewAbsoluteOrRelativeTimeTypeFromDuration(total),
+		},
+		TimeSeriesSlot: slots,
+	}
+}
+
+// return incentives as written by the CEM CEVC implementation
+func incentivesData(tariff model.TariffIdType, durations []time.Duration, values []float64) model.IncentiveTableType {
+	var slots []model.IncentiveTableIncentiveSlotType
+	var total time.Duration
+	for index, duration := range durations {
+		interval := &model.TimeTableDataType{
+			StartTime: &model.AbsoluteOrRecurringTimeType{
+				Relative: model.NewDurationType(total),
+			},
+		}
+		total += duration
+		if index == len(durations)-1 {
+			interval.EndTime = &model.AbsoluteOrRecurringTimeType{
+				Relative: model.NewDurationType(total),
+			}
+		}
+		slots = append(slots, model.IncentiveTableIncentiveSlotType{
+			TimeInterval: interval,
+			Tier: []model.IncentiveTableTierType{
+				{
+					Tier: &model.TierDataType{
+						TierId: util.Ptr(model.TierIdType(0)),
+					},
+					Incentive: []model.IncentiveDataType{
+						{
+							IncentiveId: util.Ptr(model.IncentiveIdType(0)),
+							Value:       model.NewScaledNumberType(values[index]),
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return model.IncentiveTableType{
+		Tariff: &model.TariffDataType{
+			TariffId: util.Ptr(tariff),
+		},
+		IncentiveSlot: slots,
+	}
+}
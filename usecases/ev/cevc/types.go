@@ -0,0 +1,31 @@
+package cevc
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "ev-cevc-UseCaseSupportUpdate"
+
+	// Power limits received from the CEM
+	//
+	// Use `PowerLimits` to get the current data
+	//
+	// Use Case CEVC, Scenario 2
+	DataUpdatePowerLimits api.EventType = "ev-cevc-DataUpdatePowerLimits"
+
+	// Incentive table descriptions received from the CEM
+	//
+	// Use `IncentiveTableDescriptions` to get the current data
+	//
+	// Use Case CEVC, Scenario 3
+	DataUpdateIncentiveTableDescriptions api.EventType = "ev-cevc-DataUpdateIncentiveTableDescriptions"
+
+	// Incentives received from the CEM
+	//
+	// Use `Incentives` to get the current data
+	//
+	// Use Case CEVC, Scenario 3
+	DataUpdateIncentives api.EventType = "ev-cevc-DataUpdateIncentives"
+)
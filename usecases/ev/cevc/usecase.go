@@ -0,0 +1,260 @@
+package cevc
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+const (
+	// the time series the CEM writes the power limits to
+	constraintsTimeSeriesId = model.TimeSeriesIdType(0)
+	// the time series providing the charge plan of the EV
+	planTimeSeriesId = model.TimeSeriesIdType(1)
+	// the time series providing the energy demand of the EV
+	singleDemandTimeSeriesId = model.TimeSeriesIdType(2)
+
+	// the only supported tariff
+	tariffId = model.TariffIdType(0)
+)
+
+type CEVC struct {
+	*usecase.UseCaseBase
+
+	mux sync.Mutex
+}
+
+var _ ucapi.EvCEVCInterface = (*CEVC)(nil)
+
+// Create a new EV CEVC use case
+//
+// The use case has to be added to the EV entity provided by the EV EVCC use case,
+// and should be registered there via AddEVDataUseCase, so the demand, plans
+// and received data are reset once the EV is disconnected.
+//
+// Incoming power limits and incentives are validated against the constraints
+// set via SetTimeSlotConstraints and SetIncentiveConstraints, and
+// denied if they do not match.
+//
+// parameters:
+//   - localEntity: the local EV entity providing the time series and incentive table
+//   - eventCB: the callback for use case events
+func NewCEVC(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *CEVC {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeEnergyBroker}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario: model.UseCaseScenarioSupportType(1),
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(3),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(4),
+			Mandatory: true,
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(6),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(8),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeEV,
+		model.UseCaseNameTypeCoordinatedEVCharging,
+		"1.0.1",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &CEVC{
+		UseCaseBase: usecase,
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+// approve or deny a write message with the provided reason
+func (e *CEVC) approveOrDenyWrite(feature spineapi.FeatureLocalInterface, msg *spineapi.Message, err error) {
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if err != nil {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(err.Error()))
+	}
+	feature.ApproveOrDenyWrite(msg, result)
+}
+
+// callback invoked on incoming write messages to this
+// timeseries server feature.
+// the power limits are validated against the time slot constraints
+func (e *CEVC) timeSeriesWriteCB(msg *spineapi.Message) {
+	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil ||
+		msg.Cmd.TimeSeriesListData == nil {
+		logging.Log().Debug("CEVC timeSeriesWriteCB: invalid message")
+		return
+	}
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	e.approveOrDenyWrite(f, msg, e.validatePowerLimits(msg.Cmd.TimeSeriesListData))
+}
+
+// callback invoked on incoming write messages to this
+// incentivetable server feature.
+// the incentives are validated against the incentive constraints
+func (e *CEVC) incentiveTableWriteCB(msg *spineapi.Message) {
+	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil {
+		logging.Log().Debug("CEVC incentiveTableWriteCB: invalid message")
+		return
+	}
+
+	var err error
+	switch {
+	case msg.Cmd.IncentiveTableDescriptionData != nil:
+		err = e.validateIncentiveTableDescriptions(msg.Cmd.IncentiveTableDescriptionData)
+	case msg.Cmd.IncentiveTableData != nil:
+		err = e.validateIncentives(msg.Cmd.IncentiveTableData)
+	default:
+		logging.Log().Debug("CEVC incentiveTableWriteCB: invalid message")
+		return
+	}
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	e.approveOrDenyWrite(f, msg, err)
+}
+
+// set the initial descriptions and remove all other data
+//
+// the mutex has to be locked by the caller
+func (e *CEVC) resetData() {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f != nil {
+		f.SetData(model.FunctionTypeTimeSeriesDescriptionListData, &model.TimeSeriesDescriptionListDataType{
+			TimeSeriesDescriptionData: []model.TimeSeriesDescriptionDataType{
+				{
+					TimeSeriesId:        util.Ptr(constraintsTimeSeriesId),
+					TimeSeriesType:      util.Ptr(model.TimeSeriesTypeTypeConstraints),
+					TimeSeriesWriteable: util.Ptr(true),
+					UpdateRequired:      util.Ptr(false),
+					Unit:                util.Ptr(model.UnitOfMeasurementTypeW),
+				},
+				{
+					TimeSeriesId:        util.Ptr(planTimeSeriesId),
+					TimeSeriesType:      util.Ptr(model.TimeSeriesTypeTypePlan),
+					TimeSeriesWriteable: util.Ptr(false),
+					Unit:                util.Ptr(model.UnitOfMeasurementTypeW),
+				},
+				{
+					TimeSeriesId:        util.Ptr(singleDemandTimeSeriesId),
+					TimeSeriesType:      util.Ptr(model.TimeSeriesTypeTypeSingleDemand),
+					TimeSeriesWriteable: util.Ptr(false),
+					Unit:                util.Ptr(model.UnitOfMeasurementTypeWh),
+				},
+			},
+		})
+		f.SetData(model.FunctionTypeTimeSeriesConstraintsListData, &model.TimeSeriesConstraintsListDataType{})
+		// a remote write can only update existing items, so the constraints
+		// time series has to exist, even without any slots
+		f.SetData(model.FunctionTypeTimeSeriesListData, &model.TimeSeriesListDataType{
+			TimeSeriesData: []model.TimeSeriesDataType{
+				{
+					TimeSeriesId:   util.Ptr(constraintsTimeSeriesId),
+					TimeSeriesSlot: []model.TimeSeriesSlotType{},
+				},
+			},
+		})
+	}
+
+	f = e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f != nil {
+		// default tariff, which is the same the CEM CEVC implementation uses by default
+		f.SetData(model.FunctionTypeIncentiveTableDescriptionData, &model.IncentiveTableDescriptionDataType{
+			IncentiveTableDescription: []model.IncentiveTableDescriptionType{
+				{
+					TariffDescription: &model.TariffDescriptionDataType{
+						TariffId:        util.Ptr(tariffId),
+						TariffWriteable: util.Ptr(true),
+						UpdateRequired:  util.Ptr(false),
+						ScopeType:       util.Ptr(model.ScopeTypeTypeSimpleIncentiveTable),
+					},
+					Tier: []model.IncentiveTableDescriptionTierType{
+						{
+							TierDescription: &model.TierDescriptionDataType{
+								TierId:   util.Ptr(model.TierIdType(0)),
+								TierType: util.Ptr(model.TierTypeTypeDynamicCost),
+							},
+							BoundaryDescription: []model.TierBoundaryDescriptionDataType{
+								{
+									BoundaryId:   util.Ptr(model.TierBoundaryIdType(0)),
+									BoundaryType: util.Ptr(model.TierBoundaryTypeTypePowerBoundary),
+									BoundaryUnit: util.Ptr(model.UnitOfMeasurementTypeW),
+								},
+							},
+							IncentiveDescription: []model.IncentiveDescriptionDataType{
+								{
+									IncentiveId:   util.Ptr(model.IncentiveIdType(0)),
+									IncentiveType: util.Ptr(model.IncentiveTypeTypeAbsoluteCost),
+									Currency:      util.Ptr(model.CurrencyTypeEur),
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		f.SetData(model.FunctionTypeIncentiveTableConstraintsData, &model.IncentiveTableConstraintsDataType{})
+		f.SetData(model.FunctionTypeIncentiveTableData, &model.IncentiveTableDataType{})
+	}
+}
+
+func (e *CEVC) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeClient)
+
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesConstraintsListData, true, false)
+	f.AddFunctionType(model.FunctionTypeTimeSeriesListData, true, true)
+	_ = f.AddWriteApprovalCallback(e.timeSeriesWriteCB)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableDescriptionData, true, true)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableConstraintsData, true, false)
+	f.AddFunctionType(model.FunctionTypeIncentiveTableData, true, true)
+	_ = f.AddWriteApprovalCallback(e.incentiveTableWriteCB)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	e.resetData()
+}
@@ -0,0 +1,77 @@
+package cevc
+
+import (
+	"time"
+
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvCEVCSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvCEVCSuite) Test_AddFeatures() {
+	f := s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	assert.NotNil(s.T(), f)
+
+	descs, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesDescriptionListDataType](
+		f, model.FunctionTypeTimeSeriesDescriptionListData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(descs.TimeSeriesDescriptionData))
+	assert.Equal(s.T(), model.TimeSeriesTypeTypeConstraints, *descs.TimeSeriesDescriptionData[0].TimeSeriesType)
+	assert.True(s.T(), *descs.TimeSeriesDescriptionData[0].TimeSeriesWriteable)
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesListDataType](f, model.FunctionTypeTimeSeriesListData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data.TimeSeriesData))
+
+	f = s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	assert.NotNil(s.T(), f)
+
+	tariffs, err := s.sut.IncentiveTableDescriptions()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(tariffs))
+	assert.Equal(s.T(), 1, len(tariffs[0].Tiers))
+	assert.Equal(s.T(), model.CurrencyTypeEur, tariffs[0].Tiers[0].Incentives[0].Currency)
+}
+
+func (s *EvCEVCSuite) Test_timeSeriesWriteCB() {
+	msg0 := &spineapi.Message{}
+	s.sut.timeSeriesWriteCB(msg0)
+
+	msg1 := s.writeMessage(500, model.CmdType{
+		TimeSeriesListData: &model.TimeSeriesListDataType{
+			TimeSeriesData: []model.TimeSeriesDataType{
+				powerLimitsData(constraintsTimeSeriesId, []time.Duration{time.Hour, time.Hour}, []float64{11000, 4000}),
+			},
+		},
+	})
+	s.sut.timeSeriesWriteCB(msg1)
+
+	// the limits are only stored once spine processed the approved write
+	_, err := s.sut.PowerLimits()
+	assert.NotNil(s.T(), err)
+}
+
+func (s *EvCEVCSuite) Test_incentiveTableWriteCB() {
+	msg0 := &spineapi.Message{}
+	s.sut.incentiveTableWriteCB(msg0)
+
+	msg1 := s.writeMessage(500, model.CmdType{})
+	s.sut.incentiveTableWriteCB(msg1)
+
+	msg2 := s.writeMessage(501, model.CmdType{
+		IncentiveTableData: &model.IncentiveTableDataType{
+			IncentiveTable: []model.IncentiveTableType{
+				incentivesData(tariffId, []time.Duration{time.Hour}, []float64{0.3}),
+			},
+		},
+	})
+	s.sut.incentiveTableWriteCB(msg2)
+
+	_, err := s.sut.Incentives()
+	assert.NotNil(s.T(), err)
+}
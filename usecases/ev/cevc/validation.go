@@ -0,0 +1,380 @@
+package cevc
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+)
+
+// the maximum number of tiers per tariff and incentives per tier,
+// as defined in SPINE UC CoordinatedEVCharging 2.4.3
+const (
+	maxTiersPerTariff    = 3
+	maxIncentivesPerTier = 3
+)
+
+// returns the duration of a slot defined by relative start and end times
+//
+// if no end is provided, the start of the following slot is used
+func relativeSlotDuration(start, end, nextStart *model.DurationType) (time.Duration, error) {
+	if end == nil {
+		end = nextStart
+	}
+	if start == nil || end == nil {
+		return 0, errors.New("slot time period is incomplete")
+	}
+
+	startDuration, err := start.GetTimeDuration()
+	if err != nil {
+		return 0, err
+	}
+	endDuration, err := end.GetTimeDuration()
+	if err != nil {
+		return 0, err
+	}
+
+	if endDuration <= startDuration {
+		return 0, errors.New("slot ends before it starts")
+	}
+
+	return endDuration - startDuration, nil
+}
+
+// returns the duration and max value of each slot of a time series
+//
+// only relative times are supported
+func timeSeriesSlotValues(data model.TimeSeriesDataType) ([]ucapi.DurationSlotValue, error) {
+	var result []ucapi.DurationSlotValue
+
+	for index, slot := range data.TimeSeriesSlot {
+		if slot.MaxValue == nil {
+			return nil, errors.New("slot value is missing")
+		}
+
+		newSlot := ucapi.DurationSlotValue{
+			Value: slot.MaxValue.GetValue(),
+		}
+
+		if slot.Duration != nil {
+			duration, err := slot.Duration.GetTimeDuration()
+			if err != nil {
+				return nil, err
+			}
+			newSlot.Duration = duration
+		} else {
+			var start, end, nextStart *model.DurationType
+			if slot.TimePeriod != nil {
+				start = relativeTime(slot.TimePeriod.StartTime)
+				end = relativeTime(slot.TimePeriod.EndTime)
+			}
+
+			if index < len(data.TimeSeriesSlot)-1 {
+				if next := data.TimeSeriesSlot[index+1].TimePeriod; next != nil {
+					nextStart = relativeTime(next.StartTime)
+				}
+			} else if data.TimePeriod != nil {
+				// the last slot may end with the whole time series
+				nextStart = relativeTime(data.TimePeriod.EndTime)
+			}
+
+			duration, err := relativeSlotDuration(start, end, nextStart)
+			if err != nil {
+				return nil, err
+			}
+			newSlot.Duration = duration
+		}
+
+		result = append(result, newSlot)
+	}
+
+	return result, nil
+}
+
+// returns the duration of a relative time, nil if it is an absolute time
+func relativeTime(value *model.AbsoluteOrRelativeTimeType) *model.DurationType {
+	if value == nil {
+		return nil
+	}
+
+	duration, err := value.GetDurationType()
+	if err != nil {
+		return nil
+	}
+
+	return duration
+}
+
+// returns the duration and the value of the first incentive of the first tier of each slot
+//
+// only relative times are supported
+func incentiveSlotValues(data model.IncentiveTableType) ([]ucapi.DurationSlotValue, error) {
+	var result []ucapi.DurationSlotValue
+
+	for index, slot := range data.IncentiveSlot {
+		if len(slot.Tier) == 0 || len(slot.Tier[0].Incentive) == 0 ||
+			slot.Tier[0].Incentive[0].Value == nil {
+			return nil, errors.New("slot value is missing")
+		}
+
+		var start, end, nextStart *model.DurationType
+		if slot.TimeInterval != nil {
+			if slot.TimeInterval.StartTime != nil {
+				start = slot.TimeInterval.StartTime.Relative
+			}
+			if slot.TimeInterval.EndTime != nil {
+				end = slot.TimeInterval.EndTime.Relative
+			}
+		}
+		if index < len(data.IncentiveSlot)-1 {
+			if next := data.IncentiveSlot[index+1].TimeInterval; next != nil && next.StartTime != nil {
+				nextStart = next.StartTime.Relative
+			}
+		}
+
+		duration, err := relativeSlotDuration(start, end, nextStart)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, ucapi.DurationSlotValue{
+			Duration: duration,
+			Value:    slot.Tier[0].Incentive[0].Value.GetValue(),
+		})
+	}
+
+	return result, nil
+}
+
+// check the number of slots against the minimum and maximum, 0 meaning no restriction
+func validateSlotCount(count int, minSlots, maxSlots uint) error {
+	if minSlots != 0 && minSlots > uint(count) {
+		return errors.New("too few slots provided")
+	}
+
+	if maxSlots != 0 && maxSlots < uint(count) {
+		return errors.New("too many slots provided")
+	}
+
+	return nil
+}
+
+// returns the local time slot constraints of the power limits
+func (e *CEVC) timeSlotConstraints() ucapi.TimeSlotConstraints {
+	result := ucapi.TimeSlotConstraints{}
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeTimeSeries, model.RoleTypeServer)
+	if f == nil {
+		return result
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.TimeSeriesConstraintsListDataType](
+		f, model.FunctionTypeTimeSeriesConstraintsListData)
+	if err != nil {
+		return result
+	}
+
+	for _, item := range data.TimeSeriesConstraintsData {
+		if item.TimeSeriesId == nil || *item.TimeSeriesId != constraintsTimeSeriesId {
+			continue
+		}
+
+		if item.SlotCountMin != nil {
+			result.MinSlots = uint(*item.SlotCountMin)
+		}
+		if item.SlotCountMax != nil {
+			result.MaxSlots = uint(*item.SlotCountMax)
+		}
+		if item.SlotDurationMin != nil {
+			result.MinSlotDuration, _ = item.SlotDurationMin.GetTimeDuration()
+		}
+		if item.SlotDurationMax != nil {
+			result.MaxSlotDuration, _ = item.SlotDurationMax.GetTimeDuration()
+		}
+		if item.SlotDurationStepSize != nil {
+			result.SlotDurationStepSize, _ = item.SlotDurationStepSize.GetTimeDuration()
+		}
+	}
+
+	return result
+}
+
+// check incoming power limits against the time slot constraints
+func (e *CEVC) validatePowerLimits(data *model.TimeSeriesListDataType) error {
+	constraints := e.timeSlotConstraints()
+
+	for _, item := range data.TimeSeriesData {
+		if item.TimeSeriesId == nil || *item.TimeSeriesId != constraintsTimeSeriesId {
+			return errors.New("time series is not writeable")
+		}
+
+		slots, err := timeSeriesSlotValues(item)
+		if err != nil {
+			return err
+		}
+
+		if err := validateSlotCount(len(slots), constraints.MinSlots, constraints.MaxSlots); err != nil {
+			return err
+		}
+
+		for index, slot := range slots {
+			// the last slot may be shorter, as it only defines the end of the power limits
+			if index < len(slots)-1 && constraints.MinSlotDuration != 0 && slot.Duration < constraints.MinSlotDuration {
+				return errors.New("slot duration is too short")
+			}
+			if constraints.MaxSlotDuration != 0 && slot.Duration > constraints.MaxSlotDuration {
+				return errors.New("slot duration is too long")
+			}
+			if constraints.SlotDurationStepSize != 0 && slot.Duration%constraints.SlotDurationStepSize != 0 {
+				return errors.New("slot duration does not match the step size")
+			}
+		}
+	}
+
+	return nil
+}
+
+// returns the local incentive slot constraints
+func (e *CEVC) incentiveSlotConstraints() ucapi.IncentiveSlotConstraints {
+	result := ucapi.IncentiveSlotConstraints{}
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return result
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableConstraintsDataType](
+		f, model.FunctionTypeIncentiveTableConstraintsData)
+	if err != nil {
+		return result
+	}
+
+	for _, item := range data.IncentiveTableConstraints {
+		if item.Tariff == nil || item.Tariff.TariffId == nil || *item.Tariff.TariffId != tariffId ||
+			item.IncentiveSlotConstraints == nil {
+			continue
+		}
+
+		if item.IncentiveSlotConstraints.SlotCountMin != nil {
+			result.MinSlots = uint(*item.IncentiveSlotConstraints.SlotCountMin)
+		}
+		if item.IncentiveSlotConstraints.SlotCountMax != nil {
+			result.MaxSlots = uint(*item.IncentiveSlotConstraints.SlotCountMax)
+		}
+	}
+
+	return result
+}
+
+// check incoming incentive table descriptions
+func (e *CEVC) validateIncentiveTableDescriptions(data *model.IncentiveTableDescriptionDataType) error {
+	if len(data.IncentiveTableDescription) == 0 {
+		return errors.New("no tariff provided")
+	}
+
+	for _, item := range data.IncentiveTableDescription {
+		if item.TariffDescription == nil || item.TariffDescription.TariffId == nil ||
+			*item.TariffDescription.TariffId != tariffId {
+			return errors.New("unknown tariff")
+		}
+
+		if len(item.Tier) == 0 || len(item.Tier) > maxTiersPerTariff {
+			return errors.New("invalid number of tiers")
+		}
+
+		for _, tier := range item.Tier {
+			if tier.TierDescription == nil || tier.TierDescription.TierId == nil ||
+				len(tier.BoundaryDescription) == 0 ||
+				len(tier.IncentiveDescription) == 0 || len(tier.IncentiveDescription) > maxIncentivesPerTier {
+				return errors.New("invalid tier")
+			}
+		}
+	}
+
+	return nil
+}
+
+// returns the incentive ids of each tier of the local tariff description
+func (e *CEVC) describedIncentiveIds() map[model.TierIdType][]model.IncentiveIdType {
+	result := make(map[model.TierIdType][]model.IncentiveIdType)
+
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIncentiveTable, model.RoleTypeServer)
+	if f == nil {
+		return result
+	}
+
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IncentiveTableDescriptionDataType](
+		f, model.FunctionTypeIncentiveTableDescriptionData)
+	if err != nil {
+		return result
+	}
+
+	for _, item := range data.IncentiveTableDescription {
+		if item.TariffDescription == nil || item.TariffDescription.TariffId == nil ||
+			*item.TariffDescription.TariffId != tariffId {
+			continue
+		}
+
+		for _, tier := range item.Tier {
+			if tier.TierDescription == nil || tier.TierDescription.TierId == nil {
+				continue
+			}
+
+			var ids []model.IncentiveIdType
+			for _, incentive := range tier.IncentiveDescription {
+				if incentive.IncentiveId != nil {
+					ids = append(ids, *incentive.IncentiveId)
+				}
+			}
+			result[*tier.TierDescription.TierId] = ids
+		}
+	}
+
+	return result
+}
+
+// check incoming incentives against the incentive slot constraints
+// and the tariff description
+func (e *CEVC) validateIncentives(data *model.IncentiveTableDataType) error {
+	constraints := e.incentiveSlotConstraints()
+	described := e.describedIncentiveIds()
+
+	for _, item := range data.IncentiveTable {
+		if item.Tariff == nil || item.Tariff.TariffId == nil || *item.Tariff.TariffId != tariffId {
+			return errors.New("unknown tariff")
+		}
+
+		slots, err := incentiveSlotValues(item)
+		if err != nil {
+			return err
+		}
+
+		if err := validateSlotCount(len(slots), constraints.MinSlots, constraints.MaxSlots); err != nil {
+			return err
+		}
+
+		for _, slot := range item.IncentiveSlot {
+			for _, tier := range slot.Tier {
+				if tier.Tier == nil || tier.Tier.TierId == nil {
+					return errors.New("unknown tier")
+				}
+
+				ids, ok := described[*tier.Tier.TierId]
+				if !ok {
+					return errors.New("unknown tier")
+				}
+
+				for _, incentive := range tier.Incentive {
+					if incentive.IncentiveId == nil || !slices.Contains(ids, *incentive.IncentiveId) {
+						return errors.New("unknown incentive")
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
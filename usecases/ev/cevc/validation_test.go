@@ -0,0 +1,171 @@
+package cevc
+
+import (
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvCEVCSuite) Test_timeSeriesSlotValues() {
+	data := powerLimitsData(constraintsTimeSeriesId, []time.Duration{time.Hour, 2 * time.Hour}, []float64{11000, 4000})
+	slots, err := timeSeriesSlotValues(data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 11000},
+		{Duration: 2 * time.Hour, Value: 4000},
+	}, slots)
+
+	// the last slot ends with the time series
+	data.TimeSeriesSlot[1].TimePeriod.EndTime = nil
+	slots, err = timeSeriesSlotValues(data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2*time.Hour, slots[1].Duration)
+
+	data.TimePeriod = nil
+	_, err = timeSeriesSlotValues(data)
+	assert.NotNil(s.T(), err)
+
+	data = model.TimeSeriesDataType{
+		TimeSeriesSlot: []model.TimeSeriesSlotType{
+			{
+				Duration: model.NewDurationType(time.Minute),
+				MaxValue: model.NewScaledNumberType(1000),
+			},
+		},
+	}
+	slots, err = timeSeriesSlotValues(data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), time.Minute, slots[0].Duration)
+
+	data.TimeSeriesSlot[0].MaxValue = nil
+	_, err = timeSeriesSlotValues(data)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *EvCEVCSuite) Test_incentiveSlotValues() {
+	data := incentivesData(tariffId, []time.Duration{time.Hour, time.Hour}, []float64{0.25, 0.2})
+	slots, err := incentiveSlotValues(data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.DurationSlotValue{
+		{Duration: time.Hour, Value: 0.25},
+		{Duration: time.Hour, Value: 0.2},
+	}, slots)
+
+	data.IncentiveSlot[1].TimeInterval.EndTime = nil
+	_, err = incentiveSlotValues(data)
+	assert.NotNil(s.T(), err)
+
+	data.IncentiveSlot[1].Tier = nil
+	_, err = incentiveSlotValues(data)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *EvCEVCSuite) Test_validatePowerLimits() {
+	data := &model.TimeSeriesListDataType{
+		TimeSeriesData: []model.TimeSeriesDataType{
+			powerLimitsData(constraintsTimeSeriesId, []time.Duration{time.Hour, time.Hour}, []float64{11000, 4000}),
+		},
+	}
+	assert.Nil(s.T(), s.sut.validatePowerLimits(data))
+
+	err := s.sut.SetTimeSlotConstraints(ucapi.TimeSlotConstraints{
+		MinSlots:             3,
+		MaxSlots:             4,
+		MinSlotDuration:      15 * time.Minute,
+		MaxSlotDuration:      2 * time.Hour,
+		SlotDurationStepSize: 15 * time.Minute,
+	})
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+
+	data.TimeSeriesData[0] = powerLimitsData(constraintsTimeSeriesId,
+		[]time.Duration{time.Hour, time.Hour, time.Hour, time.Hour, time.Hour}, []float64{1, 2, 3, 4, 5})
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+
+	data.TimeSeriesData[0] = powerLimitsData(constraintsTimeSeriesId,
+		[]time.Duration{time.Hour, 3 * time.Hour, time.Hour}, []float64{1, 2, 3})
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+
+	data.TimeSeriesData[0] = powerLimitsData(constraintsTimeSeriesId,
+		[]time.Duration{time.Hour, 10 * time.Minute, time.Hour}, []float64{1, 2, 3})
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+
+	data.TimeSeriesData[0] = powerLimitsData(constraintsTimeSeriesId,
+		[]time.Duration{time.Hour, 20 * time.Minute, time.Hour}, []float64{1, 2, 3})
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+
+	// the last slot may be shorter
+	data.TimeSeriesData[0] = powerLimitsData(constraintsTimeSeriesId,
+		[]time.Duration{time.Hour, 30 * time.Minute, 15 * time.Minute}, []float64{1, 2, 3})
+	assert.Nil(s.T(), s.sut.validatePowerLimits(data))
+
+	data.TimeSeriesData[0] = powerLimitsData(planTimeSeriesId,
+		[]time.Duration{time.Hour, time.Hour, time.Hour}, []float64{1, 2, 3})
+	assert.NotNil(s.T(), s.sut.validatePowerLimits(data))
+}
+
+func (s *EvCEVCSuite) Test_validateIncentiveTableDescriptions() {
+	data := &model.IncentiveTableDescriptionDataType{}
+	assert.NotNil(s.T(), s.sut.validateIncentiveTableDescriptions(data))
+
+	data.IncentiveTableDescription = []model.IncentiveTableDescriptionType{
+		{
+			TariffDescription: &model.TariffDescriptionDataType{
+				TariffId: util.Ptr(model.TariffIdType(1)),
+			},
+		},
+	}
+	assert.NotNil(s.T(), s.sut.validateIncentiveTableDescriptions(data))
+
+	data.IncentiveTableDescription[0].TariffDescription.TariffId = util.Ptr(tariffId)
+	assert.NotNil(s.T(), s.sut.validateIncentiveTableDescriptions(data))
+
+	data.IncentiveTableDescription[0].Tier = []model.IncentiveTableDescriptionTierType{
+		{
+			TierDescription: &model.TierDescriptionDataType{
+				TierId: util.Ptr(model.TierIdType(0)),
+			},
+		},
+	}
+	assert.NotNil(s.T(), s.sut.validateIncentiveTableDescriptions(data))
+
+	data.IncentiveTableDescription[0].Tier[0].BoundaryDescription = []model.TierBoundaryDescriptionDataType{
+		{
+			BoundaryId: util.Ptr(model.TierBoundaryIdType(0)),
+		},
+	}
+	data.IncentiveTableDescription[0].Tier[0].IncentiveDescription = []model.IncentiveDescriptionDataType{
+		{
+			IncentiveId: util.Ptr(model.IncentiveIdType(0)),
+		},
+	}
+	assert.Nil(s.T(), s.sut.validateIncentiveTableDescriptions(data))
+}
+
+func (s *EvCEVCSuite) Test_validateIncentives() {
+	data := &model.IncentiveTableDataType{
+		IncentiveTable: []model.IncentiveTableType{
+			incentivesData(tariffId, []time.Duration{time.Hour, time.Hour}, []float64{0.3, 0.2}),
+		},
+	}
+	assert.Nil(s.T(), s.sut.validateIncentives(data))
+
+	err := s.sut.SetIncentiveConstraints(ucapi.IncentiveSlotConstraints{MinSlots: 1, MaxSlots: 1})
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), s.sut.validateIncentives(data))
+
+	data.IncentiveTable[0] = incentivesData(tariffId, []time.Duration{time.Hour}, []float64{0.3})
+	assert.Nil(s.T(), s.sut.validateIncentives(data))
+
+	data.IncentiveTable[0].IncentiveSlot[0].Tier[0].Incentive[0].IncentiveId = util.Ptr(model.IncentiveIdType(1))
+	assert.NotNil(s.T(), s.sut.validateIncentives(data))
+
+	data.IncentiveTable[0].IncentiveSlot[0].Tier[0].Tier.TierId = util.Ptr(model.TierIdType(1))
+	assert.NotNil(s.T(), s.sut.validateIncentives(data))
+
+	data.IncentiveTable[0] = incentivesData(model.TariffIdType(1), []time.Duration{time.Hour}, []float64{0.3})
+	assert.NotNil(s.T(), s.sut.validateIncentives(data))
+}
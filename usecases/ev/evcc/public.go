@@ -0,0 +1,222 @@
+package evcc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// Scenario 1
+
+// add the EV entity to the EVSE, invoked when an EV is plugged in
+//
+// the use cases announced for the EV entity are restored
+// and the charge state is set to active
+func (e *EVCC) EVConnected() {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.connected {
+		return
+	}
+
+	// the charge state has to be available once the entity is added
+	if dd, err := server.NewDeviceDiagnosis(e.LocalEntity); err == nil {
+		dd.SetLocalOperatingState(model.DeviceDiagnosisOperatingStateTypeNormalOperation)
+	}
+
+	e.restoreUseCaseInformation(e.useCaseInformation)
+	e.useCaseInformation = nil
+
+	e.evseEntity.Device().AddEntity(e.LocalEntity)
+	e.connected = true
+}
+
+// return if the EV entity is currently added to the EVSE
+func (e *EVCC) IsEVConnected() bool {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	return e.connected
+}
+
+// Scenario 2
+
+// set the communication standard used to communicate between EVSE and EV
+func (e *EVCC) SetCommunicationStandard(standard model.DeviceConfigurationKeyValueStringType) error {
+	value := &model.DeviceConfigurationKeyValueValueType{
+		String: util.Ptr(standard),
+	}
+
+	return e.updateDeviceConfigurationValue(model.DeviceConfigurationKeyNameTypeCommunicationsStandard, value)
+}
+
+// Scenario 3
+
+// set if the EV supports asymmetric charging
+func (e *EVCC) SetAsymmetricChargingSupport(supported bool) error {
+	value := &model.DeviceConfigurationKeyValueValueType{
+		Boolean: util.Ptr(supported),
+	}
+
+	return e.updateDeviceConfigurationValue(model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported, value)
+}
+
+func (e *EVCC) updateDeviceConfigurationValue(
+	keyName model.DeviceConfigurationKeyNameType,
+	value *model.DeviceConfigurationKeyValueValueType,
+) error {
+	dcs, err := server.NewDeviceConfiguration(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	data := model.DeviceConfigurationKeyValueDataType{
+		Value:             value,
+		IsValueChangeable: util.Ptr(false),
+	}
+	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(keyName),
+	}
+	return dcs.UpdateKeyValueDataForFilter(data, nil, filter)
+}
+
+// Scenario 4
+
+// set the identifications of the connected EV, e.g. PCID, Mac Address, RFID
+//
+// an empty list removes all identifications, items with an empty value are not accepted
+func (e *EVCC) SetIdentifications(identifications []ucapi.IdentificationItem) error {
+	identification, err := server.NewIdentification(e.LocalEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	data := make([]model.IdentificationDataType, 0, len(identifications))
+	for _, item := range identifications {
+		data = append(data, model.IdentificationDataType{
+			IdentificationType:  util.Ptr(item.ValueType),
+			IdentificationValue: util.Ptr(model.IdentificationValueType(item.Value)),
+		})
+	}
+
+	// duplicates are only added once
+	return identification.SetIdentifications(data)
+}
+
+// Scenario 5
+
+// set the manufacturer data of the EV
+func (e *EVCC) SetManufacturerData(data api.ManufacturerData) error {
+	dc, err := server.NewDeviceClassification(e.LocalEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	dc.SetManufacturerData(data)
+
+	return nil
+}
+
+// Scenario 6
+
+// set the minimum, maximum and standby charging power of the EV in W
+func (e *EVCC) SetChargingPowerLimits(minimum, maximum, standby float64) error {
+	ec, err := server.NewElectricalConnection(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	data := []api.ElectricalConnectionPermittedValueSetForFilter{
+		{
+			Data: model.ElectricalConnectionPermittedValueSetDataType{
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Value: []model.ScaledNumberType{*model.NewScaledNumberType(standby)},
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(minimum),
+								Max: model.NewScaledNumberType(maximum),
+							},
+						},
+					},
+				},
+			},
+			Filter: model.ElectricalConnectionParameterDescriptionDataType{
+				ScopeType: util.Ptr(model.ScopeTypeTypeACPowerTotal),
+			},
+		},
+	}
+	return ec.UpdatePermittedValueSetForFilters(data, nil, nil)
+}
+
+// Scenario 7
+
+// set the current charge state of the EV
+//
+// the paused state is reported as sleep mode
+func (e *EVCC) SetChargeState(state ucapi.EVChargeStateType) error {
+	var operatingState model.DeviceDiagnosisOperatingStateType
+	switch state {
+	case ucapi.EVChargeStateTypeActive:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeNormalOperation
+	case ucapi.EVChargeStateTypePaused:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeStandby
+	case ucapi.EVChargeStateTypeError:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeFailure
+	case ucapi.EVChargeStateTypeFinished:
+		operatingState = model.DeviceDiagnosisOperatingStateTypeFinished
+	default:
+		return api.ErrNotSupported
+	}
+
+	dd, err := server.NewDeviceDiagnosis(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	dd.SetLocalOperatingState(operatingState)
+
+	return nil
+}
+
+// Scenario 8
+
+// remove the EV entity from the EVSE, invoked when an EV is unplugged
+//
+// all EV specific data is reset, including the data of the use cases
+// added via AddEVDataUseCase
+func (e *EVCC) EVDisconnected() {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if !e.connected {
+		return
+	}
+
+	// removing the entity also removes its use cases
+	e.useCaseInformation = e.evUseCaseInformation()
+
+	e.evseEntity.Device().RemoveEntity(e.LocalEntity)
+	e.connected = false
+
+	e.resetData()
+
+	for _, uc := range e.evDataUseCases {
+		uc.ResetEVData()
+	}
+}
+
+// add another use case of the EV actor, which data is reset once the EV is disconnected
+func (e *EVCC) AddEVDataUseCase(usecase ucapi.EvDataResetInterface) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if usecase == nil {
+		return
+	}
+
+	e.evDataUseCases = append(e.evDataUseCases, usecase)
+}
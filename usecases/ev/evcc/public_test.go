@@ -0,0 +1,203 @@
+package evcc
+
+import (
+	"slices"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVCCSuite) evEntityAdded() bool {
+	return slices.Contains(s.service.LocalDevice().Entities(), s.sut.LocalEntity)
+}
+
+func (s *EvEVCCSuite) Test_EVConnected() {
+	assert.False(s.T(), s.sut.IsEVConnected())
+	assert.False(s.T(), s.evEntityAdded())
+
+	s.sut.EVConnected()
+	assert.True(s.T(), s.sut.IsEVConnected())
+	assert.True(s.T(), s.evEntityAdded())
+	assert.True(s.T(), s.sut.LocalEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, model.UseCaseNameTypeEVCommissioningAndConfiguration))
+
+	dd := s.sut.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	state, err := spine.LocalFeatureDataCopyOfType[*model.DeviceDiagnosisStateDataType](dd, model.FunctionTypeDeviceDiagnosisStateData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, *state.OperatingState)
+
+	// connecting again does not add the entity twice
+	s.sut.EVConnected()
+	assert.Equal(s.T(), 3, len(s.service.LocalDevice().Entities()))
+
+	assert.Nil(s.T(), s.sut.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeISO151182ED2))
+
+	s.sut.EVDisconnected()
+	assert.False(s.T(), s.sut.IsEVConnected())
+	assert.False(s.T(), s.evEntityAdded())
+	assert.False(s.T(), s.sut.LocalEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, model.UseCaseNameTypeEVCommissioningAndConfiguration))
+
+	// the EV data is reset
+	dcs, err := server.NewDeviceConfiguration(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+	_, err = dcs.GetKeyValueDataForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypeCommunicationsStandard),
+	})
+	assert.NotNil(s.T(), err)
+
+	s.sut.EVDisconnected()
+	assert.False(s.T(), s.sut.IsEVConnected())
+
+	// the use case support is restored
+	s.sut.EVConnected()
+	assert.True(s.T(), s.evEntityAdded())
+	assert.True(s.T(), s.sut.LocalEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, model.UseCaseNameTypeEVCommissioningAndConfiguration))
+}
+
+func (s *EvEVCCSuite) Test_DeviceConfiguration() {
+	err := s.sut.SetCommunicationStandard(model.DeviceConfigurationKeyValueStringTypeISO151182ED2)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.SetAsymmetricChargingSupport(true)
+	assert.Nil(s.T(), err)
+
+	dcs, err := server.NewDeviceConfiguration(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+
+	data, err := dcs.GetKeyValueDataForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypeCommunicationsStandard),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceConfigurationKeyValueStringTypeISO151182ED2, *data.Value.String)
+
+	data, err = dcs.GetKeyValueDataForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported),
+	})
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), *data.Value.Boolean)
+}
+
+func (s *EvEVCCSuite) Test_Identifications() {
+	err := s.sut.SetIdentifications([]ucapi.IdentificationItem{
+		{
+			Value:     "",
+			ValueType: model.IdentificationTypeTypeUserrfidtag,
+		},
+	})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.SetIdentifications([]ucapi.IdentificationItem{
+		{
+			Value:     "04A2B3C4",
+			ValueType: model.IdentificationTypeTypeUserrfidtag,
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	// the identifications are replaced
+	err = s.sut.SetIdentifications([]ucapi.IdentificationItem{
+		{
+			Value:     "00:11:22:33:44:55",
+			ValueType: model.IdentificationTypeTypeEui48,
+		},
+		{
+			Value:     "00:11:22:33:44:55",
+			ValueType: model.IdentificationTypeTypeEui48,
+		},
+	})
+	assert.Nil(s.T(), err)
+
+	feature := s.sut.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIdentification, model.RoleTypeServer)
+	data, err := spine.LocalFeatureDataCopyOfType[*model.IdentificationListDataType](feature, model.FunctionTypeIdentificationListData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data.IdentificationData))
+	assert.Equal(s.T(), model.IdentificationIdType(0), *data.IdentificationData[0].IdentificationId)
+	assert.Equal(s.T(), model.IdentificationValueType("00:11:22:33:44:55"), *data.IdentificationData[0].IdentificationValue)
+	assert.Equal(s.T(), model.IdentificationTypeTypeEui48, *data.IdentificationData[0].IdentificationType)
+
+	err = s.sut.SetIdentifications(nil)
+	assert.Nil(s.T(), err)
+	data, err = spine.LocalFeatureDataCopyOfType[*model.IdentificationListDataType](feature, model.FunctionTypeIdentificationListData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, len(data.IdentificationData))
+}
+
+func (s *EvEVCCSuite) Test_ManufacturerData() {
+	err := s.sut.SetManufacturerData(api.ManufacturerData{
+		BrandName:    "brand",
+		SerialNumber: "1234",
+	})
+	assert.Nil(s.T(), err)
+
+	feature := s.sut.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	data, err := spine.LocalFeatureDataCopyOfType[*model.DeviceClassificationManufacturerDataType](feature, model.FunctionTypeDeviceClassificationManufacturerData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("brand"), *data.BrandName)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("1234"), *data.SerialNumber)
+	assert.Nil(s.T(), data.DeviceName)
+}
+
+func (s *EvEVCCSuite) Test_ChargingPowerLimits() {
+	err := s.sut.SetChargingPowerLimits(1400, 11000, 10)
+	assert.Nil(s.T(), err)
+
+	ec, err := server.NewElectricalConnection(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+
+	data, err := ec.GetPermittedValueSetForFilter(model.ElectricalConnectionPermittedValueSetDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	set := data[0].PermittedValueSet[0]
+	assert.Equal(s.T(), 1400.0, set.Range[0].Min.GetValue())
+	assert.Equal(s.T(), 11000.0, set.Range[0].Max.GetValue())
+	assert.Equal(s.T(), 10.0, set.Value[0].GetValue())
+}
+
+func (s *EvEVCCSuite) Test_ChargeState() {
+	err := s.sut.SetChargeState(ucapi.EVChargeStateTypeUnplugged)
+	assert.Equal(s.T(), api.ErrNotSupported, err)
+
+	dd := s.sut.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+
+	states := map[ucapi.EVChargeStateType]model.DeviceDiagnosisOperatingStateType{
+		ucapi.EVChargeStateTypeActive:   model.DeviceDiagnosisOperatingStateTypeNormalOperation,
+		ucapi.EVChargeStateTypePaused:   model.DeviceDiagnosisOperatingStateTypeStandby,
+		ucapi.EVChargeStateTypeError:    model.DeviceDiagnosisOperatingStateTypeFailure,
+		ucapi.EVChargeStateTypeFinished: model.DeviceDiagnosisOperatingStateTypeFinished,
+	}
+	for state, operatingState := range states {
+		err = s.sut.SetChargeState(state)
+		assert.Nil(s.T(), err)
+
+		data, err := spine.LocalFeatureDataCopyOfType[*model.DeviceDiagnosisStateDataType](dd, model.FunctionTypeDeviceDiagnosisStateData)
+		assert.Nil(s.T(), err)
+		assert.Equal(s.T(), operatingState, *data.OperatingState)
+	}
+}
+
+type evDataUseCase struct {
+	resets int
+}
+
+func (e *evDataUseCase) ResetEVData() {
+	e.resets++
+}
+
+func (s *EvEVCCSuite) Test_AddEVDataUseCase() {
+	evData := &evDataUseCase{}
+
+	s.sut.AddEVDataUseCase(nil)
+	s.sut.AddEVDataUseCase(evData)
+
+	// not connected yet, nothing to reset
+	s.sut.EVDisconnected()
+	assert.Equal(s.T(), 0, evData.resets)
+
+	s.sut.EVConnected()
+	s.sut.EVDisconnected()
+	assert.Equal(s.T(), 1, evData.resets)
+}
@@ -0,0 +1,246 @@
+package evcc
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection used for the charging power limits
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type EVCC struct {
+	*usecase.UseCaseBase
+
+	evseEntity spineapi.EntityLocalInterface
+
+	connected bool
+
+	// the use cases announced for the EV entity,
+	// these are removed together with the entity and restored once it is added again
+	useCaseInformation []model.UseCaseInformationDataType
+
+	// other use cases of the EV actor, which data is reset once the EV is disconnected
+	evDataUseCases []ucapi.EvDataResetInterface
+
+	mux sync.Mutex
+}
+
+var _ ucapi.EvEVCCInterface = (*EVCC)(nil)
+
+// Create a new EV EVCC use case
+//
+// The EV entity is created as a sub entity of the provided EVSE entity,
+// and is only added to the device while an EV is connected.
+// Other use cases of the EV actor have to use the EV entity provided
+// via the LocalEntity field.
+//
+// parameters:
+//   - service: the service, used for the heartbeat timeout of the EV entity
+//   - evseEntity: the local EVSE entity the EV entity is added to
+//   - eventCB: the callback for use case events
+func NewEVCC(
+	service api.ServiceInterface,
+	evseEntity spineapi.EntityLocalInterface,
+	eventCB api.EntityEventCallback,
+) *EVCC {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(3),
+			Mandatory: true,
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(4),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(5),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(6),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(7),
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(8),
+			Mandatory: true,
+		},
+	}
+
+	evAddress := append(slices.Clone(evseEntity.Address().Entity), 1)
+	evEntity := spine.NewEntityLocal(
+		evseEntity.Device(),
+		model.EntityTypeTypeEV,
+		evAddress,
+		service.Configuration().HeartbeatTimeout(),
+	)
+
+	usecase := usecase.NewUseCaseBase(
+		evEntity,
+		model.UseCaseActorTypeEV,
+		model.UseCaseNameTypeEVCommissioningAndConfiguration,
+		"1.0.1",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &EVCC{
+		UseCaseBase: usecase,
+		evseEntity:  evseEntity,
+	}
+
+	return uc
+}
+
+func (e *EVCC) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeIdentification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeIdentificationListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceClassificationManufacturerData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionPermittedValueSetListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceDiagnosisStateData, true, false)
+
+	if dcs, err := server.NewDeviceConfiguration(e.LocalEntity); err == nil {
+		keys := []model.DeviceConfigurationKeyValueDescriptionDataType{
+			{
+				KeyName:   util.Ptr(model.DeviceConfigurationKeyNameTypeCommunicationsStandard),
+				ValueType: util.Ptr(model.DeviceConfigurationKeyValueTypeTypeString),
+			},
+			{
+				KeyName:   util.Ptr(model.DeviceConfigurationKeyNameTypeAsymmetricChargingSupported),
+				ValueType: util.Ptr(model.DeviceConfigurationKeyValueTypeTypeBoolean),
+			},
+		}
+		for _, key := range keys {
+			filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+				KeyName: key.KeyName,
+			}
+			if data, err := dcs.GetKeyValueDescriptionsForFilter(filter); err != nil || len(data) == 0 {
+				dcs.AddKeyValueDescription(key)
+			}
+		}
+	}
+
+	if ec, err := server.NewElectricalConnection(e.LocalEntity); err == nil {
+		filter := model.ElectricalConnectionParameterDescriptionDataType{
+			ScopeType: util.Ptr(model.ScopeTypeTypeACPowerTotal),
+		}
+		if data, err := ec.GetParameterDescriptionsForFilter(filter); err != nil || len(data) == 0 {
+			_ = ec.AddParameterDescription(model.ElectricalConnectionParameterDescriptionDataType{
+				ElectricalConnectionId: util.Ptr(electricalConnectionId),
+				ScopeType:              util.Ptr(model.ScopeTypeTypeACPowerTotal),
+			})
+		}
+	}
+}
+
+// return the use case information announced for the EV entity
+func (e *EVCC) evUseCaseInformation() []model.UseCaseInformationDataType {
+	nodeMgmt := e.LocalEntity.Device().NodeManagement()
+	data, err := spine.LocalFeatureDataCopyOfType[*model.NodeManagementUseCaseDataType](nodeMgmt, model.FunctionTypeNodeManagementUseCaseData)
+	if err != nil {
+		return nil
+	}
+
+	var result []model.UseCaseInformationDataType
+	for _, item := range data.UseCaseInformation {
+		if item.Address != nil && reflect.DeepEqual(item.Address.Entity, e.LocalEntity.Address().Entity) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// announce the provided use case information again
+func (e *EVCC) restoreUseCaseInformation(useCaseInformation []model.UseCaseInformationDataType) {
+	if len(useCaseInformation) == 0 {
+		return
+	}
+
+	nodeMgmt := e.LocalEntity.Device().NodeManagement()
+	data, err := spine.LocalFeatureDataCopyOfType[*model.NodeManagementUseCaseDataType](nodeMgmt, model.FunctionTypeNodeManagementUseCaseData)
+	if err != nil {
+		data = &model.NodeManagementUseCaseDataType{}
+	}
+
+	for _, item := range useCaseInformation {
+		if item.Address == nil || item.Actor == nil {
+			continue
+		}
+
+		for _, support := range item.UseCaseSupport {
+			if support.UseCaseName == nil || support.UseCaseVersion == nil {
+				continue
+			}
+
+			data.AddUseCaseSupport(
+				*item.Address,
+				*item.Actor,
+				*support.UseCaseName,
+				*support.UseCaseVersion,
+				internal.Deref(support.UseCaseDocumentSubRevision),
+				support.UseCaseAvailable == nil || *support.UseCaseAvailable,
+				support.ScenarioSupport,
+			)
+		}
+	}
+
+	nodeMgmt.SetData(model.FunctionTypeNodeManagementUseCaseData, data)
+}
+
+// remove all EV specific data
+func (e *EVCC) resetData() {
+	if f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer); f != nil {
+		f.SetData(model.FunctionTypeDeviceConfigurationKeyValueListData, &model.DeviceConfigurationKeyValueListDataType{})
+	}
+
+	if f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeIdentification, model.RoleTypeServer); f != nil {
+		f.SetData(model.FunctionTypeIdentificationListData, &model.IdentificationListDataType{})
+	}
+
+	if f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer); f != nil {
+		f.SetData(model.FunctionTypeDeviceClassificationManufacturerData, &model.DeviceClassificationManufacturerDataType{})
+	}
+
+	if f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer); f != nil {
+		f.SetData(model.FunctionTypeElectricalConnectionPermittedValueSetListData, &model.ElectricalConnectionPermittedValueSetListDataType{})
+	}
+}
@@ -0,0 +1,37 @@
+package evcc
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVCCSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvEVCCSuite) Test_AddFeatures() {
+	assert.Equal(s.T(), model.EntityTypeTypeEV, s.sut.LocalEntity.EntityType())
+	assert.Equal(s.T(), append(s.evseEntity.Address().Entity, 1), s.sut.LocalEntity.Address().Entity)
+
+	dcs, err := server.NewDeviceConfiguration(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := dcs.GetKeyValueDescriptionsForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = dcs.GetKeyValueDescriptionsForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(descs))
+
+	ec, err := server.NewElectricalConnection(s.sut.LocalEntity)
+	assert.Nil(s.T(), err)
+
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(params))
+	assert.Equal(s.T(), model.ScopeTypeTypeACPowerTotal, *params[0].ScopeType)
+}
@@ -0,0 +1,69 @@
+package evcem
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+)
+
+// set the phases the connected EV is charging with
+//
+// the measurement descriptions are replaced and all measured values are removed
+//
+//   - connectedPhases: the connected phases, e.g. "abc" or "a"
+func (e *EVCEM) SetConnectedPhases(connectedPhases model.ElectricalConnectionPhaseNameType) error {
+	phases := internal.PhasesOfPhaseSet(connectedPhases)
+	if len(phases) == 0 {
+		return api.ErrNotSupported
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if connectedPhases == e.connectedPhases && len(e.measurementIds) > 0 {
+		return nil
+	}
+
+	return e.replaceDescriptions(connectedPhases, phases)
+}
+
+// reset all measurements and the connected phases to the defaults,
+// invoked once the EV is disconnected
+func (e *EVCEM) ResetEVData() {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are not yet added
+	if len(e.measurementIds) == 0 {
+		return
+	}
+
+	_ = e.replaceDescriptions(e.defaultPhases, internal.PhasesOfPhaseSet(e.defaultPhases))
+}
+
+// Scenario 1
+
+// set the momentary charging current per phase
+//
+//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+func (e *EVCEM) UpdateCurrentPerPhase(phaseCurrent []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACCurrent, phaseCurrent)
+}
+
+// Scenario 2
+
+// set the momentary charging power per phase
+//
+//   - phasePower: the power in W for each connected phase, in the order of phases a, b and c
+func (e *EVCEM) UpdatePowerPerPhase(phasePower []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACPower, phasePower)
+}
+
+// Scenario 3
+
+// set the energy charged during the current charging session
+//
+//   - energy: the energy in Wh
+func (e *EVCEM) UpdateEnergyCharged(energy float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeCharge, []float64{energy})
+}
@@ -0,0 +1,113 @@
+package evcem
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVCEMSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *EvEVCEMSuite) connectedPhases() uint {
+	ec, err := server.NewElectricalConnection(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	data, err := ec.GetDescriptionsForFilter(model.ElectricalConnectionDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+
+	return *data[0].AcConnectedPhases
+}
+
+func (s *EvEVCEMSuite) Test_Measurements() {
+	err := s.sut.UpdateCurrentPerPhase([]float64{10, 11})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 11, 12}, s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	err = s.sut.UpdatePowerPerPhase([]float64{2300, 2400, 2500})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{2300, 2400, 2500}, s.valuesForScope(model.ScopeTypeTypeACPower))
+
+	err = s.sut.UpdateEnergyCharged(1500.5)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1500.5}, s.valuesForScope(model.ScopeTypeTypeCharge))
+}
+
+func (s *EvEVCEMSuite) Test_SetConnectedPhases() {
+	err := s.sut.SetConnectedPhases(model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), api.ErrNotSupported, err)
+
+	err = s.sut.UpdateEnergyCharged(100)
+	assert.Nil(s.T(), err)
+	err = s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+
+	// unchanged phases keep the values
+	err = s.sut.SetConnectedPhases(model.ElectricalConnectionPhaseNameTypeAbc)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{100}, s.valuesForScope(model.ScopeTypeTypeCharge))
+
+	err = s.sut.SetConnectedPhases(model.ElectricalConnectionPhaseNameTypeA)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(1), s.connectedPhases())
+	assert.Nil(s.T(), s.valuesForScope(model.ScopeTypeTypeCharge))
+	assert.Nil(s.T(), s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{16})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16}, s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// current, power, charged energy
+	assert.Equal(s.T(), 3, len(descs))
+}
+
+func (s *EvEVCEMSuite) Test_ResetEVData() {
+	err := s.sut.SetConnectedPhases(model.ElectricalConnectionPhaseNameTypeA)
+	assert.Nil(s.T(), err)
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{16})
+	assert.Nil(s.T(), err)
+
+	// the EV is disconnected, so the defaults are restored
+	s.evcc.EVDisconnected()
+
+	assert.Equal(s.T(), uint(3), s.connectedPhases())
+	assert.Nil(s.T(), s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	s.evcc.EVConnected()
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 11, 12}, s.valuesForScope(model.ScopeTypeTypeACCurrent))
+	assert.True(s.T(), s.evEntity.HasUseCaseSupport(model.UseCaseActorTypeEV, model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging))
+}
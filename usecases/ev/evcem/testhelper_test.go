@@ -0,0 +1,64 @@
+package evcem
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	"github.com/enbility/eebus-go/usecases/ev/evcc"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEvEVCEMSuite(t *testing.T) {
+	suite.Run(t, new(EvEVCEMSuite))
+}
+
+type EvEVCEMSuite struct {
+	suite.Suite
+
+	sut *EVCEM
+
+	service api.ServiceInterface
+
+	evcc     *evcc.EVCC
+	evEntity spineapi.EntityLocalInterface
+}
+
+func (s *EvEVCEMSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *EvEVCEMSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	evseEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+	s.evcc = evcc.NewEVCC(s.service, evseEntity, s.Event)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+	s.evEntity = s.evcc.LocalEntity
+
+	s.sut = NewEVCEM(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+
+	s.evcc.AddEVDataUseCase(s.sut)
+	s.evcc.EVConnected()
+}
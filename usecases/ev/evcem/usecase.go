@@ -0,0 +1,221 @@
+package evcem
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection used for all measurements
+//
+// this is identical to the ElectricalConnectionId used in the EV EVCC implementation
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type EVCEM struct {
+	*usecase.UseCaseBase
+
+	defaultPhases   model.ElectricalConnectionPhaseNameType // the phases used once the EV is disconnected
+	connectedPhases model.ElectricalConnectionPhaseNameType
+	phases          []model.ElectricalConnectionPhaseNameType // the single connected phases
+
+	measurementIds map[model.ScopeTypeType][]model.MeasurementIdType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.EvEVCEMInterface = (*EVCEM)(nil)
+
+// Create a new EV EVCEM use case
+//
+// The use case has to be added to the EV entity provided by the EV EVCC use case,
+// and should be registered there via AddEVDataUseCase, so the measurements
+// are reset once the EV is disconnected.
+//
+// parameters:
+//   - localEntity: the local EV entity providing the measurements
+//   - eventCB: the callback for use case events
+//   - connectedPhases: the phases the EV is charging with by default, e.g. "abc" or "a",
+//     defaults to "abc" if not a valid phase set
+func NewEVCEM(
+	localEntity spineapi.EntityLocalInterface,
+	eventCB api.EntityEventCallback,
+	connectedPhases model.ElectricalConnectionPhaseNameType,
+) *EVCEM {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(2),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(3),
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeEV,
+		model.UseCaseNameTypeMeasurementOfElectricityDuringEVCharging,
+		"1.0.1",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	phases := internal.PhasesOfPhaseSet(connectedPhases)
+	if len(phases) == 0 {
+		connectedPhases = model.ElectricalConnectionPhaseNameTypeAbc
+		phases = internal.PhasesOfPhaseSet(connectedPhases)
+	}
+
+	uc := &EVCEM{
+		UseCaseBase:     usecase,
+		defaultPhases:   connectedPhases,
+		connectedPhases: connectedPhases,
+		phases:          phases,
+		measurementIds:  make(map[model.ScopeTypeType][]model.MeasurementIdType),
+	}
+
+	return uc
+}
+
+// add the measurement and parameter descriptions for a scope, one for each provided phase set
+func (e *EVCEM) addMeasurements(
+	measurementType model.MeasurementTypeType,
+	unit model.UnitOfMeasurementType,
+	scope model.ScopeTypeType,
+	phases []model.ElectricalConnectionPhaseNameType,
+) {
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(measurementType),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(unit),
+		ScopeType:       util.Ptr(scope),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(electricalConnectionId),
+		VoltageType:            util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		AcMeasurementType:      util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+	}
+
+	for _, phase := range phases {
+		param := paramDesc
+		param.AcMeasuredPhases = util.Ptr(phase)
+
+		id, err := internal.AddMeasurementWithParameterDescription(e.LocalEntity, measurementDesc, param)
+		if err != nil {
+			logging.Log().Debug("EVCEM addMeasurements: error adding description", scope, err)
+			continue
+		}
+
+		e.measurementIds[scope] = append(e.measurementIds[scope], *id)
+	}
+}
+
+// add the electrical connection description and all measurement descriptions
+// for the currently connected phases
+//
+// the mutex has to be locked by the caller
+func (e *EVCEM) addDescriptions() {
+	if ec, err := server.NewElectricalConnection(e.LocalEntity); err == nil {
+		// this also updates an already existing description
+		_ = ec.AddDescription(model.ElectricalConnectionDescriptionDataType{
+			ElectricalConnectionId:  util.Ptr(electricalConnectionId),
+			PowerSupplyType:         util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			AcConnectedPhases:       util.Ptr(uint(len(e.phases))),
+			PositiveEnergyDirection: util.Ptr(model.EnergyDirectionTypeConsume),
+		})
+	}
+
+	// Scenario 1
+	// the current measurements are shared with the OPEV and OSCEV use cases
+	if ids, err := internal.PhaseCurrentMeasurementIds(e.LocalEntity, electricalConnectionId, e.phases); err == nil {
+		e.measurementIds[model.ScopeTypeTypeACCurrent] = ids
+	} else {
+		logging.Log().Debug("EVCEM addDescriptions: error adding current descriptions", err)
+	}
+
+	// Scenario 2
+	e.addMeasurements(model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPower, e.phases)
+
+	// Scenario 3
+	connectedPhases := []model.ElectricalConnectionPhaseNameType{e.connectedPhases}
+	e.addMeasurements(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeCharge, connectedPhases)
+}
+
+// replace all measurement descriptions with the ones for the provided phases,
+// this also removes all measured values
+//
+// the mutex has to be locked by the caller
+func (e *EVCEM) replaceDescriptions(
+	connectedPhases model.ElectricalConnectionPhaseNameType,
+	phases []model.ElectricalConnectionPhaseNameType,
+) error {
+	var ids []model.MeasurementIdType
+	for _, scopeIds := range e.measurementIds {
+		ids = append(ids, scopeIds...)
+	}
+
+	if err := internal.RemoveMeasurementsWithParameterDescriptions(e.LocalEntity, ids); err != nil {
+		return err
+	}
+
+	e.connectedPhases = connectedPhases
+	e.phases = phases
+	e.measurementIds = make(map[model.ScopeTypeType][]model.MeasurementIdType)
+
+	e.addDescriptions()
+
+	return nil
+}
+
+// set the values of all measurements of a scope
+func (e *EVCEM) updateMeasurements(scope model.ScopeTypeType, values []float64) error {
+	e.mux.Lock()
+	ids := e.measurementIds[scope]
+	e.mux.Unlock()
+
+	if len(ids) == 0 {
+		return api.ErrMetadataNotAvailable
+	}
+
+	return internal.UpdateMeasurementValues(e.LocalEntity, ids, values)
+}
+
+func (e *EVCEM) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are only added once
+	if len(e.measurementIds) > 0 {
+		return
+	}
+
+	e.addDescriptions()
+}
@@ -0,0 +1,59 @@
+package evcem
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVCEMSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvEVCEMSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// 3x current, 3x power, charged energy
+	assert.Equal(s.T(), 7, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 7, len(descs))
+
+	ec, err := server.NewElectricalConnection(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	descriptions, err := ec.GetDescriptionsForFilter(model.ElectricalConnectionDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descriptions))
+	assert.Equal(s.T(), uint(3), *descriptions[0].AcConnectedPhases)
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *descriptions[0].PositiveEnergyDirection)
+
+	// the parameter description of the EVCC charging power limits is kept
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 8, len(params))
+
+	params, err = ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		AcMeasuredPhases: util.Ptr(model.ElectricalConnectionPhaseNameTypeB),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(params))
+}
+
+func (s *EvEVCEMSuite) Test_ConnectedPhases() {
+	sut := NewEVCEM(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeAbc, sut.connectedPhases)
+	assert.Equal(s.T(), 3, len(sut.phases))
+
+	sut = NewEVCEM(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeA)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, sut.connectedPhases)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, sut.defaultPhases)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeA}, sut.phases)
+}
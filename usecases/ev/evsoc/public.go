@@ -0,0 +1,68 @@
+package evsoc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+)
+
+// remove all measured values, invoked once the EV is disconnected
+func (e *EVSOC) ResetEVData() {
+	e.mux.Lock()
+	var ids []model.MeasurementIdType
+	for _, id := range e.measurementIds {
+		ids = append(ids, id)
+	}
+	e.mux.Unlock()
+
+	_ = internal.RemoveMeasurementValues(e.LocalEntity, ids)
+}
+
+// set the value of the measurement of a scope
+func (e *EVSOC) updateMeasurement(scope model.ScopeTypeType, value float64) error {
+	e.mux.Lock()
+	id, ok := e.measurementIds[scope]
+	e.mux.Unlock()
+
+	if !ok {
+		return api.ErrMetadataNotAvailable
+	}
+
+	return internal.UpdateMeasurementValues(e.LocalEntity, []model.MeasurementIdType{id}, []float64{value})
+}
+
+// Scenario 1
+
+// set the current state of charge of the EV
+//
+//   - stateOfCharge: the state of charge in %
+func (e *EVSOC) UpdateStateOfCharge(stateOfCharge float64) error {
+	return e.updateMeasurement(model.ScopeTypeTypeStateOfCharge, stateOfCharge)
+}
+
+// Scenario 2
+
+// set the nominal battery capacity of the EV
+//
+//   - capacity: the nominal capacity in Wh
+func (e *EVSOC) UpdateNominalCapacity(capacity float64) error {
+	return e.updateMeasurement(model.ScopeTypeTypeNominalEnergyCapacity, capacity)
+}
+
+// Scenario 3
+
+// set the actual usable battery capacity of the EV
+//
+//   - capacity: the actual capacity in Wh
+func (e *EVSOC) UpdateActualCapacity(capacity float64) error {
+	return e.updateMeasurement(model.ScopeTypeTypeUseableCapacity, capacity)
+}
+
+// Scenario 4
+
+// set the remaining travel range of the EV
+//
+//   - travelRange: the travel range in m
+func (e *EVSOC) UpdateTravelRange(travelRange float64) error {
+	return e.updateMeasurement(model.ScopeTypeTypeTravelRange, travelRange)
+}
@@ -0,0 +1,79 @@
+package evsoc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVSOCSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *EvEVSOCSuite) Test_Measurements() {
+	err := s.sut.UpdateStateOfCharge(55.5)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{55.5}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+
+	err = s.sut.UpdateNominalCapacity(77000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{77000}, s.valuesForScope(model.ScopeTypeTypeNominalEnergyCapacity))
+
+	err = s.sut.UpdateActualCapacity(72000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{72000}, s.valuesForScope(model.ScopeTypeTypeUseableCapacity))
+
+	err = s.sut.UpdateTravelRange(250000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{250000}, s.valuesForScope(model.ScopeTypeTypeTravelRange))
+
+	// updating a value keeps the others
+	err = s.sut.UpdateStateOfCharge(60)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{60}, s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+	assert.Equal(s.T(), []float64{250000}, s.valuesForScope(model.ScopeTypeTypeTravelRange))
+}
+
+func (s *EvEVSOCSuite) Test_MissingDescriptions() {
+	sut := NewEVSOC(s.evEntity, s.Event)
+
+	err := sut.UpdateStateOfCharge(50)
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+}
+
+func (s *EvEVSOCSuite) Test_ResetEVData() {
+	err := s.sut.UpdateStateOfCharge(55.5)
+	assert.Nil(s.T(), err)
+	err = s.sut.UpdateTravelRange(250000)
+	assert.Nil(s.T(), err)
+
+	s.evcc.EVDisconnected()
+
+	assert.Nil(s.T(), s.valuesForScope(model.ScopeTypeTypeStateOfCharge))
+	assert.Nil(s.T(), s.valuesForScope(model.ScopeTypeTypeTravelRange))
+
+	// the descriptions are kept
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4, len(descs))
+}
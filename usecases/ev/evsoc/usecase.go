@@ -0,0 +1,133 @@
+package evsoc
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+type EVSOC struct {
+	*usecase.UseCaseBase
+
+	measurementIds map[model.ScopeTypeType]model.MeasurementIdType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.EvEVSOCInterface = (*EVSOC)(nil)
+
+// the measurement descriptions of all scenarios
+var measurementDescriptions = []model.MeasurementDescriptionDataType{
+	// Scenario 1
+	{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypePercentage),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(model.UnitOfMeasurementTypepct),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeStateOfCharge),
+	},
+	// Scenario 2
+	{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypeEnergy),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(model.UnitOfMeasurementTypeWh),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeNominalEnergyCapacity),
+	},
+	// Scenario 3
+	{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypeEnergy),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(model.UnitOfMeasurementTypeWh),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeUseableCapacity),
+	},
+	// Scenario 4
+	{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypeDistance),
+		Unit:            util.Ptr(model.UnitOfMeasurementTypem),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeTravelRange),
+	},
+}
+
+// Create a new EV EVSOC use case
+//
+// The use case has to be added to the EV entity provided by the EV EVCC use case,
+// and should be registered there via AddEVDataUseCase, so the measurements
+// are reset once the EV is disconnected.
+//
+// parameters:
+//   - localEntity: the local EV entity providing the measurements
+//   - eventCB: the callback for use case events
+func NewEVSOC(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *EVSOC {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(2),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(3),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(4),
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeEV,
+		model.UseCaseNameTypeEVStateOfCharge,
+		"1.0.0",
+		"RC1",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &EVSOC{
+		UseCaseBase:    usecase,
+		measurementIds: make(map[model.ScopeTypeType]model.MeasurementIdType),
+	}
+
+	return uc
+}
+
+func (e *EVSOC) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are only added once
+	if len(e.measurementIds) > 0 {
+		return
+	}
+
+	measurement, err := server.NewMeasurement(e.LocalEntity)
+	if err != nil {
+		logging.Log().Debug("EVSOC AddFeatures: measurement feature not found", err)
+		return
+	}
+
+	for _, desc := range measurementDescriptions {
+		if id := measurement.AddDescription(desc); id != nil {
+			e.measurementIds[*desc.ScopeType] = *id
+		}
+	}
+}
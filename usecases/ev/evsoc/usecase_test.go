@@ -0,0 +1,38 @@
+package evsoc
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvEVSOCSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvEVSOCSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// state of charge, nominal capacity, actual capacity, travel range
+	assert.Equal(s.T(), 4, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4, len(descs))
+
+	// the state of charge description matches the one the CEM EVSOC use case expects
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypePercentage),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeStateOfCharge),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descs))
+	assert.Equal(s.T(), model.UnitOfMeasurementTypepct, *descs[0].Unit)
+}
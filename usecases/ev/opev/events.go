@@ -0,0 +1,42 @@
+package opev
+
+import (
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// handle SPINE events
+func (e *OPEV) HandleEvent(payload spineapi.EventPayload) {
+	// only about written data of the load control server of this entity
+
+	if !e.IsCompatibleEntityType(payload.Entity) {
+		return
+	}
+
+	if payload.EventType != spineapi.EventTypeDataChange ||
+		payload.ChangeType != spineapi.ElementChangeUpdate ||
+		payload.CmdClassifier == nil ||
+		*payload.CmdClassifier != model.CmdClassifierTypeWrite {
+		return
+	}
+
+	if data, ok := payload.Data.(*model.LoadControlLimitListDataType); ok {
+		serverF := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+
+		if payload.Function != model.FunctionTypeLoadControlLimitListData ||
+			payload.LocalFeature != serverF {
+			return
+		}
+
+		e.loadControlLimitDataUpdate(payload, data)
+	}
+}
+
+// the load control limit data was written by a CEM
+func (e *OPEV) loadControlLimitDataUpdate(payload spineapi.EventPayload, data *model.LoadControlLimitListDataType) {
+	limits := internal.PhaseLimitsOfWriteData(e.LocalEntity, internal.EVCurrentObligationLimitDescription, data)
+	if len(limits) > 0 && e.EventCB != nil {
+		e.EventCB(payload.Ski, payload.Device, payload.Entity, DataUpdateLimit)
+	}
+}
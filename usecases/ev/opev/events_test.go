@@ -0,0 +1,58 @@
+package opev
+
+import (
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvOPEVSuite) Test_Events() {
+	payload := spineapi.EventPayload{
+		Entity: s.cemEntity,
+	}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	payload.EventType = spineapi.EventTypeDataChange
+	payload.ChangeType = spineapi.ElementChangeUpdate
+	payload.CmdClassifier = util.Ptr(model.CmdClassifierTypeWrite)
+	payload.Function = model.FunctionTypeLoadControlLimitListData
+	payload.Data = &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+				IsLimitActive: util.Ptr(true),
+				Value:         model.NewScaledNumberType(10),
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.eventCalled)
+
+	payload.LocalFeature = s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	s.sut.HandleEvent(payload)
+	assert.True(s.T(), s.isEventCalled(DataUpdateLimit))
+}
+
+func (s *EvOPEVSuite) Test_Events_OtherLimits() {
+	payload := spineapi.EventPayload{
+		Entity:        s.cemEntity,
+		EventType:     spineapi.EventTypeDataChange,
+		ChangeType:    spineapi.ElementChangeUpdate,
+		CmdClassifier: util.Ptr(model.CmdClassifierTypeWrite),
+		Function:      model.FunctionTypeLoadControlLimitListData,
+		LocalFeature:  s.evEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer),
+		Data: &model.LoadControlLimitListDataType{
+			LoadControlLimitData: []model.LoadControlLimitDataType{
+				{
+					LimitId:       util.Ptr(model.LoadControlLimitIdType(10)),
+					IsLimitActive: util.Ptr(true),
+					Value:         model.NewScaledNumberType(10),
+				},
+			},
+		},
+	}
+	s.sut.HandleEvent(payload)
+	assert.False(s.T(), s.eventCalled)
+}
@@ -0,0 +1,132 @@
+package opev
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvOPEVSuite) Test_SetCurrentLimits() {
+	sut := NewOPEV(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+	err := sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0})
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+
+	err = s.sut.SetCurrentLimits([]float64{6}, []float64{16}, []float64{0})
+	assert.NotNil(s.T(), err)
+
+	err = s.sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 10}, []float64{0, 0, 0})
+	assert.Nil(s.T(), err)
+
+	limits, err := s.sut.EffectiveCurrentLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16, 16, 10}, limits)
+}
+
+func (s *EvOPEVSuite) Test_LoadControlLimits() {
+	limits, err := s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(limits))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, limits[0].Phase)
+	assert.True(s.T(), limits[0].IsChangeable)
+	assert.False(s.T(), limits[0].IsActive)
+
+	s.setLimit(1, 10)
+
+	limits, err = s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(limits))
+	assert.True(s.T(), limits[1].IsActive)
+	assert.Equal(s.T(), 10.0, limits[1].Value)
+}
+
+func (s *EvOPEVSuite) Test_PendingLoadControlLimits() {
+	data := s.sut.PendingLoadControlLimits()
+	assert.Equal(s.T(), 0, len(data))
+
+	msg := s.writeMessage(500, []model.LoadControlLimitDataType{
+		{
+			LimitId:       util.Ptr(model.LoadControlLimitIdType(2)),
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(12),
+		},
+	})
+	s.sut.loadControlWriteCB(msg)
+
+	data = s.sut.PendingLoadControlLimits()
+	assert.Equal(s.T(), 1, len(data))
+	limits := data[model.MsgCounterType(500)]
+	assert.Equal(s.T(), 1, len(limits))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeC, limits[0].Phase)
+	assert.True(s.T(), limits[0].IsActive)
+	assert.Equal(s.T(), 12.0, limits[0].Value)
+
+	// unknown message counters are ignored
+	s.sut.ApproveOrDenyLoadControlLimits(model.MsgCounterType(499), true, "")
+	data = s.sut.PendingLoadControlLimits()
+	assert.Equal(s.T(), 1, len(data))
+
+	s.sut.ApproveOrDenyLoadControlLimits(model.MsgCounterType(500), false, "not allowed")
+	data = s.sut.PendingLoadControlLimits()
+	assert.Equal(s.T(), 0, len(data))
+}
+
+func (s *EvOPEVSuite) Test_EffectiveCurrentLimits() {
+	limits, err := s.sut.EffectiveCurrentLimits()
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), limits)
+
+	err = s.sut.SetCurrentLimits([]float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0})
+	assert.Nil(s.T(), err)
+
+	s.setLimit(0, 10)
+
+	limits, err = s.sut.EffectiveCurrentLimits()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 16, 16}, limits)
+}
+
+func (s *EvOPEVSuite) Test_ResetEVData() {
+	s.setLimit(0, 10)
+
+	msg := s.writeMessage(500, []model.LoadControlLimitDataType{
+		{
+			LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(12),
+		},
+	})
+	s.sut.loadControlWriteCB(msg)
+	assert.Equal(s.T(), 1, len(s.sut.PendingLoadControlLimits()))
+
+	// the EV is disconnected, so the limits are deactivated
+	s.evcc.EVDisconnected()
+
+	assert.Equal(s.T(), 0, len(s.sut.PendingLoadControlLimits()))
+
+	limits, err := s.sut.LoadControlLimits()
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), limits[0].IsActive)
+}
+
+// activate the obligation limit of a phase
+func (s *EvOPEVSuite) setLimit(index int, value float64) {
+	lc, err := server.NewLoadControl(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := lc.GetLimitDescriptionsForFilter(internal.EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+
+	err = lc.UpdateLimitDataForIds([]api.LoadControlLimitDataForID{
+		{
+			Data: model.LoadControlLimitDataType{
+				IsLimitActive: util.Ptr(true),
+				Value:         model.NewScaledNumberType(value),
+			},
+			Id: *descs[index].LimitId,
+		},
+	})
+	assert.Nil(s.T(), err)
+}
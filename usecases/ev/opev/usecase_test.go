@@ -0,0 +1,91 @@
+package opev
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/eebus-go/usecases/internal"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvOPEVSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvOPEVSuite) Test_AddFeatures() {
+	lc, err := server.NewLoadControl(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := lc.GetLimitDescriptionsForFilter(internal.EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = lc.GetLimitDescriptionsForFilter(internal.EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(descs))
+
+	measurement, err := server.NewMeasurement(s.evEntity)
+	assert.Nil(s.T(), err)
+
+	for index, desc := range descs {
+		assert.Equal(s.T(), s.sut.measurementIds[index], *desc.MeasurementId)
+
+		data, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+			MeasurementId: desc.MeasurementId,
+		})
+		assert.Nil(s.T(), err)
+		assert.Equal(s.T(), 1, len(data))
+		assert.Equal(s.T(), model.ScopeTypeTypeACCurrent, *data[0].ScopeType)
+	}
+
+	data, err := lc.GetLimitDataForFilter(internal.EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+	assert.True(s.T(), *data[0].IsLimitChangeable)
+	assert.False(s.T(), *data[0].IsLimitActive)
+}
+
+func (s *EvOPEVSuite) Test_Phases() {
+	sut := NewOPEV(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), 3, len(sut.phases))
+
+	sut = NewOPEV(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeA)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeA}, sut.phases)
+}
+
+func (s *EvOPEVSuite) Test_loadControlWriteCB() {
+	msg0 := &spineapi.Message{}
+
+	s.sut.loadControlWriteCB(msg0)
+	assert.False(s.T(), s.eventCalled)
+
+	// no limit of this use case
+	msg1 := s.writeMessage(500, []model.LoadControlLimitDataType{
+		{
+			LimitId:       util.Ptr(model.LoadControlLimitIdType(10)),
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(10),
+		},
+	})
+	s.sut.loadControlWriteCB(msg1)
+	assert.False(s.T(), s.eventCalled)
+	assert.Equal(s.T(), 0, len(s.sut.PendingLoadControlLimits()))
+
+	msg2 := s.writeMessage(501, []model.LoadControlLimitDataType{
+		{
+			LimitId:       util.Ptr(model.LoadControlLimitIdType(0)),
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(10),
+		},
+	})
+	s.sut.loadControlWriteCB(msg2)
+	assert.True(s.T(), s.isEventCalled(WriteApprovalRequired))
+	assert.Equal(s.T(), 1, len(s.sut.PendingLoadControlLimits()))
+
+	// the same message is only added once
+	s.sut.loadControlWriteCB(msg2)
+	assert.Equal(s.T(), 1, len(s.sut.PendingLoadControlLimits()))
+}
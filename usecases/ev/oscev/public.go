@@ -0,0 +1,97 @@
+package oscev
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+)
+
+// deactivate all recommendation limits and deny all pending write limits,
+// invoked once the EV is disconnected
+func (e *OSCEV) ResetEVData() {
+	e.pendingMux.Lock()
+	for msgCounter, msg := range e.pendingLimits {
+		e.approveOrDenyLoadControlLimits(msg, false, "EV disconnected")
+		delete(e.pendingLimits, msgCounter)
+	}
+	e.pendingMux.Unlock()
+
+	_ = internal.DeactivatePhaseLimits(e.LocalEntity, internal.EVCurrentRecommendationLimitDescription)
+}
+
+// Scenario 1
+
+// set the minimum, maximum and standby charging current of the EV per phase
+//
+// the values are shared with the OPEV use case
+//
+//   - minimum: the minimum current in A for each phase, in the order of phases a, b and c
+//   - maximum: the maximum current in A for each phase, in the order of phases a, b and c
+//   - standby: the standby current in A for each phase, in the order of phases a, b and c
+func (e *OSCEV) SetCurrentLimits(minimum, maximum, standby []float64) error {
+	e.mux.Lock()
+	ids := e.measurementIds
+	e.mux.Unlock()
+
+	if len(ids) == 0 {
+		return api.ErrMetadataNotAvailable
+	}
+
+	return internal.UpdatePhasePermittedValues(e.LocalEntity, ids, minimum, maximum, standby)
+}
+
+// return the current loadcontrol recommendation limits
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such limit is (yet) available
+//   - and others
+func (e *OSCEV) LoadControlLimits() (limits []ucapi.LoadLimitsPhase, resultErr error) {
+	return internal.LocalPhaseLimits(e.LocalEntity, internal.EVCurrentRecommendationLimitDescription)
+}
+
+// return the currently pending incoming recommendation write limits
+func (e *OSCEV) PendingLoadControlLimits() map[model.MsgCounterType][]ucapi.LoadLimitsPhase {
+	result := make(map[model.MsgCounterType][]ucapi.LoadLimitsPhase)
+
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	for key, msg := range e.pendingLimits {
+		// elements are only added to the map if they contain limits of this use case
+		result[key] = internal.PhaseLimitsOfWriteData(
+			e.LocalEntity, internal.EVCurrentRecommendationLimitDescription, msg.Cmd.LoadControlLimitListData)
+	}
+
+	return result
+}
+
+// accept or deny an incoming recommendation write limit
+//
+// use PendingLoadControlLimits to get the list of currently pending requests
+func (e *OSCEV) ApproveOrDenyLoadControlLimits(msgCounter model.MsgCounterType, approve bool, reason string) {
+	e.pendingMux.Lock()
+	defer e.pendingMux.Unlock()
+
+	msg, ok := e.pendingLimits[msgCounter]
+	if !ok {
+		// no pending limit for this msgCounter, this is a caller error
+		return
+	}
+
+	e.approveOrDenyLoadControlLimits(msg, approve, reason)
+
+	delete(e.pendingLimits, msgCounter)
+}
+
+// return the effective charging current limit per phase
+//
+// the maximum permitted current, reduced by the active obligation limits of the OPEV use case
+// and the active recommendation limits
+//
+// possible errors:
+//   - ErrDataNotAvailable if no permitted values are available
+//   - and others
+func (e *OSCEV) EffectiveCurrentLimits() ([]float64, error) {
+	return internal.EffectiveEVCurrentLimits(e.LocalEntity)
+}
@@ -0,0 +1,119 @@
+package oscev
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	"github.com/enbility/eebus-go/usecases/ev/evcc"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const remoteSki string = "testremoteski"
+
+func TestEvOSCEVSuite(t *testing.T) {
+	suite.Run(t, new(EvOSCEVSuite))
+}
+
+type EvOSCEVSuite struct {
+	suite.Suite
+
+	sut *OSCEV
+
+	service api.ServiceInterface
+
+	evcc     *evcc.EVCC
+	evEntity spineapi.EntityLocalInterface
+
+	remoteDevice *spinemocks.DeviceRemoteInterface
+	cemEntity    *spinemocks.EntityRemoteInterface
+
+	mux          sync.Mutex
+	eventCalled  bool
+	eventsCalled []api.EventType
+}
+
+func (s *EvOSCEVSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.eventCalled = true
+	s.eventsCalled = append(s.eventsCalled, event)
+}
+
+func (s *EvOSCEVSuite) BeforeTest(suiteName, testName string) {
+	s.eventCalled = false
+	s.eventsCalled = nil
+
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.remoteDevice = spinemocks.NewDeviceRemoteInterface(s.T())
+	s.remoteDevice.EXPECT().Ski().Return(remoteSki).Maybe()
+	s.cemEntity = spinemocks.NewEntityRemoteInterface(s.T())
+	s.cemEntity.EXPECT().Device().Return(s.remoteDevice).Maybe()
+	s.cemEntity.EXPECT().EntityType().Return(model.EntityTypeTypeCEM).Maybe()
+
+	evseEntity := s.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+	s.evcc = evcc.NewEVCC(s.service, evseEntity, s.Event)
+	s.evcc.AddFeatures()
+	s.evcc.AddUseCase()
+	s.evEntity = s.evcc.LocalEntity
+
+	s.sut = NewOSCEV(s.evEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+
+	s.evcc.AddEVDataUseCase(s.sut)
+	s.evcc.EVConnected()
+}
+
+// return if an event was invoked
+func (s *EvOSCEVSuite) isEventCalled(event api.EventType) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, item := range s.eventsCalled {
+		if item == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// return an incoming write message of the CEM
+func (s *EvOSCEVSuite) writeMessage(msgCounter model.MsgCounterType, data []model.LoadControlLimitDataType) *spineapi.Message {
+	return &spineapi.Message{
+		RequestHeader: &model.HeaderType{
+			MsgCounter: &msgCounter,
+		},
+		Cmd: model.CmdType{
+			LoadControlLimitListData: &model.LoadControlLimitListDataType{
+				LoadControlLimitData: data,
+			},
+		},
+		DeviceRemote: s.remoteDevice,
+		EntityRemote: s.cemEntity,
+	}
+}
@@ -0,0 +1,26 @@
+package oscev
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "ev-oscev-UseCaseSupportUpdate"
+
+	// Load control obligation limit data update received
+	//
+	// Use `LoadControlLimits` to get the current data
+	// or `EffectiveCurrentLimits` to get the limits that have to be applied
+	//
+	// Use Case OSCEV, Scenario 1
+	DataUpdateLimit api.EventType = "ev-oscev-DataUpdateLimit"
+
+	// An incoming load control recommendation limit needs to be approved or denied
+	//
+	// Use `PendingLoadControlLimits` to get the currently pending write approval requests
+	// and invoke `ApproveOrDenyLoadControlLimits` for each
+	//
+	// Use Case OSCEV, Scenario 1
+	WriteApprovalRequired api.EventType = "ev-oscev-WriteApprovalRequired"
+)
@@ -0,0 +1,181 @@
+package oscev
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection the phase specific current limits refer to
+//
+// this is identical to the ElectricalConnectionId used in the EV EVCC implementation
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type OSCEV struct {
+	*usecase.UseCaseBase
+
+	phases []model.ElectricalConnectionPhaseNameType
+
+	measurementIds []model.MeasurementIdType
+
+	pendingMux    sync.Mutex
+	pendingLimits map[model.MsgCounterType]*spineapi.Message
+
+	mux sync.Mutex
+}
+
+var _ ucapi.EvOSCEVInterface = (*OSCEV)(nil)
+
+// Create a new EV OSCEV use case
+//
+// The use case has to be added to the EV entity provided by the EV EVCC use case,
+// and should be registered there via AddEVDataUseCase, so the limits
+// are deactivated once the EV is disconnected.
+//
+// parameters:
+//   - localEntity: the local EV entity providing the limits
+//   - eventCB: the callback for use case events
+//   - phases: the phases the limits are provided for, e.g. "abc" or "a",
+//     defaults to "abc" if not a valid phase set
+func NewOSCEV(
+	localEntity spineapi.EntityLocalInterface,
+	eventCB api.EntityEventCallback,
+	phases model.ElectricalConnectionPhaseNameType,
+) *OSCEV {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(2),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
+		},
+		{
+			Scenario:       model.UseCaseScenarioSupportType(3),
+			Mandatory:      true,
+			ServerFeatures: []model.FeatureTypeType{model.FeatureTypeTypeDeviceDiagnosis},
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeEV,
+		model.UseCaseNameTypeOptimizationOfSelfConsumptionDuringEVCharging,
+		"1.0.1",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	phaseList := internal.PhasesOfPhaseSet(phases)
+	if len(phaseList) == 0 {
+		phaseList = internal.PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAbc)
+	}
+
+	uc := &OSCEV{
+		UseCaseBase:   usecase,
+		phases:        phaseList,
+		pendingLimits: make(map[model.MsgCounterType]*spineapi.Message),
+	}
+
+	_ = spine.Events.Subscribe(uc)
+
+	return uc
+}
+
+func (e *OSCEV) approveOrDenyLoadControlLimits(msg *spineapi.Message, approve bool, reason string) {
+	f := e.LocalEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+
+	result := model.ErrorType{
+		ErrorNumber: model.ErrorNumberType(0),
+	}
+
+	if !approve {
+		result.ErrorNumber = model.ErrorNumberType(7)
+		result.Description = util.Ptr(model.DescriptionType(reason))
+	}
+	f.ApproveOrDenyWrite(msg, result)
+}
+
+// callback invoked on incoming write messages to this
+// loadcontrol server feature.
+// the implementation only considers write messages for this use case and
+// approves all others
+func (e *OSCEV) loadControlWriteCB(msg *spineapi.Message) {
+	if msg.RequestHeader == nil || msg.RequestHeader.MsgCounter == nil ||
+		msg.Cmd.LoadControlLimitListData == nil {
+		logging.Log().Debug("OSCEV loadControlWriteCB: invalid message")
+		return
+	}
+
+	limits := internal.PhaseLimitsOfWriteData(
+		e.LocalEntity, internal.EVCurrentRecommendationLimitDescription, msg.Cmd.LoadControlLimitListData)
+	if len(limits) == 0 {
+		// approve, because this is no request for this usecase
+		go e.approveOrDenyLoadControlLimits(msg, true, "")
+		return
+	}
+
+	e.pendingMux.Lock()
+	if _, ok := e.pendingLimits[*msg.RequestHeader.MsgCounter]; ok {
+		e.pendingMux.Unlock()
+		return
+	}
+	e.pendingLimits[*msg.RequestHeader.MsgCounter] = msg
+	e.pendingMux.Unlock()
+
+	if e.EventCB != nil {
+		e.EventCB(msg.DeviceRemote.Ski(), msg.DeviceRemote, msg.EntityRemote, WriteApprovalRequired)
+	}
+}
+
+func (e *OSCEV) AddFeatures() {
+	// client features
+	_ = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeClient)
+
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeLoadControlLimitDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeLoadControlLimitListData, true, true)
+	_ = f.AddWriteApprovalCallback(e.loadControlWriteCB)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionPermittedValueSetListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the limits refer to the current measurements of each phase
+	ids, err := internal.PhaseCurrentMeasurementIds(e.LocalEntity, electricalConnectionId, e.phases)
+	if err != nil {
+		logging.Log().Debug("OSCEV AddFeatures: error adding current descriptions", err)
+		return
+	}
+	e.measurementIds = ids
+
+	if err := internal.AddPhaseLimitDescriptions(e.LocalEntity, internal.EVCurrentRecommendationLimitDescription, ids); err != nil {
+		logging.Log().Debug("OSCEV AddFeatures: error adding limit descriptions", err)
+	}
+}
@@ -0,0 +1,40 @@
+package evsecc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the manufacturer data of the EVSE
+//
+//   - data: the manufacturer data, empty fields are not provided
+func (e *EVSECC) SetManufacturerData(data api.ManufacturerData) error {
+	dc, err := server.NewDeviceClassification(e.LocalEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	dc.SetManufacturerData(data)
+
+	return nil
+}
+
+// Scenario 2
+
+// set the operating state of the EVSE
+//
+//   - state: the operating state
+//   - errorMessage: the last error code, only provided if not empty
+func (e *EVSECC) SetOperatingState(state model.DeviceDiagnosisOperatingStateType, errorMessage string) error {
+	dd, err := server.NewDeviceDiagnosis(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	dd.SetLocalOperatingStateWithLastErrorCode(state, errorMessage)
+
+	return nil
+}
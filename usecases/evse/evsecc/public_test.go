@@ -0,0 +1,47 @@
+package evsecc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvseEVSECCSuite) Test_SetManufacturerData() {
+	err := s.sut.SetManufacturerData(api.ManufacturerData{
+		DeviceName:   "wallbox",
+		SerialNumber: "1234",
+	})
+	assert.Nil(s.T(), err)
+
+	f := s.evseEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	data, err := spine.LocalFeatureDataCopyOfType[*model.DeviceClassificationManufacturerDataType](
+		f, model.FunctionTypeDeviceClassificationManufacturerData)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("wallbox"), *data.DeviceName)
+	assert.Equal(s.T(), model.DeviceClassificationStringType("1234"), *data.SerialNumber)
+	assert.Nil(s.T(), data.BrandName)
+}
+
+func (s *EvseEVSECCSuite) Test_SetOperatingState() {
+	err := s.sut.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeFailure, "contactor error")
+	assert.Nil(s.T(), err)
+
+	dd, err := server.NewDeviceDiagnosis(s.evseEntity)
+	assert.Nil(s.T(), err)
+
+	state, err := dd.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeFailure, *state.OperatingState)
+	assert.Equal(s.T(), model.LastErrorCodeType("contactor error"), *state.LastErrorCode)
+
+	// the error code is removed with the next state
+	err = s.sut.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeNormalOperation, "")
+	assert.Nil(s.T(), err)
+
+	state, err = dd.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, *state.OperatingState)
+	assert.Nil(s.T(), state.LastErrorCode)
+}
@@ -0,0 +1,54 @@
+package evsecc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestEvseEVSECCSuite(t *testing.T) {
+	suite.Run(t, new(EvseEVSECCSuite))
+}
+
+type EvseEVSECCSuite struct {
+	suite.Suite
+
+	sut *EVSECC
+
+	service api.ServiceInterface
+
+	evseEntity spineapi.EntityLocalInterface
+}
+
+func (s *EvseEVSECCSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *EvseEVSECCSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeEMobility},
+		model.DeviceTypeTypeChargingStation,
+		[]model.EntityTypeType{model.EntityTypeTypeEVSE},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.evseEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeEVSE)
+	s.sut = NewEVSECC(s.evseEntity, s.Event)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+}
@@ -0,0 +1,72 @@
+package evsecc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+type EVSECC struct {
+	*usecase.UseCaseBase
+}
+
+var _ ucapi.EvseEVSECCInterface = (*EVSECC)(nil)
+
+// Create a new EVSE EVSECC use case
+//
+// parameters:
+//   - localEntity: the local EVSE entity providing the manufacturer data and operating state
+//   - eventCB: the callback for use case events
+func NewEVSECC(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *EVSECC {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario: model.UseCaseScenarioSupportType(1),
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeEVSE,
+		model.UseCaseNameTypeEVSECommissioningAndConfiguration,
+		"1.0.1",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &EVSECC{
+		UseCaseBase: usecase,
+	}
+
+	return uc
+}
+
+func (e *EVSECC) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceClassificationManufacturerData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceDiagnosis, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceDiagnosisStateData, true, false)
+
+	// the operating state is mandatory, so provide it right away
+	if dd, err := server.NewDeviceDiagnosis(e.LocalEntity); err == nil {
+		if state, err := dd.GetState(); err != nil || state.OperatingState == nil {
+			dd.SetLocalOperatingState(model.DeviceDiagnosisOperatingStateTypeNormalOperation)
+		}
+	}
+}
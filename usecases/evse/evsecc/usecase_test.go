@@ -0,0 +1,32 @@
+package evsecc
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *EvseEVSECCSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *EvseEVSECCSuite) Test_AddFeatures() {
+	f := s.evseEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeDeviceClassification, model.RoleTypeServer)
+	assert.NotNil(s.T(), f)
+
+	dd, err := server.NewDeviceDiagnosis(s.evseEntity)
+	assert.Nil(s.T(), err)
+
+	state, err := dd.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeNormalOperation, *state.OperatingState)
+
+	// adding the features again keeps the current state
+	err = s.sut.SetOperatingState(model.DeviceDiagnosisOperatingStateTypeFailure, "error")
+	assert.Nil(s.T(), err)
+	s.sut.AddFeatures()
+
+	state, err = dd.GetState()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DeviceDiagnosisOperatingStateTypeFailure, *state.OperatingState)
+}
@@ -0,0 +1,88 @@
+package mgcp
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// Scenario 1
+
+// set the current power limitation factor
+//
+//   - factor: the factor of the PV peak power the production is limited to, e.g. 0.7
+func (e *MGCP) UpdatePowerLimitationFactor(factor float64) error {
+	dcs, err := server.NewDeviceConfiguration(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	data := model.DeviceConfigurationKeyValueDataType{
+		Value: &model.DeviceConfigurationKeyValueValueType{
+			ScaledNumber: model.NewScaledNumberType(factor),
+		},
+		IsValueChangeable: util.Ptr(false),
+	}
+	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor),
+	}
+	return dcs.UpdateKeyValueDataForFilter(data, nil, filter)
+}
+
+// Scenario 2
+
+// set the momentary power consumption or production at the grid connection point
+//
+//   - power: the power in W
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *MGCP) UpdatePower(power float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACPowerTotal, []float64{power})
+}
+
+// Scenario 3
+
+// set the total feed in energy at the grid connection point
+//
+//   - energy: the energy in Wh
+func (e *MGCP) UpdateEnergyFeedIn(energy float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeGridFeedIn, []float64{energy})
+}
+
+// Scenario 4
+
+// set the total consumption energy at the grid connection point
+//
+//   - energy: the energy in Wh
+func (e *MGCP) UpdateEnergyConsumed(energy float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeGridConsumption, []float64{energy})
+}
+
+// Scenario 5
+
+// set the momentary current consumption or production at the grid connection point
+//
+//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *MGCP) UpdateCurrentPerPhase(phaseCurrent []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACCurrent, phaseCurrent)
+}
+
+// Scenario 6
+
+// set the voltage phase details at the grid connection point
+//
+//   - phaseVoltage: the voltage in V for each connected phase, in the order of phases a, b and c
+func (e *MGCP) UpdateVoltagePerPhase(phaseVoltage []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACVoltage, phaseVoltage)
+}
+
+// Scenario 7
+
+// set the frequency at the grid connection point
+//
+//   - frequency: the frequency in Hz
+func (e *MGCP) UpdateFrequency(frequency float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACFrequency, []float64{frequency})
+}
@@ -0,0 +1,86 @@
+package mgcp
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *GcpMGCPSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *GcpMGCPSuite) Test_PowerLimitationFactor() {
+	err := s.sut.UpdatePowerLimitationFactor(0.5)
+	assert.Nil(s.T(), err)
+
+	dcs, err := server.NewDeviceConfiguration(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor),
+	}
+	data, err := dcs.GetKeyValueDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+	assert.Equal(s.T(), 0.5, data.Value.ScaledNumber.GetValue())
+}
+
+func (s *GcpMGCPSuite) Test_Power() {
+	err := s.sut.UpdatePower(-3000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{-3000}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+}
+
+func (s *GcpMGCPSuite) Test_Energy() {
+	err := s.sut.UpdateEnergyFeedIn(1000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.valuesForScope(model.ScopeTypeTypeGridFeedIn))
+
+	err = s.sut.UpdateEnergyConsumed(500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{500}, s.valuesForScope(model.ScopeTypeTypeGridConsumption))
+}
+
+func (s *GcpMGCPSuite) Test_CurrentVoltageFrequency() {
+	err := s.sut.UpdateCurrentPerPhase([]float64{10, 11})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 11, 12}, s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	err = s.sut.UpdateVoltagePerPhase([]float64{230, 231, 232})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 232}, s.valuesForScope(model.ScopeTypeTypeACVoltage))
+
+	err = s.sut.UpdateFrequency(50)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{50}, s.valuesForScope(model.ScopeTypeTypeACFrequency))
+}
+
+func (s *GcpMGCPSuite) Test_NoFeatures() {
+	sut := NewMGCP(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+
+	// AddFeatures was not invoked on this instance
+	err := sut.UpdatePower(1000)
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+}
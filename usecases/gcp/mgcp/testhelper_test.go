@@ -0,0 +1,54 @@
+package mgcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/mocks"
+	"github.com/enbility/eebus-go/service"
+	shipapi "github.com/enbility/ship-go/api"
+	"github.com/enbility/ship-go/cert"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestGcpMGCPSuite(t *testing.T) {
+	suite.Run(t, new(GcpMGCPSuite))
+}
+
+type GcpMGCPSuite struct {
+	suite.Suite
+
+	sut *MGCP
+
+	service api.ServiceInterface
+
+	localEntity spineapi.EntityLocalInterface
+}
+
+func (s *GcpMGCPSuite) Event(ski string, device spineapi.DeviceRemoteInterface, entity spineapi.EntityRemoteInterface, event api.EventType) {
+}
+
+func (s *GcpMGCPSuite) BeforeTest(suiteName, testName string) {
+	cert, _ := cert.CreateCertificate("test", "test", "DE", "test")
+	configuration, _ := api.NewConfiguration(
+		"test", "test", "test", "test",
+		[]shipapi.DeviceCategoryType{shipapi.DeviceCategoryTypeGridConnectionHub},
+		model.DeviceTypeTypeElectricitySupplySystem,
+		[]model.EntityTypeType{model.EntityTypeTypeGridConnectionPointOfPremises},
+		9999, cert, time.Second*4)
+
+	serviceHandler := mocks.NewServiceReaderInterface(s.T())
+	serviceHandler.EXPECT().ServicePairingDetailUpdate(mock.Anything, mock.Anything).Return().Maybe()
+
+	s.service = service.NewService(configuration, serviceHandler)
+	_ = s.service.Setup()
+
+	s.localEntity = s.service.LocalDevice().EntityForType(model.EntityTypeTypeGridConnectionPointOfPremises)
+	s.sut = NewMGCP(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+	s.sut.AddFeatures()
+	s.sut.AddUseCase()
+}
@@ -0,0 +1,10 @@
+package mgcp
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "gcp-mgcp-UseCaseSupportUpdate"
+)
@@ -0,0 +1,56 @@
+package mgcp
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *GcpMGCPSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *GcpMGCPSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// power, energy feed in and consumed, 3x current, 3x voltage, frequency
+	assert.Equal(s.T(), 10, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10, len(descs))
+
+	dcs, err := server.NewDeviceConfiguration(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	keyDescs, err := dcs.GetKeyValueDescriptionsForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(keyDescs))
+	assert.Equal(s.T(), model.DeviceConfigurationKeyNameTypePvCurtailmentLimitFactor, *keyDescs[0].KeyName)
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	desc, err := ec.GetDescriptionForParameterDescriptionFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		ParameterId: util.Ptr(model.ElectricalConnectionParameterIdType(0)),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(3), *desc.AcConnectedPhases)
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *desc.PositiveEnergyDirection)
+}
+
+func (s *GcpMGCPSuite) Test_ConnectedPhases() {
+	sut := NewMGCP(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeAbc, sut.connectedPhases)
+	assert.Equal(s.T(), 3, len(sut.phases))
+
+	sut = NewMGCP(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeA)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, sut.connectedPhases)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeA}, sut.phases)
+}
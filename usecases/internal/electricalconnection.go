@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// set the permitted values of the parameter descriptions linked to
+// phase specific measurements of the local electrical connection server
+//
+// the values are applied to the measurementIds at the same index
+//
+// parameters:
+//   - measurementIds: the ids of the phase specific measurements
+//   - minimum: the minimum value per phase
+//   - maximum: the maximum value per phase
+//   - standby: the standby value per phase
+func UpdatePhasePermittedValues(
+	localEntity spineapi.EntityLocalInterface,
+	measurementIds []model.MeasurementIdType,
+	minimum, maximum, standby []float64,
+) error {
+	if len(measurementIds) == 0 ||
+		len(measurementIds) != len(minimum) ||
+		len(measurementIds) != len(maximum) ||
+		len(measurementIds) != len(standby) {
+		return api.ErrMissingData
+	}
+
+	electricalConnection, err := server.NewElectricalConnection(localEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	var data []api.ElectricalConnectionPermittedValueSetForFilter
+	for index := range measurementIds {
+		data = append(data, api.ElectricalConnectionPermittedValueSetForFilter{
+			Data: model.ElectricalConnectionPermittedValueSetDataType{
+				PermittedValueSet: []model.ScaledNumberSetType{
+					{
+						Value: []model.ScaledNumberType{*model.NewScaledNumberType(standby[index])},
+						Range: []model.ScaledNumberRangeType{
+							{
+								Min: model.NewScaledNumberType(minimum[index]),
+								Max: model.NewScaledNumberType(maximum[index]),
+							},
+						},
+					},
+				},
+			},
+			Filter: model.ElectricalConnectionParameterDescriptionDataType{
+				MeasurementId: &measurementIds[index],
+			},
+		})
+	}
+
+	return electricalConnection.UpdatePermittedValueSetForFilters(data, nil, nil)
+}
@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *InternalSuite) Test_UpdatePhasePermittedValues() {
+	err := UpdatePhasePermittedValues(s.localEntity, nil, nil, nil, nil)
+	assert.NotNil(s.T(), err)
+
+	ids, err := PhaseCurrentMeasurementIds(s.localEntity, 0, PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAbc))
+	assert.Nil(s.T(), err)
+
+	err = UpdatePhasePermittedValues(s.localEntity, ids, []float64{6, 6}, []float64{16, 16}, []float64{0, 0})
+	assert.NotNil(s.T(), err)
+
+	err = UpdatePhasePermittedValues(nil, ids, []float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0})
+	assert.NotNil(s.T(), err)
+
+	err = UpdatePhasePermittedValues(s.localEntity, ids, []float64{6, 6, 6}, []float64{16, 16, 10}, []float64{0, 0, 0})
+	assert.Nil(s.T(), err)
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		MeasurementId: &ids[2],
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(params))
+
+	minimum, maximum, standby, err := ec.GetPermittedValueDataForFilter(model.ElectricalConnectionPermittedValueSetDataType{
+		ParameterId: params[0].ParameterId,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 6.0, minimum)
+	assert.Equal(s.T(), 10.0, maximum)
+	assert.Equal(s.T(), 0.0, standby)
+}
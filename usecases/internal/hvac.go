@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"slices"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// return the setpoint filters of the setpoints related to the HVAC system functions
+// of a given type, e.g. heating or cooling
+//
+// each returned filter is a copy of the given filter with the setpointId of a related setpoint
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such system function or setpoint relation is (yet) available
+//   - and others
+func HvacSetpointFilters(
+	localEntity spineapi.EntityLocalInterface,
+	remoteEntity spineapi.EntityRemoteInterface,
+	systemFunctionType model.HvacSystemFunctionTypeType,
+	filter model.SetpointDescriptionDataType,
+) ([]model.SetpointDescriptionDataType, error) {
+	hvac, err := client.NewHvac(localEntity, remoteEntity)
+	if err != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	descriptionFilter := model.HvacSystemFunctionDescriptionDataType{
+		SystemFunctionType: &systemFunctionType,
+	}
+	descriptions, err := hvac.GetSystemFunctionDescriptionsForFilter(descriptionFilter)
+	if err != nil || len(descriptions) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	var result []model.SetpointDescriptionDataType
+
+	for _, desc := range descriptions {
+		if desc.SystemFunctionId == nil {
+			continue
+		}
+
+		relationFilter := model.HvacSystemFunctionSetpointRelationDataType{
+			SystemFunctionId: desc.SystemFunctionId,
+		}
+		relations, err := hvac.GetSystemFunctionSetpointRelationsForFilter(relationFilter)
+		if err != nil {
+			continue
+		}
+
+		for _, relation := range relations {
+			if relation.SetpointId == nil {
+				continue
+			}
+
+			// a setpoint may be related to multiple operation modes
+			setpointId := *relation.SetpointId
+			if slices.ContainsFunc(result, func(item model.SetpointDescriptionDataType) bool {
+				return *item.SetpointId == setpointId
+			}) {
+				continue
+			}
+
+			setpointFilter := filter
+			setpointFilter.SetpointId = util.Ptr(setpointId)
+			result = append(result, setpointFilter)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
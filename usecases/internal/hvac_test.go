@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	spinemocks "github.com/enbility/spine-go/mocks"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *InternalSuite) Test_HvacSetpointFilters() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	data, err := HvacSetpointFilters(s.localEntity, nil, model.HvacSystemFunctionTypeTypeHeating, filter)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+	assert.Nil(s.T(), data)
+
+	// the SPINE function data of this version can not hold the system function
+	// description list, so the HVAC feature is mocked
+	var descriptions *model.HvacSystemFunctionDescriptionListDataType
+	var relations *model.HvacSystemFunctionSetpointRelationListDataType
+
+	hvacFeature := spinemocks.NewFeatureRemoteInterface(s.T())
+	hvacFeature.EXPECT().Type().Return(model.FeatureTypeTypeHvac).Maybe()
+	hvacFeature.EXPECT().Role().Return(model.RoleTypeServer).Maybe()
+	hvacFeature.EXPECT().DataCopy(mock.Anything).RunAndReturn(func(function model.FunctionType) any {
+		if function == model.FunctionTypeHvacSystemFunctionDescriptionListData {
+			return descriptions
+		}
+		return relations
+	}).Maybe()
+	s.monitoredEntity.AddFeature(hvacFeature)
+
+	data, err = HvacSetpointFilters(s.localEntity, s.monitoredEntity, model.HvacSystemFunctionTypeTypeHeating, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	descriptions = &model.HvacSystemFunctionDescriptionListDataType{
+		HvacSystemFunctionDescriptionData: []model.HvacSystemFunctionDescriptionDataType{
+			{},
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(1)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeHeating),
+			},
+			{
+				SystemFunctionId:   util.Ptr(model.HvacSystemFunctionIdType(2)),
+				SystemFunctionType: util.Ptr(model.HvacSystemFunctionTypeTypeCooling),
+			},
+		},
+	}
+
+	data, err = HvacSetpointFilters(s.localEntity, s.monitoredEntity, model.HvacSystemFunctionTypeTypeHeating, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	relations = &model.HvacSystemFunctionSetpointRelationListDataType{
+		HvacSystemFunctionSetpointRelationData: []model.HvacSystemFunctionSetpointRelationDataType{
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(1)),
+			},
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(1)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+			},
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(1)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(1)),
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+			},
+			{
+				SystemFunctionId: util.Ptr(model.HvacSystemFunctionIdType(2)),
+				OperationModeId:  util.Ptr(model.HvacOperationModeIdType(0)),
+				SetpointId:       util.Ptr(model.SetpointIdType(1)),
+			},
+		},
+	}
+
+	data, err = HvacSetpointFilters(s.localEntity, s.monitoredEntity, model.HvacSystemFunctionTypeTypeHeating, filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.SetpointDescriptionDataType{
+		{
+			SetpointId:   util.Ptr(model.SetpointIdType(0)),
+			SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+		},
+	}, data)
+
+	data, err = HvacSetpointFilters(s.localEntity, s.monitoredEntity, model.HvacSystemFunctionTypeTypeCooling, filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []model.SetpointDescriptionDataType{
+		{
+			SetpointId:   util.Ptr(model.SetpointIdType(1)),
+			SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+		},
+	}, data)
+
+	data, err = HvacSetpointFilters(s.localEntity, s.monitoredEntity, model.HvacSystemFunctionTypeTypeDhw, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// return the tariff descriptions of the incentive table descriptions
+//
+// tiers, boundaries and incentives without an id are ignored
+func IncentiveTariffDescriptions(data []model.IncentiveTableDescriptionType) []ucapi.IncentiveTariffDescription {
+	var result []ucapi.IncentiveTariffDescription
+
+	for _, item := range data {
+		tariff := ucapi.IncentiveTariffDescription{}
+
+		for _, tier := range item.Tier {
+			if tier.TierDescription == nil || tier.TierDescription.TierId == nil {
+				continue
+			}
+
+			newTier := ucapi.IncentiveTableDescriptionTier{
+				Id: uint(*tier.TierDescription.TierId),
+			}
+			if tier.TierDescription.TierType != nil {
+				newTier.Type = *tier.TierDescription.TierType
+			}
+
+			for _, boundary := range tier.BoundaryDescription {
+				if boundary.BoundaryId == nil {
+					continue
+				}
+
+				newBoundary := ucapi.TierBoundaryDescription{
+					Id: uint(*boundary.BoundaryId),
+				}
+				if boundary.BoundaryType != nil {
+					newBoundary.Type = *boundary.BoundaryType
+				}
+				if boundary.BoundaryUnit != nil {
+					newBoundary.Unit = *boundary.BoundaryUnit
+				}
+				newTier.Boundaries = append(newTier.Boundaries, newBoundary)
+			}
+
+			for _, incentive := range tier.IncentiveDescription {
+				if incentive.IncentiveId == nil {
+					continue
+				}
+
+				newIncentive := ucapi.IncentiveDescription{
+					Id: uint(*incentive.IncentiveId),
+				}
+				if incentive.IncentiveType != nil {
+					newIncentive.Type = *incentive.IncentiveType
+				}
+				if incentive.Currency != nil {
+					newIncentive.Currency = *incentive.Currency
+				}
+				newTier.Incentives = append(newTier.Incentives, newIncentive)
+			}
+
+			tariff.Tiers = append(tariff.Tiers, newTier)
+		}
+
+		result = append(result, tariff)
+	}
+
+	return result
+}
+
+// return the incentive table description tiers of a tariff description
+//
+// the currency of an incentive is only set if it is provided
+func IncentiveTableDescriptionTiers(tiers []ucapi.IncentiveTableDescriptionTier) []model.IncentiveTableDescriptionTierType {
+	result := []model.IncentiveTableDescriptionTierType{}
+
+	for _, tier := range tiers {
+		newTier := model.IncentiveTableDescriptionTierType{
+			TierDescription: &model.TierDescriptionDataType{
+				TierId:   util.Ptr(model.TierIdType(tier.Id)),
+				TierType: util.Ptr(tier.Type),
+			},
+		}
+
+		boundaryDescription := []model.TierBoundaryDescriptionDataType{}
+		for _, boundary := range tier.Boundaries {
+			newBoundary := model.TierBoundaryDescriptionDataType{
+				BoundaryId:   util.Ptr(model.TierBoundaryIdType(boundary.Id)),
+				BoundaryType: util.Ptr(boundary.Type),
+				BoundaryUnit: util.Ptr(boundary.Unit),
+			}
+			boundaryDescription = append(boundaryDescription, newBoundary)
+		}
+		newTier.BoundaryDescription = boundaryDescription
+
+		incentiveDescription := []model.IncentiveDescriptionDataType{}
+		for _, incentive := range tier.Incentives {
+			newIncentive := model.IncentiveDescriptionDataType{
+				IncentiveId:   util.Ptr(model.IncentiveIdType(incentive.Id)),
+				IncentiveType: util.Ptr(incentive.Type),
+			}
+			if incentive.Currency != "" {
+				newIncentive.Currency = util.Ptr(incentive.Currency)
+			}
+			incentiveDescription = append(incentiveDescription, newIncentive)
+		}
+		newTier.IncentiveDescription = incentiveDescription
+
+		result = append(result, newTier)
+	}
+
+	return result
+}
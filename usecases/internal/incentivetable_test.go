@@ -0,0 +1,95 @@
+package internal
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *InternalSuite) Test_IncentiveTable() {
+	tariffs := IncentiveTariffDescriptions(nil)
+	assert.Nil(s.T(), tariffs)
+
+	tiers := IncentiveTableDescriptionTiers(nil)
+	assert.Equal(s.T(), 0, len(tiers))
+
+	data := []model.IncentiveTableDescriptionType{
+		{
+			TariffDescription: &model.TariffDescriptionDataType{
+				TariffId: util.Ptr(model.TariffIdType(0)),
+			},
+			Tier: []model.IncentiveTableDescriptionTierType{
+				{},
+				{
+					TierDescription: &model.TierDescriptionDataType{
+						TierId:   util.Ptr(model.TierIdType(1)),
+						TierType: util.Ptr(model.TierTypeTypeDynamicCost),
+					},
+					BoundaryDescription: []model.TierBoundaryDescriptionDataType{
+						{},
+						{
+							BoundaryId:   util.Ptr(model.TierBoundaryIdType(2)),
+							BoundaryType: util.Ptr(model.TierBoundaryTypeTypePowerBoundary),
+							BoundaryUnit: util.Ptr(model.UnitOfMeasurementTypeW),
+						},
+					},
+					IncentiveDescription: []model.IncentiveDescriptionDataType{
+						{},
+						{
+							IncentiveId:   util.Ptr(model.IncentiveIdType(3)),
+							IncentiveType: util.Ptr(model.IncentiveTypeTypeAbsoluteCost),
+							Currency:      util.Ptr(model.CurrencyTypeEur),
+						},
+						{
+							IncentiveId:   util.Ptr(model.IncentiveIdType(4)),
+							IncentiveType: util.Ptr(model.IncentiveTypeTypeRenewableEnergyPercentage),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []ucapi.IncentiveTariffDescription{
+		{
+			Tiers: []ucapi.IncentiveTableDescriptionTier{
+				{
+					Id:   1,
+					Type: model.TierTypeTypeDynamicCost,
+					Boundaries: []ucapi.TierBoundaryDescription{
+						{
+							Id:   2,
+							Type: model.TierBoundaryTypeTypePowerBoundary,
+							Unit: model.UnitOfMeasurementTypeW,
+						},
+					},
+					Incentives: []ucapi.IncentiveDescription{
+						{
+							Id:       3,
+							Type:     model.IncentiveTypeTypeAbsoluteCost,
+							Currency: model.CurrencyTypeEur,
+						},
+						{
+							Id:   4,
+							Type: model.IncentiveTypeTypeRenewableEnergyPercentage,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tariffs = IncentiveTariffDescriptions(data)
+	assert.Equal(s.T(), expected, tariffs)
+
+	tiers = IncentiveTableDescriptionTiers(tariffs[0].Tiers)
+	assert.Equal(s.T(), 1, len(tiers))
+	assert.Equal(s.T(), data[0].Tier[1].TierDescription, tiers[0].TierDescription)
+	assert.Equal(s.T(), data[0].Tier[1].BoundaryDescription[1:], tiers[0].BoundaryDescription)
+	assert.Equal(s.T(), data[0].Tier[1].IncentiveDescription[1:], tiers[0].IncentiveDescription)
+
+	// the conversion is reversible
+	tariffs = IncentiveTariffDescriptions([]model.IncentiveTableDescriptionType{{Tier: tiers}})
+	assert.Equal(s.T(), expected, tariffs)
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// generic helper to be used in UCLPC & UCLPP
+// schedules the expiry of a limit with a duration
+type LimitExpiryTimer struct {
+	timer *time.Timer
+
+	// incremented with every change, so an already fired timer
+	// of a replaced limit does not invoke its callback
+	generation uint64
+
+	mux sync.Mutex
+}
+
+// schedule the callback to be invoked once the duration elapsed
+//
+// a previously scheduled expiry is cancelled
+func (l *LimitExpiryTimer) Schedule(duration time.Duration, expiredCB func()) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.stop()
+
+	generation := l.generation
+	l.timer = time.AfterFunc(duration, func() {
+		l.mux.Lock()
+		if generation != l.generation {
+			l.mux.Unlock()
+			return
+		}
+		l.timer = nil
+		l.mux.Unlock()
+
+		expiredCB()
+	})
+}
+
+// cancel a scheduled expiry
+func (l *LimitExpiryTimer) Cancel() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.stop()
+}
+
+// return if an expiry is currently scheduled
+func (l *LimitExpiryTimer) IsScheduled() bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return l.timer != nil
+}
+
+// has to be invoked with a locked mutex
+func (l *LimitExpiryTimer) stop() {
+	l.generation++
+
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LimitExpiryTimer(t *testing.T) {
+	sut := &LimitExpiryTimer{}
+	assert.False(t, sut.IsScheduled())
+
+	var expired atomic.Int32
+	expiredCB := func() {
+		expired.Add(1)
+	}
+
+	sut.Schedule(time.Millisecond*20, expiredCB)
+	assert.True(t, sut.IsScheduled())
+
+	assert.Eventually(t, func() bool { return expired.Load() == 1 }, time.Second, time.Millisecond*5)
+	assert.False(t, sut.IsScheduled())
+
+	// a new schedule replaces the previous one
+	sut.Schedule(time.Millisecond*20, expiredCB)
+	sut.Schedule(time.Millisecond*40, expiredCB)
+	assert.Eventually(t, func() bool { return expired.Load() == 2 }, time.Second, time.Millisecond*5)
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(2), expired.Load())
+
+	sut.Schedule(time.Millisecond*20, expiredCB)
+	sut.Cancel()
+	assert.False(t, sut.IsScheduled())
+	time.Sleep(time.Millisecond * 40)
+	assert.Equal(t, int32(2), expired.Load())
+}
@@ -0,0 +1,293 @@
+package internal
+
+import (
+	"sync"
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+)
+
+const (
+	// time the Controllable System waits in "init" state for a limit
+	// before switching to "unlimited/autonomous"
+	//
+	// EEBus_UC_TS_LimitationOfPowerConsumption V1.0.0 2.5
+	LimitStateInitTimeout = time.Second * 120
+
+	// minimum time the Controllable System remains in "failsafe" state,
+	// used if the configured value is lower
+	LimitStateFailsafeDurationMinimum = time.Hour * 2
+
+	// interval in which the state conditions are checked
+	limitStateCheckInterval = time.Second * 5
+)
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// generic state machine of a Controllable System, to be used in UCLPC & UCLPP
+//
+// The states and transitions are defined in
+// EEBus_UC_TS_LimitationOfPowerConsumption V1.0.0 2.5 and
+// EEBus_UC_TS_LimitationOfPowerProduction V1.0.0 2.5
+type LimitStateMachine struct {
+	clock ucapi.ClockInterface
+
+	isHeartbeatWithinDuration func() bool
+	limit                     func() (ucapi.LoadLimit, error)
+	failsafeLimit             func() (float64, bool, error)
+	failsafeDuration          func() (time.Duration, bool, error)
+	stateCB                   func(state ucapi.LimitationStateType)
+
+	state      ucapi.LimitationStateType
+	stateSince time.Time
+
+	// set if a limit was received from the Energy Guard since entering the current state
+	limitReceived bool
+
+	stopChan chan struct{}
+
+	mux sync.Mutex
+}
+
+// Create a new state machine
+//
+// parameters:
+//   - isHeartbeatWithinDuration: reports if the heartbeat of the Energy Guard is within the allowed duration
+//   - limit: provides the current limit data
+//   - failsafeLimit: provides the failsafe limit
+//   - failsafeDuration: provides the failsafe duration minimum
+//   - stateCB: invoked whenever the state changed
+func NewLimitStateMachine(
+	isHeartbeatWithinDuration func() bool,
+	limit func() (ucapi.LoadLimit, error),
+	failsafeLimit func() (float64, bool, error),
+	failsafeDuration func() (time.Duration, bool, error),
+	stateCB func(state ucapi.LimitationStateType),
+) *LimitStateMachine {
+	return &LimitStateMachine{
+		clock:                     systemClock{},
+		isHeartbeatWithinDuration: isHeartbeatWithinDuration,
+		limit:                     limit,
+		failsafeLimit:             failsafeLimit,
+		failsafeDuration:          failsafeDuration,
+		stateCB:                   stateCB,
+	}
+}
+
+// set the clock used for all time based transitions
+//
+// the system time is used if clock is nil
+func (l *LimitStateMachine) SetClock(clock ucapi.ClockInterface) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if clock == nil {
+		clock = systemClock{}
+	}
+	l.clock = clock
+}
+
+// return the current time of the clock used by the state machine
+func (l *LimitStateMachine) Now() time.Time {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return l.clock.Now()
+}
+
+// start the state machine in "init" state
+//
+// does nothing if the state machine is already running
+func (l *LimitStateMachine) Start() {
+	l.mux.Lock()
+
+	if l.stopChan != nil {
+		l.mux.Unlock()
+		return
+	}
+
+	l.stopChan = make(chan struct{})
+	l.setState(ucapi.LimitationStateTypeInit)
+	stopChan := l.stopChan
+
+	l.mux.Unlock()
+
+	l.notify(ucapi.LimitationStateTypeInit)
+
+	go l.run(stopChan)
+}
+
+// stop the state machine
+//
+// the last state is kept
+func (l *LimitStateMachine) Stop() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if l.stopChan == nil {
+		return
+	}
+
+	close(l.stopChan)
+	l.stopChan = nil
+}
+
+// return if the state machine is running
+func (l *LimitStateMachine) IsRunning() bool {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return l.stopChan != nil
+}
+
+// return the current state
+//
+// returns an empty value if the state machine was never started
+func (l *LimitStateMachine) State() ucapi.LimitationStateType {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	return l.state
+}
+
+// return the limit that has to be applied in the current state
+//
+// return values:
+//   - value: the limit value
+//   - isLimited: false if no limit has to be applied
+func (l *LimitStateMachine) EffectiveLimit() (value float64, isLimited bool) {
+	switch l.State() {
+	case ucapi.LimitationStateTypeInit, ucapi.LimitationStateTypeFailsafe:
+		if failsafe, _, err := l.failsafeLimit(); err == nil {
+			return failsafe, true
+		}
+	case ucapi.LimitationStateTypeLimited:
+		if limit, err := l.limit(); err == nil {
+			return limit.Value, true
+		}
+	case ucapi.LimitationStateTypeUnlimitedControlled, ucapi.LimitationStateTypeUnlimitedAutonomous:
+		return 0, false
+	default:
+		// the state machine is not used, report the current limit
+		if limit, err := l.limit(); err == nil && limit.IsActive {
+			return limit.Value, true
+		}
+	}
+
+	return 0, false
+}
+
+// report that a limit was received from the Energy Guard
+//
+// the state conditions are checked afterwards
+func (l *LimitStateMachine) LimitReceived() {
+	l.mux.Lock()
+	l.limitReceived = true
+	l.mux.Unlock()
+
+	l.Evaluate()
+}
+
+// check the state conditions and switch the state if required
+func (l *LimitStateMachine) Evaluate() {
+	l.mux.Lock()
+
+	if l.stopChan == nil {
+		l.mux.Unlock()
+		return
+	}
+
+	next := l.nextState()
+	changed := next != l.state
+	if changed {
+		l.setState(next)
+	}
+
+	l.mux.Unlock()
+
+	if changed {
+		l.notify(next)
+	}
+}
+
+func (l *LimitStateMachine) run(stopChan chan struct{}) {
+	ticker := time.NewTicker(limitStateCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			l.Evaluate()
+		}
+	}
+}
+
+// has to be invoked with a locked mutex
+func (l *LimitStateMachine) setState(state ucapi.LimitationStateType) {
+	l.state = state
+	l.stateSince = l.clock.Now()
+	l.limitReceived = false
+}
+
+func (l *LimitStateMachine) notify(state ucapi.LimitationStateType) {
+	if l.stateCB != nil {
+		l.stateCB(state)
+	}
+}
+
+// the state to switch to if a limit was received
+func (l *LimitStateMachine) controlledState() ucapi.LimitationStateType {
+	if limit, err := l.limit(); err == nil && limit.IsActive {
+		return ucapi.LimitationStateTypeLimited
+	}
+
+	return ucapi.LimitationStateTypeUnlimitedControlled
+}
+
+// has to be invoked with a locked mutex
+func (l *LimitStateMachine) nextState() ucapi.LimitationStateType {
+	elapsed := l.clock.Now().Sub(l.stateSince)
+	heartbeatOk := l.isHeartbeatWithinDuration()
+
+	switch l.state {
+	case ucapi.LimitationStateTypeInit:
+		if heartbeatOk && l.limitReceived {
+			return l.controlledState()
+		}
+		if elapsed >= LimitStateInitTimeout {
+			return ucapi.LimitationStateTypeUnlimitedAutonomous
+		}
+
+	case ucapi.LimitationStateTypeUnlimitedControlled, ucapi.LimitationStateTypeLimited:
+		if !heartbeatOk {
+			return ucapi.LimitationStateTypeFailsafe
+		}
+		return l.controlledState()
+
+	case ucapi.LimitationStateTypeFailsafe:
+		if heartbeatOk && l.limitReceived {
+			return l.controlledState()
+		}
+
+		duration, _, err := l.failsafeDuration()
+		if err != nil || duration < LimitStateFailsafeDurationMinimum {
+			duration = LimitStateFailsafeDurationMinimum
+		}
+		if elapsed >= duration {
+			return ucapi.LimitationStateTypeUnlimitedAutonomous
+		}
+
+	case ucapi.LimitationStateTypeUnlimitedAutonomous:
+		if heartbeatOk && l.limitReceived {
+			return l.controlledState()
+		}
+	}
+
+	return l.state
+}
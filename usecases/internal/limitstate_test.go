@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/stretchr/testify/assert"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+type testLimitSource struct {
+	heartbeat        bool
+	limit            ucapi.LoadLimit
+	limitErr         error
+	failsafe         float64
+	failsafeDuration time.Duration
+	states           []ucapi.LimitationStateType
+}
+
+func (t *testLimitSource) newStateMachine(clock ucapi.ClockInterface) *LimitStateMachine {
+	sm := NewLimitStateMachine(
+		func() bool { return t.heartbeat },
+		func() (ucapi.LoadLimit, error) { return t.limit, t.limitErr },
+		func() (float64, bool, error) { return t.failsafe, true, nil },
+		func() (time.Duration, bool, error) { return t.failsafeDuration, true, nil },
+		func(state ucapi.LimitationStateType) { t.states = append(t.states, state) },
+	)
+	sm.SetClock(clock)
+
+	return sm
+}
+
+func Test_LimitStateMachine_Init(t *testing.T) {
+	clock := &testClock{now: time.Now()}
+	source := &testLimitSource{failsafe: 4200}
+	sut := source.newStateMachine(clock)
+
+	assert.Equal(t, ucapi.LimitationStateType(""), sut.State())
+	assert.False(t, sut.IsRunning())
+
+	// not started, nothing should happen
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateType(""), sut.State())
+
+	sut.Start()
+	defer sut.Stop()
+	assert.True(t, sut.IsRunning())
+	assert.Equal(t, ucapi.LimitationStateTypeInit, sut.State())
+	assert.Equal(t, []ucapi.LimitationStateType{ucapi.LimitationStateTypeInit}, source.states)
+
+	value, isLimited := sut.EffectiveLimit()
+	assert.True(t, isLimited)
+	assert.Equal(t, 4200.0, value)
+
+	// a second start should not reset the state
+	sut.Start()
+	assert.Equal(t, 1, len(source.states))
+
+	clock.Add(LimitStateInitTimeout - time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeInit, sut.State())
+
+	clock.Add(time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedAutonomous, sut.State())
+
+	value, isLimited = sut.EffectiveLimit()
+	assert.False(t, isLimited)
+	assert.Equal(t, 0.0, value)
+}
+
+func Test_LimitStateMachine_Controlled(t *testing.T) {
+	clock := &testClock{now: time.Now()}
+	source := &testLimitSource{failsafe: 4200}
+	sut := source.newStateMachine(clock)
+
+	sut.Start()
+	defer sut.Stop()
+
+	// a limit without a heartbeat is not sufficient
+	source.limit = ucapi.LoadLimit{Value: 1000, IsActive: true}
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeInit, sut.State())
+
+	source.heartbeat = true
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeLimited, sut.State())
+
+	value, isLimited := sut.EffectiveLimit()
+	assert.True(t, isLimited)
+	assert.Equal(t, 1000.0, value)
+
+	source.limit.IsActive = false
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedControlled, sut.State())
+
+	source.limit.IsActive = true
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeLimited, sut.State())
+
+	source.heartbeat = false
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	value, isLimited = sut.EffectiveLimit()
+	assert.True(t, isLimited)
+	assert.Equal(t, 4200.0, value)
+
+	// the heartbeat alone is not sufficient to leave the failsafe state
+	source.heartbeat = true
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	source.limit.IsActive = false
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedControlled, sut.State())
+
+	assert.Equal(t, []ucapi.LimitationStateType{
+		ucapi.LimitationStateTypeInit,
+		ucapi.LimitationStateTypeLimited,
+		ucapi.LimitationStateTypeUnlimitedControlled,
+		ucapi.LimitationStateTypeLimited,
+		ucapi.LimitationStateTypeFailsafe,
+		ucapi.LimitationStateTypeUnlimitedControlled,
+	}, source.states)
+}
+
+func Test_LimitStateMachine_Failsafe(t *testing.T) {
+	clock := &testClock{now: time.Now()}
+	source := &testLimitSource{
+		heartbeat:        true,
+		failsafe:         4200,
+		failsafeDuration: time.Hour * 3,
+	}
+	sut := source.newStateMachine(clock)
+
+	sut.Start()
+	defer sut.Stop()
+
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedControlled, sut.State())
+
+	source.heartbeat = false
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	clock.Add(time.Hour*3 - time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	clock.Add(time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedAutonomous, sut.State())
+
+	// a limit without heartbeat is not sufficient
+	source.limit = ucapi.LoadLimit{Value: 1000, IsActive: true}
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedAutonomous, sut.State())
+
+	// the received limit applies once the heartbeat is available
+	source.heartbeat = true
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeLimited, sut.State())
+}
+
+func Test_LimitStateMachine_FailsafeDurationMinimum(t *testing.T) {
+	clock := &testClock{now: time.Now()}
+	source := &testLimitSource{
+		heartbeat: true,
+		limitErr:  api.ErrDataNotAvailable,
+	}
+	sut := source.newStateMachine(clock)
+
+	value, isLimited := sut.EffectiveLimit()
+	assert.False(t, isLimited)
+	assert.Equal(t, 0.0, value)
+
+	sut.Start()
+
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedControlled, sut.State())
+
+	source.heartbeat = false
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	// a configured duration of 0 may not end the failsafe state
+	clock.Add(LimitStateFailsafeDurationMinimum - time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeFailsafe, sut.State())
+
+	clock.Add(time.Second)
+	sut.Evaluate()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedAutonomous, sut.State())
+
+	sut.Stop()
+	assert.False(t, sut.IsRunning())
+	sut.Stop()
+
+	// the last state is kept, but no longer changed
+	source.heartbeat = true
+	sut.LimitReceived()
+	assert.Equal(t, ucapi.LimitationStateTypeUnlimitedAutonomous, sut.State())
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/features/server"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
 	"github.com/enbility/ship-go/logging"
 	spineapi "github.com/enbility/spine-go/api"
@@ -296,3 +297,356 @@ func WriteLoadControlPhaseLimits(
 
 	return msgCounter, err
 }
+
+// the limit description of the phase specific current obligations
+// of the OPEV use case provided by the EV actor
+var EVCurrentObligationLimitDescription = model.LoadControlLimitDescriptionDataType{
+	LimitType:     util.Ptr(model.LoadControlLimitTypeTypeMaxValueLimit),
+	LimitCategory: util.Ptr(model.LoadControlCategoryTypeObligation),
+	Unit:          util.Ptr(model.UnitOfMeasurementTypeA),
+	ScopeType:     util.Ptr(model.ScopeTypeTypeOverloadProtection),
+}
+
+// the limit description of the phase specific current recommendations
+// of the OSCEV use case provided by the EV actor
+var EVCurrentRecommendationLimitDescription = model.LoadControlLimitDescriptionDataType{
+	LimitType:     util.Ptr(model.LoadControlLimitTypeTypeMaxValueLimit),
+	LimitCategory: util.Ptr(model.LoadControlCategoryTypeRecommendation),
+	Unit:          util.Ptr(model.UnitOfMeasurementTypeA),
+	ScopeType:     util.Ptr(model.ScopeTypeTypeSelfConsumption),
+}
+
+// a limit description of the local load control server and
+// the electrical connection parameter of the phase it refers to
+type localPhaseLimit struct {
+	limitId     model.LoadControlLimitIdType
+	parameterId *model.ElectricalConnectionParameterIdType
+}
+
+// return the limit descriptions of the local load control server matching the filter
+// for each phase, the phase is taken from the parameter description
+// of the measurement the limit description refers to
+func localPhaseLimitDescriptions(
+	localEntity spineapi.EntityLocalInterface,
+	filter model.LoadControlLimitDescriptionDataType,
+) (map[model.ElectricalConnectionPhaseNameType]localPhaseLimit, error) {
+	loadControl, err := server.NewLoadControl(localEntity)
+	electricalConnection, err1 := server.NewElectricalConnection(localEntity)
+	if err != nil || err1 != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	descriptions, err := loadControl.GetLimitDescriptionsForFilter(filter)
+	if err != nil || len(descriptions) == 0 {
+		return nil, api.ErrMetadataNotAvailable
+	}
+
+	result := make(map[model.ElectricalConnectionPhaseNameType]localPhaseLimit)
+
+	for _, desc := range descriptions {
+		if desc.LimitId == nil || desc.MeasurementId == nil {
+			continue
+		}
+
+		paramFilter := model.ElectricalConnectionParameterDescriptionDataType{
+			MeasurementId: desc.MeasurementId,
+		}
+		params, err := electricalConnection.GetParameterDescriptionsForFilter(paramFilter)
+		if err != nil || len(params) == 0 || params[0].AcMeasuredPhases == nil {
+			continue
+		}
+
+		result[*params[0].AcMeasuredPhases] = localPhaseLimit{
+			limitId:     *desc.LimitId,
+			parameterId: params[0].ParameterId,
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrMetadataNotAvailable
+	}
+
+	return result, nil
+}
+
+// add a limit description to the local load control server for each of the
+// provided phase specific measurements, if it does not exist yet
+//
+// the new limits are changeable and not active
+//
+// parameters:
+//   - description: the limit description without LimitId and MeasurementId
+//   - measurementIds: the ids of the phase specific measurements the limits refer to
+func AddPhaseLimitDescriptions(
+	localEntity spineapi.EntityLocalInterface,
+	description model.LoadControlLimitDescriptionDataType,
+	measurementIds []model.MeasurementIdType,
+) error {
+	loadControl, err := server.NewLoadControl(localEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	for _, measurementId := range measurementIds {
+		desc := description
+		desc.MeasurementId = util.Ptr(measurementId)
+
+		if data, err := loadControl.GetLimitDescriptionsForFilter(desc); err == nil && len(data) > 0 {
+			continue
+		}
+
+		limitId := loadControl.AddLimitDescription(desc)
+		if limitId == nil {
+			return api.ErrMissingData
+		}
+
+		limitData := []api.LoadControlLimitDataForID{
+			{
+				Data: model.LoadControlLimitDataType{
+					IsLimitChangeable: util.Ptr(true),
+					IsLimitActive:     util.Ptr(false),
+				},
+				Id: *limitId,
+			},
+		}
+		if err := loadControl.UpdateLimitDataForIds(limitData); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// return the phase specific limits of the local load control server matching the filter
+//
+// possible errors:
+//   - ErrMetadataNotAvailable if no limit descriptions are available
+//   - ErrDataNotAvailable if no limit data is available
+func LocalPhaseLimits(
+	localEntity spineapi.EntityLocalInterface,
+	filter model.LoadControlLimitDescriptionDataType,
+) ([]ucapi.LoadLimitsPhase, error) {
+	descriptions, err := localPhaseLimitDescriptions(localEntity, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	loadControl, err := server.NewLoadControl(localEntity)
+	if err != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	var result []ucapi.LoadLimitsPhase
+
+	for _, phase := range ucapi.PhaseNameMapping {
+		desc, ok := descriptions[phase]
+		if !ok {
+			continue
+		}
+
+		data, err := loadControl.GetLimitDataForId(desc.limitId)
+		if err != nil || data == nil {
+			continue
+		}
+
+		limit := ucapi.LoadLimitsPhase{
+			Phase:        phase,
+			IsChangeable: data.IsLimitChangeable != nil && *data.IsLimitChangeable,
+			IsActive:     data.IsLimitActive != nil && *data.IsLimitActive,
+		}
+		if data.Value != nil {
+			limit.Value = data.Value.GetValue()
+		}
+
+		result = append(result, limit)
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// return the phase specific limits contained in the data of an incoming write
+// to the local load control server, only limits matching the filter are considered
+//
+// elements missing in the written data are taken from the current limit data
+func PhaseLimitsOfWriteData(
+	localEntity spineapi.EntityLocalInterface,
+	filter model.LoadControlLimitDescriptionDataType,
+	data *model.LoadControlLimitListDataType,
+) []ucapi.LoadLimitsPhase {
+	var result []ucapi.LoadLimitsPhase
+
+	if data == nil {
+		return result
+	}
+
+	descriptions, err := localPhaseLimitDescriptions(localEntity, filter)
+	if err != nil {
+		return result
+	}
+
+	loadControl, err := server.NewLoadControl(localEntity)
+	if err != nil {
+		return result
+	}
+
+	for _, phase := range ucapi.PhaseNameMapping {
+		desc, ok := descriptions[phase]
+		if !ok {
+			continue
+		}
+
+		for _, item := range data.LoadControlLimitData {
+			if item.LimitId == nil || *item.LimitId != desc.limitId {
+				continue
+			}
+
+			limit := ucapi.LoadLimitsPhase{
+				Phase: phase,
+			}
+
+			current, err := loadControl.GetLimitDataForId(desc.limitId)
+			if err == nil && current != nil {
+				limit.IsChangeable = current.IsLimitChangeable != nil && *current.IsLimitChangeable
+				limit.IsActive = current.IsLimitActive != nil && *current.IsLimitActive
+				if current.Value != nil {
+					limit.Value = current.Value.GetValue()
+				}
+			}
+
+			if item.IsLimitActive != nil {
+				limit.IsActive = *item.IsLimitActive
+			}
+			if item.Value != nil {
+				limit.Value = item.Value.GetValue()
+			}
+
+			result = append(result, limit)
+			break
+		}
+	}
+
+	return result
+}
+
+// deactivate all phase specific limits of the local load control server matching the filter
+func DeactivatePhaseLimits(
+	localEntity spineapi.EntityLocalInterface,
+	filter model.LoadControlLimitDescriptionDataType,
+) error {
+	descriptions, err := localPhaseLimitDescriptions(localEntity, filter)
+	if err != nil {
+		return err
+	}
+
+	loadControl, err := server.NewLoadControl(localEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	var limitData []api.LoadControlLimitDataForID
+	for _, phase := range ucapi.PhaseNameMapping {
+		if desc, ok := descriptions[phase]; ok {
+			limitData = append(limitData, api.LoadControlLimitDataForID{
+				Data: model.LoadControlLimitDataType{
+					IsLimitActive: util.Ptr(false),
+				},
+				Id: desc.limitId,
+			})
+		}
+	}
+
+	return loadControl.UpdateLimitDataForIds(limitData)
+}
+
+// return the effective charging current limit of the EV per phase
+// of the local load control and electrical connection servers
+//
+// the effective limit of a phase is the maximum permitted current,
+// reduced by the active OPEV obligation and the active OSCEV recommendation,
+// so a recommendation never exceeds an obligation
+//
+// return values:
+//   - the limits in A, in the order of phases a, b and c
+//
+// possible errors:
+//   - ErrMetadataNotAvailable if no limit descriptions are available
+//   - ErrDataNotAvailable if neither permitted values nor active limits are available for a phase
+func EffectiveEVCurrentLimits(localEntity spineapi.EntityLocalInterface) ([]float64, error) {
+	loadControl, err := server.NewLoadControl(localEntity)
+	electricalConnection, err1 := server.NewElectricalConnection(localEntity)
+	if err != nil || err1 != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	filters := []model.LoadControlLimitDescriptionDataType{
+		EVCurrentObligationLimitDescription,
+		EVCurrentRecommendationLimitDescription,
+	}
+
+	var descriptions []map[model.ElectricalConnectionPhaseNameType]localPhaseLimit
+	for _, filter := range filters {
+		if data, err := localPhaseLimitDescriptions(localEntity, filter); err == nil {
+			descriptions = append(descriptions, data)
+		}
+	}
+
+	if len(descriptions) == 0 {
+		return nil, api.ErrMetadataNotAvailable
+	}
+
+	var result []float64
+
+	for _, phase := range ucapi.PhaseNameMapping {
+		var limit float64
+		available, exists, permitted := false, false, false
+
+		for _, data := range descriptions {
+			desc, ok := data[phase]
+			if !ok {
+				continue
+			}
+			exists = true
+
+			// the permitted values are identical for all limits of a phase
+			if !permitted && desc.parameterId != nil {
+				filter := model.ElectricalConnectionPermittedValueSetDataType{
+					ParameterId: desc.parameterId,
+				}
+				if _, max, _, err := electricalConnection.GetPermittedValueDataForFilter(filter); err == nil {
+					permitted = true
+					if !available || max < limit {
+						limit = max
+						available = true
+					}
+				}
+			}
+
+			value, err := loadControl.GetLimitDataForId(desc.limitId)
+			if err != nil || value == nil || value.Value == nil ||
+				value.IsLimitActive == nil || !*value.IsLimitActive {
+				continue
+			}
+
+			if !available || value.Value.GetValue() < limit {
+				limit = value.Value.GetValue()
+				available = true
+			}
+		}
+
+		if !exists {
+			continue
+		}
+
+		if !available {
+			return nil, api.ErrDataNotAvailable
+		}
+
+		result = append(result, limit)
+	}
+
+	return result, nil
+}
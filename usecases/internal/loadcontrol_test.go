@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
@@ -1164,3 +1166,129 @@ func (s *InternalSuite) Test_WriteLoadControlLimits() {
 		})
 	}
 }
+
+func (s *InternalSuite) Test_LocalPhaseLimits() {
+	phases := PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAbc)
+
+	err := AddPhaseLimitDescriptions(nil, EVCurrentObligationLimitDescription, nil)
+	assert.NotNil(s.T(), err)
+
+	data, err := LocalPhaseLimits(s.localEntity, EVCurrentObligationLimitDescription)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), data)
+
+	ids, err := PhaseCurrentMeasurementIds(s.localEntity, 0, phases)
+	assert.Nil(s.T(), err)
+
+	err = AddPhaseLimitDescriptions(s.localEntity, EVCurrentObligationLimitDescription, ids)
+	assert.Nil(s.T(), err)
+
+	// adding the descriptions again does not add duplicates
+	err = AddPhaseLimitDescriptions(s.localEntity, EVCurrentObligationLimitDescription, ids)
+	assert.Nil(s.T(), err)
+
+	err = AddPhaseLimitDescriptions(s.localEntity, EVCurrentRecommendationLimitDescription, ids)
+	assert.Nil(s.T(), err)
+
+	data, err = LocalPhaseLimits(s.localEntity, EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+	for index, limit := range data {
+		assert.Equal(s.T(), phases[index], limit.Phase)
+		assert.True(s.T(), limit.IsChangeable)
+		assert.False(s.T(), limit.IsActive)
+	}
+
+	limits, err := EffectiveEVCurrentLimits(s.localEntity)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), limits)
+
+	err = UpdatePhasePermittedValues(s.localEntity, ids, []float64{6, 6, 6}, []float64{16, 16, 16}, []float64{0, 0, 0})
+	assert.Nil(s.T(), err)
+
+	limits, err = EffectiveEVCurrentLimits(s.localEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16, 16, 16}, limits)
+
+	lc, err := server.NewLoadControl(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	obligations, err := lc.GetLimitDescriptionsForFilter(EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(obligations))
+	recommendations, err := lc.GetLimitDescriptionsForFilter(EVCurrentRecommendationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(recommendations))
+
+	writeData := &model.LoadControlLimitListDataType{
+		LoadControlLimitData: []model.LoadControlLimitDataType{
+			{
+				LimitId:       recommendations[0].LimitId,
+				IsLimitActive: util.Ptr(true),
+				Value:         model.NewScaledNumberType(8),
+			},
+		},
+	}
+	phaseLimits := PhaseLimitsOfWriteData(s.localEntity, EVCurrentObligationLimitDescription, writeData)
+	assert.Equal(s.T(), 0, len(phaseLimits))
+
+	phaseLimits = PhaseLimitsOfWriteData(s.localEntity, EVCurrentRecommendationLimitDescription, nil)
+	assert.Equal(s.T(), 0, len(phaseLimits))
+
+	phaseLimits = PhaseLimitsOfWriteData(s.localEntity, EVCurrentRecommendationLimitDescription, writeData)
+	assert.Equal(s.T(), 1, len(phaseLimits))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, phaseLimits[0].Phase)
+	assert.True(s.T(), phaseLimits[0].IsChangeable)
+	assert.True(s.T(), phaseLimits[0].IsActive)
+	assert.Equal(s.T(), 8.0, phaseLimits[0].Value)
+
+	var limitData []api.LoadControlLimitDataForID
+	for index, value := range []float64{10, 12, 20} {
+		limitData = append(limitData, api.LoadControlLimitDataForID{
+			Data: model.LoadControlLimitDataType{
+				IsLimitActive: util.Ptr(true),
+				Value:         model.NewScaledNumberType(value),
+			},
+			Id: *obligations[index].LimitId,
+		})
+	}
+	limitData = append(limitData, api.LoadControlLimitDataForID{
+		Data: model.LoadControlLimitDataType{
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(8),
+		},
+		Id: *recommendations[0].LimitId,
+	}, api.LoadControlLimitDataForID{
+		Data: model.LoadControlLimitDataType{
+			IsLimitActive: util.Ptr(true),
+			Value:         model.NewScaledNumberType(14),
+		},
+		Id: *recommendations[1].LimitId,
+	})
+	err = lc.UpdateLimitDataForIds(limitData)
+	assert.Nil(s.T(), err)
+
+	data, err = LocalPhaseLimits(s.localEntity, EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(data))
+	assert.True(s.T(), data[0].IsActive)
+	assert.Equal(s.T(), 10.0, data[0].Value)
+
+	limits, err = EffectiveEVCurrentLimits(s.localEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{8, 12, 16}, limits)
+
+	err = DeactivatePhaseLimits(s.localEntity, EVCurrentRecommendationLimitDescription)
+	assert.Nil(s.T(), err)
+
+	limits, err = EffectiveEVCurrentLimits(s.localEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 12, 16}, limits)
+
+	err = DeactivatePhaseLimits(s.localEntity, EVCurrentObligationLimitDescription)
+	assert.Nil(s.T(), err)
+
+	limits, err = EffectiveEVCurrentLimits(s.localEntity)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{16, 16, 16}, limits)
+}
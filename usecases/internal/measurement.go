@@ -2,11 +2,17 @@ package internal
 
 import (
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/enbility/eebus-go/api"
 	"github.com/enbility/eebus-go/features/client"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/spine"
+	"github.com/enbility/spine-go/util"
 )
 
 // return the phase specific measurement data
@@ -69,3 +75,246 @@ func MeasurementPhaseSpecificDataForFilter(
 
 	return result, nil
 }
+
+// return the single phases of a phase set, in the order of ucapi.PhaseNameMapping
+//
+// e.g. "abc" returns "a", "b", "c" and "ac" returns "a", "c"
+func PhasesOfPhaseSet(phases model.ElectricalConnectionPhaseNameType) []model.ElectricalConnectionPhaseNameType {
+	var result []model.ElectricalConnectionPhaseNameType
+
+	// only phase sets consist of multiple phases, other values e.g. "neutral" do not
+	if phases != model.ElectricalConnectionPhaseNameTypeA &&
+		phases != model.ElectricalConnectionPhaseNameTypeB &&
+		phases != model.ElectricalConnectionPhaseNameTypeC &&
+		phases != model.ElectricalConnectionPhaseNameTypeAb &&
+		phases != model.ElectricalConnectionPhaseNameTypeBc &&
+		phases != model.ElectricalConnectionPhaseNameTypeAc &&
+		phases != model.ElectricalConnectionPhaseNameTypeAbc {
+		return result
+	}
+
+	for _, phase := range ucapi.PhaseNameMapping {
+		if strings.Contains(string(phases), string(phase)) {
+			result = append(result, phase)
+		}
+	}
+
+	return result
+}
+
+// add a measurement description to the local measurement server and
+// a parameter description linking it to an electrical connection
+// of the local electrical connection server
+//
+// NOTE: the ElectricalConnectionId of the parameter description has to be provided,
+// the MeasurementId and ParameterId are set automatically
+//
+// returns the measurementId of the new measurement description
+func AddMeasurementWithParameterDescription(
+	localEntity spineapi.EntityLocalInterface,
+	measurementDescription model.MeasurementDescriptionDataType,
+	parameterDescription model.ElectricalConnectionParameterDescriptionDataType,
+) (*model.MeasurementIdType, error) {
+	if parameterDescription.ElectricalConnectionId == nil {
+		return nil, api.ErrMissingData
+	}
+
+	measurement, err := server.NewMeasurement(localEntity)
+	electricalConnection, err1 := server.NewElectricalConnection(localEntity)
+	if err != nil || err1 != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	measurementId := measurement.AddDescription(measurementDescription)
+	if measurementId == nil {
+		return nil, api.ErrMissingData
+	}
+
+	parameterDescription.MeasurementId = measurementId
+	if parameterId := electricalConnection.AddParameterDescription(parameterDescription); parameterId == nil {
+		return nil, api.ErrMissingData
+	}
+
+	return measurementId, nil
+}
+
+// set the values of measurements of the local measurement server
+//
+// the values are applied to the measurementIds at the same index
+func UpdateMeasurementValues(
+	localEntity spineapi.EntityLocalInterface,
+	measurementIds []model.MeasurementIdType,
+	values []float64,
+) error {
+	if len(measurementIds) == 0 || len(measurementIds) != len(values) {
+		return api.ErrMissingData
+	}
+
+	measurement, err := server.NewMeasurement(localEntity)
+	if err != nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	timestamp := time.Now()
+
+	var data []api.MeasurementDataForID
+	for index, id := range measurementIds {
+		data = append(data, api.MeasurementDataForID{
+			Data: model.MeasurementDataType{
+				ValueType:   util.Ptr(model.MeasurementValueTypeTypeValue),
+				Timestamp:   model.NewAbsoluteOrRelativeTimeTypeFromTime(timestamp),
+				Value:       model.NewScaledNumberType(values[index]),
+				ValueSource: util.Ptr(model.MeasurementValueSourceTypeMeasuredValue),
+			},
+			Id: id,
+		})
+	}
+
+	return measurement.UpdateDataForIds(data)
+}
+
+// return the measurementIds of the AC current measurements for each provided phase,
+// in the order of the phases
+//
+// missing current measurements and their parameter descriptions are added,
+// so the use cases of an entity, e.g. EVCEM, OPEV and OSCEV, share them
+// and the phase specific current limits refer to the measured currents
+func PhaseCurrentMeasurementIds(
+	localEntity spineapi.EntityLocalInterface,
+	electricalConnectionId model.ElectricalConnectionIdType,
+	phases []model.ElectricalConnectionPhaseNameType,
+) ([]model.MeasurementIdType, error) {
+	measurement, err := server.NewMeasurement(localEntity)
+	electricalConnection, err1 := server.NewElectricalConnection(localEntity)
+	if err != nil || err1 != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypeCurrent),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(model.UnitOfMeasurementTypeA),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeACCurrent),
+	}
+
+	var result []model.MeasurementIdType
+
+	for _, phase := range phases {
+		filter := model.ElectricalConnectionParameterDescriptionDataType{
+			ElectricalConnectionId: util.Ptr(electricalConnectionId),
+			AcMeasuredPhases:       util.Ptr(phase),
+		}
+		params, _ := electricalConnection.GetParameterDescriptionsForFilter(filter)
+
+		var measurementId *model.MeasurementIdType
+		for _, param := range params {
+			if param.MeasurementId == nil {
+				continue
+			}
+
+			descFilter := measurementDesc
+			descFilter.MeasurementId = param.MeasurementId
+			if descs, err := measurement.GetDescriptionsForFilter(descFilter); err == nil && len(descs) > 0 {
+				measurementId = param.MeasurementId
+				break
+			}
+		}
+
+		if measurementId == nil {
+			param := model.ElectricalConnectionParameterDescriptionDataType{
+				ElectricalConnectionId: util.Ptr(electricalConnectionId),
+				VoltageType:            util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+				AcMeasuredPhases:       util.Ptr(phase),
+				AcMeasurementType:      util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+			}
+			if measurementId, err = AddMeasurementWithParameterDescription(localEntity, measurementDesc, param); err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, *measurementId)
+	}
+
+	return result, nil
+}
+
+// remove the values of measurements of the local measurement server,
+// the measurement descriptions are kept
+func RemoveMeasurementValues(
+	localEntity spineapi.EntityLocalInterface,
+	measurementIds []model.MeasurementIdType,
+) error {
+	if localEntity == nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	measurement := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	if measurement == nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	if len(measurementIds) == 0 {
+		return nil
+	}
+
+	if data, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementListDataType](
+		measurement, model.FunctionTypeMeasurementListData); err == nil {
+		data.MeasurementData = slices.DeleteFunc(data.MeasurementData, func(item model.MeasurementDataType) bool {
+			return item.MeasurementId != nil && slices.Contains(measurementIds, *item.MeasurementId)
+		})
+		measurement.SetData(model.FunctionTypeMeasurementListData, data)
+	}
+
+	return nil
+}
+
+// remove measurement descriptions, their values and the parameter descriptions
+// linked to them from the local measurement and electrical connection servers
+func RemoveMeasurementsWithParameterDescriptions(
+	localEntity spineapi.EntityLocalInterface,
+	measurementIds []model.MeasurementIdType,
+) error {
+	if localEntity == nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	measurement := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	electricalConnection := localEntity.FeatureOfTypeAndRole(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	if measurement == nil || electricalConnection == nil {
+		return api.ErrFunctionNotSupported
+	}
+
+	if len(measurementIds) == 0 {
+		return nil
+	}
+
+	removed := func(id *model.MeasurementIdType) bool {
+		return id != nil && slices.Contains(measurementIds, *id)
+	}
+
+	if data, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementDescriptionListDataType](
+		measurement, model.FunctionTypeMeasurementDescriptionListData); err == nil {
+		data.MeasurementDescriptionData = slices.DeleteFunc(data.MeasurementDescriptionData, func(item model.MeasurementDescriptionDataType) bool {
+			return removed(item.MeasurementId)
+		})
+		measurement.SetData(model.FunctionTypeMeasurementDescriptionListData, data)
+	}
+
+	if data, err := spine.LocalFeatureDataCopyOfType[*model.MeasurementListDataType](
+		measurement, model.FunctionTypeMeasurementListData); err == nil {
+		data.MeasurementData = slices.DeleteFunc(data.MeasurementData, func(item model.MeasurementDataType) bool {
+			return removed(item.MeasurementId)
+		})
+		measurement.SetData(model.FunctionTypeMeasurementListData, data)
+	}
+
+	if data, err := spine.LocalFeatureDataCopyOfType[*model.ElectricalConnectionParameterDescriptionListDataType](
+		electricalConnection, model.FunctionTypeElectricalConnectionParameterDescriptionListData); err == nil {
+		data.ElectricalConnectionParameterDescriptionData = slices.DeleteFunc(data.ElectricalConnectionParameterDescriptionData, func(item model.ElectricalConnectionParameterDescriptionDataType) bool {
+			return removed(item.MeasurementId)
+		})
+		electricalConnection.SetData(model.FunctionTypeElectricalConnectionParameterDescriptionListData, data)
+	}
+
+	return nil
+}
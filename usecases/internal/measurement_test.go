@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"github.com/enbility/eebus-go/features/server"
 	ucapi "github.com/enbility/eebus-go/usecases/api"
 	"github.com/enbility/spine-go/model"
 	"github.com/enbility/spine-go/util"
@@ -162,3 +163,219 @@ func (s *InternalSuite) Test_MeasurementPhaseSpecificDataForFilter() {
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), []float64{10, 10, 10}, data)
 }
+
+func (s *InternalSuite) Test_PhasesOfPhaseSet() {
+	phases := PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAbc)
+	assert.Equal(s.T(), ucapi.PhaseNameMapping, phases)
+
+	phases = PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAc)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{
+		model.ElectricalConnectionPhaseNameTypeA,
+		model.ElectricalConnectionPhaseNameTypeC,
+	}, phases)
+
+	phases = PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeB)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeB}, phases)
+
+	phases = PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), 0, len(phases))
+}
+
+func (s *InternalSuite) Test_AddMeasurementWithParameterDescription() {
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypePower),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeACPowerTotal),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(model.ElectricalConnectionIdType(0)),
+		AcMeasuredPhases:       util.Ptr(model.ElectricalConnectionPhaseNameTypeAbc),
+	}
+
+	id, err := AddMeasurementWithParameterDescription(nil, measurementDesc, paramDesc)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), id)
+
+	// the electrical connection id is missing
+	id, err = AddMeasurementWithParameterDescription(s.localEntity, measurementDesc, model.ElectricalConnectionParameterDescriptionDataType{})
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), id)
+
+	measurementDesc.MeasurementId = util.Ptr(model.MeasurementIdType(5))
+	id, err = AddMeasurementWithParameterDescription(s.localEntity, measurementDesc, paramDesc)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), id)
+
+	measurementDesc.MeasurementId = nil
+	id, err = AddMeasurementWithParameterDescription(s.localEntity, measurementDesc, paramDesc)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), id)
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.ElectricalConnectionParameterDescriptionDataType{
+		MeasurementId: id,
+	}
+	params, err := ec.GetParameterDescriptionsForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(params))
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeAbc, *params[0].AcMeasuredPhases)
+}
+
+func (s *InternalSuite) Test_UpdateMeasurementValues() {
+	ids := []model.MeasurementIdType{0, 1}
+
+	err := UpdateMeasurementValues(nil, ids, []float64{10, 20})
+	assert.NotNil(s.T(), err)
+
+	err = UpdateMeasurementValues(s.localEntity, ids, []float64{10})
+	assert.NotNil(s.T(), err)
+
+	// no descriptions available
+	err = UpdateMeasurementValues(s.localEntity, ids, []float64{10, 20})
+	assert.NotNil(s.T(), err)
+
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+	for i := 0; i < 2; i++ {
+		desc := model.MeasurementDescriptionDataType{
+			MeasurementType: util.Ptr(model.MeasurementTypeTypeCurrent),
+			CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+			ScopeType:       util.Ptr(model.ScopeTypeTypeACCurrent),
+		}
+		assert.NotNil(s.T(), measurement.AddDescription(desc))
+	}
+
+	err = UpdateMeasurementValues(s.localEntity, ids, []float64{10, 20})
+	assert.Nil(s.T(), err)
+
+	data, err := measurement.GetDataForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(data))
+	assert.Equal(s.T(), 10.0, data[0].Value.GetValue())
+	assert.Equal(s.T(), 20.0, data[1].Value.GetValue())
+}
+
+func (s *InternalSuite) Test_RemoveMeasurementsWithParameterDescriptions() {
+	err := RemoveMeasurementsWithParameterDescriptions(nil, nil)
+	assert.NotNil(s.T(), err)
+
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(model.ElectricalConnectionIdType(0)),
+		AcMeasuredPhases:       util.Ptr(model.ElectricalConnectionPhaseNameTypeAbc),
+	}
+
+	var ids []model.MeasurementIdType
+	for _, scope := range []model.ScopeTypeType{model.ScopeTypeTypeACPowerTotal, model.ScopeTypeTypeCharge} {
+		measurementDesc := model.MeasurementDescriptionDataType{
+			MeasurementType: util.Ptr(model.MeasurementTypeTypePower),
+			CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+			ScopeType:       util.Ptr(scope),
+		}
+		id, err := AddMeasurementWithParameterDescription(s.localEntity, measurementDesc, paramDesc)
+		assert.Nil(s.T(), err)
+		assert.NotNil(s.T(), id)
+		ids = append(ids, *id)
+	}
+
+	err = UpdateMeasurementValues(s.localEntity, ids, []float64{10, 20})
+	assert.Nil(s.T(), err)
+
+	err = RemoveMeasurementsWithParameterDescriptions(s.localEntity, nil)
+	assert.Nil(s.T(), err)
+
+	err = RemoveMeasurementsWithParameterDescriptions(s.localEntity, ids[:1])
+	assert.Nil(s.T(), err)
+
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descs))
+	assert.Equal(s.T(), ids[1], *descs[0].MeasurementId)
+
+	data, err := measurement.GetDataForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), 20.0, data[0].Value.GetValue())
+
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(params))
+	assert.Equal(s.T(), ids[1], *params[0].MeasurementId)
+}
+
+func (s *InternalSuite) Test_PhaseCurrentMeasurementIds() {
+	phases := []model.ElectricalConnectionPhaseNameType{
+		model.ElectricalConnectionPhaseNameTypeA,
+		model.ElectricalConnectionPhaseNameTypeB,
+	}
+
+	ids, err := PhaseCurrentMeasurementIds(nil, 0, phases)
+	assert.NotNil(s.T(), err)
+	assert.Nil(s.T(), ids)
+
+	// a power measurement of the same phase is no current measurement
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(model.MeasurementTypeTypePower),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		ScopeType:       util.Ptr(model.ScopeTypeTypeACPower),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(model.ElectricalConnectionIdType(0)),
+		AcMeasuredPhases:       util.Ptr(model.ElectricalConnectionPhaseNameTypeA),
+	}
+	powerId, err := AddMeasurementWithParameterDescription(s.localEntity, measurementDesc, paramDesc)
+	assert.Nil(s.T(), err)
+
+	ids, err = PhaseCurrentMeasurementIds(s.localEntity, 0, phases)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(ids))
+	assert.NotContains(s.T(), ids, *powerId)
+
+	// existing current measurements are reused
+	ids2, err := PhaseCurrentMeasurementIds(s.localEntity, 0, phases[1:])
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ids[1:], ids2)
+
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(model.ScopeTypeTypeACCurrent),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(descs))
+}
+
+func (s *InternalSuite) Test_RemoveMeasurementValues() {
+	err := RemoveMeasurementValues(nil, nil)
+	assert.NotNil(s.T(), err)
+
+	ids, err := PhaseCurrentMeasurementIds(s.localEntity, 0, PhasesOfPhaseSet(model.ElectricalConnectionPhaseNameTypeAb))
+	assert.Nil(s.T(), err)
+
+	err = UpdateMeasurementValues(s.localEntity, ids, []float64{10, 20})
+	assert.Nil(s.T(), err)
+
+	err = RemoveMeasurementValues(s.localEntity, nil)
+	assert.Nil(s.T(), err)
+
+	err = RemoveMeasurementValues(s.localEntity, ids[:1])
+	assert.Nil(s.T(), err)
+
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(descs))
+
+	data, err := measurement.GetDataForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), ids[1], *data[0].MeasurementId)
+}
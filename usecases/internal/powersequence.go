@@ -0,0 +1,197 @@
+package internal
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// return the power sequences of a SmartEnergyManagementPs data set
+//
+// sequences without a description providing the sequenceId are ignored
+func PowerSequences(data *model.SmartEnergyManagementPsDataType) []ucapi.PowerSequence {
+	var result []ucapi.PowerSequence
+
+	if data == nil {
+		return result
+	}
+
+	for _, alternative := range data.Alternatives {
+		var alternativesId uint
+		if alternative.Relation != nil && alternative.Relation.AlternativesId != nil {
+			alternativesId = uint(*alternative.Relation.AlternativesId)
+		}
+
+		for _, item := range alternative.PowerSequence {
+			if item.Description == nil || item.Description.SequenceId == nil {
+				continue
+			}
+
+			sequence := ucapi.PowerSequence{
+				Id:             uint(*item.Description.SequenceId),
+				AlternativesId: alternativesId,
+			}
+
+			if item.State != nil {
+				if item.State.State != nil {
+					sequence.State = *item.State.State
+				}
+				if item.State.SequenceRemoteControllable != nil {
+					sequence.RemoteControllable = *item.State.SequenceRemoteControllable
+				}
+				if item.State.ActiveSlotNumber != nil {
+					sequence.ActiveSlot = uint(*item.State.ActiveSlotNumber)
+				}
+			}
+
+			if item.ScheduleConstraints != nil {
+				if item.ScheduleConstraints.EarliestStartTime != nil {
+					if value, err := item.ScheduleConstraints.EarliestStartTime.GetTime(); err == nil {
+						sequence.EarliestStartTime = value
+					}
+				}
+				if item.ScheduleConstraints.LatestEndTime != nil {
+					if value, err := item.ScheduleConstraints.LatestEndTime.GetTime(); err == nil {
+						sequence.LatestEndTime = value
+					}
+				}
+			}
+
+			if item.Schedule != nil && item.Schedule.StartTime != nil {
+				if value, err := item.Schedule.StartTime.GetTime(); err == nil {
+					sequence.StartTime = value
+				}
+			}
+
+			for _, timeSlot := range item.PowerTimeSlot {
+				sequence.Slots = append(sequence.Slots, powerSequenceSlot(timeSlot))
+			}
+
+			result = append(result, sequence)
+		}
+	}
+
+	return result
+}
+
+// convert the SPINE time slot data into a power sequence slot
+func powerSequenceSlot(timeSlot model.SmartEnergyManagementPsPowerTimeSlotType) ucapi.PowerSequenceSlot {
+	var slot ucapi.PowerSequenceSlot
+
+	if timeSlot.Schedule != nil {
+		if timeSlot.Schedule.SlotNumber != nil {
+			slot.Number = uint(*timeSlot.Schedule.SlotNumber)
+		}
+		if timeSlot.Schedule.DefaultDuration != nil {
+			if duration, err := timeSlot.Schedule.DefaultDuration.GetTimeDuration(); err == nil {
+				slot.Duration = duration
+			}
+		}
+	}
+
+	if timeSlot.ValueList == nil {
+		return slot
+	}
+
+	for _, value := range timeSlot.ValueList.Value {
+		if value.ValueType == nil || value.Value == nil {
+			continue
+		}
+
+		switch *value.ValueType {
+		case model.PowerTimeSlotValueTypeTypePower:
+			slot.Power = value.Value.GetValue()
+		case model.PowerTimeSlotValueTypeTypePowerMin:
+			slot.PowerMin = value.Value.GetValue()
+		case model.PowerTimeSlotValueTypeTypePowerMax:
+			slot.PowerMax = value.Value.GetValue()
+		}
+	}
+
+	return slot
+}
+
+// return the SPINE data of a power sequence consuming power
+//
+// the sequenceId is not set, the slots are numbered in the order provided
+func PowerSequenceData(sequence ucapi.PowerSequence) model.SmartEnergyManagementPsPowerSequenceType {
+	state := sequence.State
+	if state == "" {
+		state = model.PowerSequenceStateTypeInactive
+	}
+
+	result := model.SmartEnergyManagementPsPowerSequenceType{
+		Description: &model.PowerSequenceDescriptionDataType{
+			PositiveEnergyDirection: util.Ptr(model.EnergyDirectionTypeConsume),
+			PowerUnit:               util.Ptr(model.UnitOfMeasurementTypeW),
+		},
+		State: &model.PowerSequenceStateDataType{
+			State:                      util.Ptr(state),
+			SequenceRemoteControllable: util.Ptr(sequence.RemoteControllable),
+		},
+		PowerTimeSlot: PowerTimeSlotData(sequence.Slots),
+	}
+
+	if !sequence.EarliestStartTime.IsZero() || !sequence.LatestEndTime.IsZero() {
+		result.ScheduleConstraints = &model.PowerSequenceScheduleConstraintsDataType{}
+		if !sequence.EarliestStartTime.IsZero() {
+			result.ScheduleConstraints.EarliestStartTime = model.NewAbsoluteOrRelativeTimeTypeFromTime(sequence.EarliestStartTime)
+		}
+		if !sequence.LatestEndTime.IsZero() {
+			result.ScheduleConstraints.LatestEndTime = model.NewAbsoluteOrRelativeTimeTypeFromTime(sequence.LatestEndTime)
+		}
+	}
+
+	if !sequence.StartTime.IsZero() {
+		result.Schedule = &model.PowerSequenceScheduleDataType{
+			StartTime: model.NewAbsoluteOrRelativeTimeTypeFromTime(sequence.StartTime),
+		}
+	}
+
+	return result
+}
+
+// return the SPINE data of power sequence slots
+//
+// the sequenceId is not set, the slots are numbered in the order provided
+func PowerTimeSlotData(slots []ucapi.PowerSequenceSlot) []model.SmartEnergyManagementPsPowerTimeSlotType {
+	var result []model.SmartEnergyManagementPsPowerTimeSlotType
+
+	for index, slot := range slots {
+		slotNumber := util.Ptr(model.PowerTimeSlotNumberType(index))
+
+		values := []model.PowerTimeSlotValueDataType{
+			{
+				SlotNumber: slotNumber,
+				ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePower),
+				Value:      model.NewScaledNumberType(slot.Power),
+			},
+		}
+		if slot.PowerMin != 0 {
+			values = append(values, model.PowerTimeSlotValueDataType{
+				SlotNumber: slotNumber,
+				ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePowerMin),
+				Value:      model.NewScaledNumberType(slot.PowerMin),
+			})
+		}
+		if slot.PowerMax != 0 {
+			values = append(values, model.PowerTimeSlotValueDataType{
+				SlotNumber: slotNumber,
+				ValueType:  util.Ptr(model.PowerTimeSlotValueTypeTypePowerMax),
+				Value:      model.NewScaledNumberType(slot.PowerMax),
+			})
+		}
+
+		result = append(result, model.SmartEnergyManagementPsPowerTimeSlotType{
+			Schedule: &model.PowerTimeSlotScheduleDataType{
+				SlotNumber:      slotNumber,
+				DefaultDuration: model.NewDurationType(slot.Duration),
+			},
+			ValueList: &model.SmartEnergyManagementPsPowerTimeSlotValueListType{
+				Value: values,
+			},
+		})
+	}
+
+	return result
+}
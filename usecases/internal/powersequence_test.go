@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *InternalSuite) Test_PowerSequences() {
+	data := PowerSequences(nil)
+	assert.Equal(s.T(), 0, len(data))
+
+	data = PowerSequences(&model.SmartEnergyManagementPsDataType{
+		Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+			{
+				PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{
+					{
+						State: &model.PowerSequenceStateDataType{},
+					},
+				},
+			},
+		},
+	})
+	assert.Equal(s.T(), 0, len(data))
+
+	startTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	sequence := PowerSequenceData(ucapi.PowerSequence{
+		RemoteControllable: true,
+		EarliestStartTime:  startTime,
+		LatestEndTime:      startTime.Add(time.Hour * 4),
+		StartTime:          startTime,
+		Slots: []ucapi.PowerSequenceSlot{
+			{Duration: time.Hour, Power: 2000, PowerMin: 1000, PowerMax: 3000},
+			{Duration: time.Minute * 30, Power: 500},
+		},
+	})
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, *sequence.State.State)
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *sequence.Description.PositiveEnergyDirection)
+	assert.Equal(s.T(), 3, len(sequence.PowerTimeSlot[0].ValueList.Value))
+	assert.Equal(s.T(), 1, len(sequence.PowerTimeSlot[1].ValueList.Value))
+
+	sequence.Description.SequenceId = util.Ptr(model.PowerSequenceIdType(3))
+	data = PowerSequences(&model.SmartEnergyManagementPsDataType{
+		Alternatives: []model.SmartEnergyManagementPsAlternativesType{
+			{
+				Relation: &model.SmartEnergyManagementPsAlternativesRelationType{
+					AlternativesId: util.Ptr(model.AlternativesIdType(1)),
+					SequenceId:     []model.PowerSequenceIdType{3},
+				},
+				PowerSequence: []model.SmartEnergyManagementPsPowerSequenceType{sequence},
+			},
+		},
+	})
+	assert.Equal(s.T(), 1, len(data))
+	assert.Equal(s.T(), uint(3), data[0].Id)
+	assert.Equal(s.T(), uint(1), data[0].AlternativesId)
+	assert.Equal(s.T(), model.PowerSequenceStateTypeInactive, data[0].State)
+	assert.True(s.T(), data[0].RemoteControllable)
+	assert.True(s.T(), startTime.Equal(data[0].EarliestStartTime))
+	assert.True(s.T(), startTime.Add(time.Hour*4).Equal(data[0].LatestEndTime))
+	assert.True(s.T(), startTime.Equal(data[0].StartTime))
+	assert.Equal(s.T(), []ucapi.PowerSequenceSlot{
+		{Number: 0, Duration: time.Hour, Power: 2000, PowerMin: 1000, PowerMax: 3000},
+		{Number: 1, Duration: time.Minute * 30, Power: 500},
+	}, data[0].Slots)
+}
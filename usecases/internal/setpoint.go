@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"errors"
+	"math"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/client"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+)
+
+// return the setpoints for a given filter
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - and others
+func Setpoints(
+	localEntity spineapi.EntityLocalInterface,
+	remoteEntity spineapi.EntityRemoteInterface,
+	filter model.SetpointDescriptionDataType,
+) ([]ucapi.Setpoint, error) {
+	setpoint, err := client.NewSetpoint(localEntity, remoteEntity)
+	if err != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	data, err := setpoint.GetDataForFilter(filter)
+	if err != nil || len(data) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	var result []ucapi.Setpoint
+
+	for _, item := range data {
+		if item.SetpointId == nil || item.Value == nil {
+			continue
+		}
+
+		value := ucapi.Setpoint{
+			Id:           uint(*item.SetpointId),
+			Value:        item.Value.GetValue(),
+			IsActive:     item.IsSetpointActive == nil || *item.IsSetpointActive,
+			IsChangeable: item.IsSetpointChangeable != nil && *item.IsSetpointChangeable,
+		}
+		if item.ValueMin != nil {
+			value.MinValue = item.ValueMin.GetValue()
+		}
+		if item.ValueMax != nil {
+			value.MaxValue = item.ValueMax.GetValue()
+		}
+
+		result = append(result, value)
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+// return the setpoint constraints for a given filter
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such constraints are (yet) available
+//   - and others
+func SetpointConstraints(
+	localEntity spineapi.EntityLocalInterface,
+	remoteEntity spineapi.EntityRemoteInterface,
+	filter model.SetpointDescriptionDataType,
+) ([]ucapi.SetpointConstraints, error) {
+	setpoint, err := client.NewSetpoint(localEntity, remoteEntity)
+	if err != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	descriptions, err := setpoint.GetDescriptionsForFilter(filter)
+	if err != nil || len(descriptions) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	var result []ucapi.SetpointConstraints
+
+	for _, desc := range descriptions {
+		if desc.SetpointId == nil {
+			continue
+		}
+
+		constraints, err := setpoint.GetConstraintsForId(*desc.SetpointId)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, setpointConstraints(*constraints))
+	}
+
+	if len(result) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	return result, nil
+}
+
+func setpointConstraints(data model.SetpointConstraintsDataType) ucapi.SetpointConstraints {
+	result := ucapi.SetpointConstraints{
+		Id: uint(*data.SetpointId),
+	}
+	if data.SetpointRangeMin != nil {
+		result.MinValue = data.SetpointRangeMin.GetValue()
+		result.HasMinValue = true
+	}
+	if data.SetpointRangeMax != nil {
+		result.MaxValue = data.SetpointRangeMax.GetValue()
+		result.HasMaxValue = true
+	}
+	if data.SetpointStepSize != nil {
+		result.StepSize = data.SetpointStepSize.GetValue()
+	}
+
+	return result
+}
+
+// write a new value for a setpoint matching a given filter
+//
+// the value is checked against the constraints of the setpoint, if they are available
+//
+// possible errors:
+//   - ErrDataNotAvailable if no such setpoint is (yet) available
+//   - ErrNotSupported if the setpoint can not be changed
+//   - and others
+func WriteSetpoint(
+	localEntity spineapi.EntityLocalInterface,
+	remoteEntity spineapi.EntityRemoteInterface,
+	filter model.SetpointDescriptionDataType,
+	setpointId uint,
+	value float64,
+) (*model.MsgCounterType, error) {
+	setpoint, err := client.NewSetpoint(localEntity, remoteEntity)
+	if err != nil {
+		return nil, api.ErrFunctionNotSupported
+	}
+
+	id := model.SetpointIdType(setpointId)
+	filter.SetpointId = &id
+
+	data, err := setpoint.GetDataForFilter(filter)
+	if err != nil || len(data) == 0 {
+		return nil, api.ErrDataNotAvailable
+	}
+
+	if data[0].IsSetpointChangeable == nil || !*data[0].IsSetpointChangeable {
+		return nil, api.ErrNotSupported
+	}
+
+	if constraints, err := setpoint.GetConstraintsForId(id); err == nil {
+		if err := checkSetpointConstraints(setpointConstraints(*constraints), value); err != nil {
+			return nil, err
+		}
+	}
+
+	writeData := []model.SetpointDataType{
+		{
+			SetpointId: &id,
+			Value:      model.NewScaledNumberType(value),
+		},
+	}
+
+	return setpoint.WriteData(writeData)
+}
+
+// check if a value fulfills the constraints of a setpoint
+func checkSetpointConstraints(constraints ucapi.SetpointConstraints, value float64) error {
+	if constraints.HasMinValue && value < constraints.MinValue {
+		return errors.New("value is below the minimum")
+	}
+
+	if constraints.HasMaxValue && value > constraints.MaxValue {
+		return errors.New("value is above the maximum")
+	}
+
+	if constraints.StepSize != 0 {
+		// the steps start at the minimum value, or at 0 if there is none
+		var origin float64
+		if constraints.HasMinValue {
+			origin = constraints.MinValue
+		}
+
+		steps := (value - origin) / constraints.StepSize
+		if math.Abs(steps-math.Round(steps)) > 1e-6 {
+			return errors.New("value does not match the step size")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *InternalSuite) Test_Setpoints() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	data, err := Setpoints(s.localEntity, nil, filter)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+	assert.Nil(s.T(), data)
+
+	data, err = Setpoints(s.localEntity, s.monitoredEntity, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	s.addSetpointDescriptions()
+
+	data, err = Setpoints(s.localEntity, s.monitoredEntity, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	s.addSetpointData()
+
+	data, err = Setpoints(s.localEntity, s.monitoredEntity, filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.Setpoint{
+		{
+			Id:           0,
+			Value:        50,
+			MinValue:     45,
+			MaxValue:     55,
+			IsActive:     true,
+			IsChangeable: true,
+		},
+		{
+			Id:       1,
+			Value:    40,
+			IsActive: false,
+		},
+	}, data)
+}
+
+func (s *InternalSuite) Test_SetpointConstraints() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	data, err := SetpointConstraints(s.localEntity, nil, filter)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+	assert.Nil(s.T(), data)
+
+	data, err = SetpointConstraints(s.localEntity, s.monitoredEntity, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	s.addSetpointDescriptions()
+
+	data, err = SetpointConstraints(s.localEntity, s.monitoredEntity, filter)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+	assert.Nil(s.T(), data)
+
+	s.addSetpointConstraints()
+
+	data, err = SetpointConstraints(s.localEntity, s.monitoredEntity, filter)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []ucapi.SetpointConstraints{
+		{
+			Id:          0,
+			MinValue:    30,
+			HasMinValue: true,
+			MaxValue:    60,
+			HasMaxValue: true,
+			StepSize:    0.5,
+		},
+	}, data)
+}
+
+func (s *InternalSuite) Test_CheckSetpointConstraints() {
+	// no bounds provided
+	constraints := ucapi.SetpointConstraints{}
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, -10))
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, 10))
+
+	// a minimum of 0
+	constraints = ucapi.SetpointConstraints{
+		MinValue:    0,
+		HasMinValue: true,
+	}
+	assert.NotNil(s.T(), checkSetpointConstraints(constraints, -1))
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, 0))
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, 1))
+
+	// a maximum of 0
+	constraints = ucapi.SetpointConstraints{
+		MaxValue:    0,
+		HasMaxValue: true,
+	}
+	assert.NotNil(s.T(), checkSetpointConstraints(constraints, 1))
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, 0))
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, -1))
+
+	// a step size without a minimum starts at 0
+	constraints = ucapi.SetpointConstraints{
+		MaxValue:    10,
+		HasMaxValue: true,
+		StepSize:    0.5,
+	}
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, -1.5))
+	assert.NotNil(s.T(), checkSetpointConstraints(constraints, 1.2))
+
+	// a step size with a minimum starts at the minimum
+	constraints = ucapi.SetpointConstraints{
+		MinValue:    0.25,
+		HasMinValue: true,
+		StepSize:    0.5,
+	}
+	assert.Nil(s.T(), checkSetpointConstraints(constraints, 0.75))
+	assert.NotNil(s.T(), checkSetpointConstraints(constraints, 1))
+}
+
+func (s *InternalSuite) Test_WriteSetpoint() {
+	filter := model.SetpointDescriptionDataType{
+		SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+	}
+
+	_, err := WriteSetpoint(s.localEntity, nil, filter, 0, 50)
+	assert.Equal(s.T(), api.ErrFunctionNotSupported, err)
+
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 50)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	s.addSetpointDescriptions()
+	s.addSetpointData()
+
+	// not changeable
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 1, 50)
+	assert.Equal(s.T(), api.ErrNotSupported, err)
+
+	// no matching setpoint
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 5, 50)
+	assert.Equal(s.T(), api.ErrDataNotAvailable, err)
+
+	// no constraints available
+	msgCounter, err := WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 80)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), msgCounter)
+
+	s.addSetpointConstraints()
+
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 20)
+	assert.NotNil(s.T(), err)
+
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 80)
+	assert.NotNil(s.T(), err)
+
+	_, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 50.2)
+	assert.NotNil(s.T(), err)
+
+	msgCounter, err = WriteSetpoint(s.localEntity, s.monitoredEntity, filter, 0, 50.5)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), msgCounter)
+}
+
+// helper
+
+func (s *InternalSuite) addSetpointDescriptions() {
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	fData := &model.SetpointDescriptionListDataType{
+		SetpointDescriptionData: []model.SetpointDescriptionDataType{
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(0)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(1)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueAbsolute),
+			},
+			{
+				SetpointId:   util.Ptr(model.SetpointIdType(2)),
+				SetpointType: util.Ptr(model.SetpointTypeTypeValueRelative),
+			},
+		},
+	}
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointDescriptionListData, fData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
+
+func (s *InternalSuite) addSetpointConstraints() {
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	fData := &model.SetpointConstraintsListDataType{
+		SetpointConstraintsData: []model.SetpointConstraintsDataType{
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(0)),
+				SetpointRangeMin: model.NewScaledNumberType(30),
+				SetpointRangeMax: model.NewScaledNumberType(60),
+				SetpointStepSize: model.NewScaledNumberType(0.5),
+			},
+			{
+				SetpointId:       util.Ptr(model.SetpointIdType(2)),
+				SetpointRangeMin: model.NewScaledNumberType(-5),
+				SetpointRangeMax: model.NewScaledNumberType(5),
+			},
+		},
+	}
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointConstraintsListData, fData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
+
+func (s *InternalSuite) addSetpointData() {
+	rFeature := s.remoteDevice.FeatureByEntityTypeAndRole(s.monitoredEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeServer)
+	fData := &model.SetpointListDataType{
+		SetpointData: []model.SetpointDataType{
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(0)),
+				Value:                model.NewScaledNumberType(50),
+				ValueMin:             model.NewScaledNumberType(45),
+				ValueMax:             model.NewScaledNumberType(55),
+				IsSetpointChangeable: util.Ptr(true),
+			},
+			{
+				SetpointId:           util.Ptr(model.SetpointIdType(1)),
+				Value:                model.NewScaledNumberType(40),
+				IsSetpointActive:     util.Ptr(false),
+				IsSetpointChangeable: util.Ptr(false),
+			},
+			{
+				SetpointId: util.Ptr(model.SetpointIdType(2)),
+			},
+		},
+	}
+	_, fErr := rFeature.UpdateData(true, model.FunctionTypeSetpointListData, fData, nil, nil)
+	assert.Nil(s.T(), fErr)
+}
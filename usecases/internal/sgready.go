@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"github.com/enbility/eebus-go/api"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the HVAC overrun types representing the SG-Ready states
+//
+// the normal operation (condition 2) is represented by none of them being active
+var sgReadyOverrunTypes = map[ucapi.SGReadyStateType]model.HvacOverrunTypeType{
+	ucapi.SGReadyStateTypeBlocked:             model.HvacOverrunTypeTypeSgReadyCondition1,
+	ucapi.SGReadyStateTypeRecommendedIncrease: model.HvacOverrunTypeTypeSgReadyCondition3,
+	ucapi.SGReadyStateTypeForcedIncrease:      model.HvacOverrunTypeTypeSgReadyCondition4,
+}
+
+// return the SG-Ready state represented by an overrun type
+func sgReadyStateForOverrunType(overrunType *model.HvacOverrunTypeType) (ucapi.SGReadyStateType, bool) {
+	if overrunType == nil {
+		return 0, false
+	}
+
+	for state, item := range sgReadyOverrunTypes {
+		if item == *overrunType {
+			return state, true
+		}
+	}
+
+	return 0, false
+}
+
+// check if an overrun description represents a SG-Ready state
+func IsSGReadyOverrun(description model.HvacOverrunDescriptionDataType) bool {
+	if description.OverrunId == nil {
+		return false
+	}
+
+	_, ok := sgReadyStateForOverrunType(description.OverrunType)
+	return ok
+}
+
+// return the SG-Ready overrun descriptions
+func sgReadyDescriptions(descriptions []model.HvacOverrunDescriptionDataType) []model.HvacOverrunDescriptionDataType {
+	var result []model.HvacOverrunDescriptionDataType
+
+	for _, desc := range descriptions {
+		if IsSGReadyOverrun(desc) {
+			result = append(result, desc)
+		}
+	}
+
+	return result
+}
+
+// return the SG-Ready states supported by the overrun descriptions
+//
+// the normal operation is always supported if any SG-Ready overrun is available
+func SGReadyStates(descriptions []model.HvacOverrunDescriptionDataType) []ucapi.SGReadyStateType {
+	supported := make(map[ucapi.SGReadyStateType]bool)
+	for _, desc := range sgReadyDescriptions(descriptions) {
+		state, _ := sgReadyStateForOverrunType(desc.OverrunType)
+		supported[state] = true
+	}
+
+	if len(supported) == 0 {
+		return nil
+	}
+	supported[ucapi.SGReadyStateTypeNormal] = true
+
+	var result []ucapi.SGReadyStateType
+	for state := ucapi.SGReadyStateTypeBlocked; state <= ucapi.SGReadyStateTypeForcedIncrease; state++ {
+		if supported[state] {
+			result = append(result, state)
+		}
+	}
+
+	return result
+}
+
+// return the SG-Ready state represented by the overrun data
+//
+// the state is normal (condition 2) if none of the SG-Ready overruns is active or running
+//
+// possible errors:
+//   - ErrDataNotAvailable if no SG-Ready overrun is available
+func SGReadyState(
+	descriptions []model.HvacOverrunDescriptionDataType,
+	data []model.HvacOverrunDataType,
+) (ucapi.SGReadyStateType, error) {
+	sgDescriptions := sgReadyDescriptions(descriptions)
+	if len(sgDescriptions) == 0 {
+		return 0, api.ErrDataNotAvailable
+	}
+
+	for _, desc := range sgDescriptions {
+		for _, item := range data {
+			if item.OverrunId == nil || *item.OverrunId != *desc.OverrunId ||
+				item.OverrunStatus == nil {
+				continue
+			}
+
+			if *item.OverrunStatus == model.HvacOverrunStatusTypeActive ||
+				*item.OverrunStatus == model.HvacOverrunStatusTypeRunning {
+				state, _ := sgReadyStateForOverrunType(desc.OverrunType)
+				return state, nil
+			}
+		}
+	}
+
+	return ucapi.SGReadyStateTypeNormal, nil
+}
+
+// return the overrun data setting a SG-Ready state
+//
+// the overrun of the state is activated, all other SG-Ready overruns are deactivated
+//
+// possible errors:
+//   - ErrNotSupported if the state is not supported by the overrun descriptions
+func SGReadyOverrunData(
+	descriptions []model.HvacOverrunDescriptionDataType,
+	state ucapi.SGReadyStateType,
+) ([]model.HvacOverrunDataType, error) {
+	sgDescriptions := sgReadyDescriptions(descriptions)
+	if len(sgDescriptions) == 0 {
+		return nil, api.ErrNotSupported
+	}
+
+	if _, ok := sgReadyOverrunTypes[state]; !ok && state != ucapi.SGReadyStateTypeNormal {
+		return nil, api.ErrNotSupported
+	}
+
+	var result []model.HvacOverrunDataType
+	found := state == ucapi.SGReadyStateTypeNormal
+
+	for _, desc := range sgDescriptions {
+		status := model.HvacOverrunStatusTypeInactive
+		if descState, _ := sgReadyStateForOverrunType(desc.OverrunType); descState == state {
+			status = model.HvacOverrunStatusTypeActive
+			found = true
+		}
+
+		result = append(result, model.HvacOverrunDataType{
+			OverrunId:     util.Ptr(*desc.OverrunId),
+			OverrunStatus: util.Ptr(status),
+		})
+	}
+
+	if !found {
+		return nil, api.ErrNotSupported
+	}
+
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *InternalSuite) Test_SGReady() {
+	states := SGReadyStates(nil)
+	assert.Nil(s.T(), states)
+
+	_, err := SGReadyState(nil, nil)
+	assert.NotNil(s.T(), err)
+
+	_, err = SGReadyOverrunData(nil, ucapi.SGReadyStateTypeBlocked)
+	assert.NotNil(s.T(), err)
+
+	descriptions := []model.HvacOverrunDescriptionDataType{
+		{
+			OverrunId:   util.Ptr(model.HvacOverrunIdType(0)),
+			OverrunType: util.Ptr(model.HvacOverrunTypeTypeParty),
+		},
+		{
+			OverrunId:   util.Ptr(model.HvacOverrunIdType(1)),
+			OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition1),
+		},
+		{
+			OverrunId:   util.Ptr(model.HvacOverrunIdType(2)),
+			OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition3),
+		},
+	}
+
+	assert.False(s.T(), IsSGReadyOverrun(descriptions[0]))
+	assert.True(s.T(), IsSGReadyOverrun(descriptions[1]))
+	assert.False(s.T(), IsSGReadyOverrun(model.HvacOverrunDescriptionDataType{
+		OverrunType: util.Ptr(model.HvacOverrunTypeTypeSgReadyCondition1),
+	}))
+
+	states = SGReadyStates(descriptions)
+	assert.Equal(s.T(), []ucapi.SGReadyStateType{
+		ucapi.SGReadyStateTypeBlocked,
+		ucapi.SGReadyStateTypeNormal,
+		ucapi.SGReadyStateTypeRecommendedIncrease,
+	}, states)
+
+	state, err := SGReadyState(descriptions, nil)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeNormal, state)
+
+	data := []model.HvacOverrunDataType{
+		{
+			OverrunId:     util.Ptr(model.HvacOverrunIdType(0)),
+			OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeActive),
+		},
+		{
+			OverrunId:     util.Ptr(model.HvacOverrunIdType(1)),
+			OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeInactive),
+		},
+		{
+			OverrunId:     util.Ptr(model.HvacOverrunIdType(2)),
+			OverrunStatus: util.Ptr(model.HvacOverrunStatusTypeRunning),
+		},
+	}
+	state, err = SGReadyState(descriptions, data)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeRecommendedIncrease, state)
+
+	_, err = SGReadyOverrunData(descriptions, ucapi.SGReadyStateTypeForcedIncrease)
+	assert.NotNil(s.T(), err)
+
+	_, err = SGReadyOverrunData(descriptions, ucapi.SGReadyStateType(5))
+	assert.NotNil(s.T(), err)
+
+	result, err := SGReadyOverrunData(descriptions, ucapi.SGReadyStateTypeBlocked)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(result))
+	assert.Equal(s.T(), model.HvacOverrunIdType(1), *result[0].OverrunId)
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeActive, *result[0].OverrunStatus)
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeInactive, *result[1].OverrunStatus)
+
+	result, err = SGReadyOverrunData(descriptions, ucapi.SGReadyStateTypeNormal)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(result))
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeInactive, *result[0].OverrunStatus)
+	assert.Equal(s.T(), model.HvacOverrunStatusTypeInactive, *result[1].OverrunStatus)
+
+	state, err = SGReadyState(descriptions, result)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ucapi.SGReadyStateTypeNormal, state)
+}
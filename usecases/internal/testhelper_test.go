@@ -96,11 +96,16 @@ func setupDevices(
 	localEntity.AddFeature(f)
 	f = spine.NewFeatureLocal(5, localEntity, model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeClient)
 	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(6, localEntity, model.FeatureTypeTypeSetpoint, model.RoleTypeClient)
+	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(7, localEntity, model.FeatureTypeTypeHvac, model.RoleTypeClient)
+	localEntity.AddFeature(f)
 	f = spine.NewFeatureLocal(1, localEntity, model.FeatureTypeTypeLoadControl, model.RoleTypeServer)
 	f.AddFunctionType(model.FunctionTypeLoadControlLimitDescriptionListData, true, false)
 	f.AddFunctionType(model.FunctionTypeLoadControlLimitListData, true, true)
 	localEntity.AddFeature(f)
 	f = spine.NewFeatureLocal(2, localEntity, model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
 	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
 	f.AddFunctionType(model.FunctionTypeElectricalConnectionPermittedValueSetListData, true, false)
 	f.AddFunctionType(model.FunctionTypeElectricalConnectionCharacteristicListData, true, true)
@@ -113,6 +118,10 @@ func setupDevices(
 	f.AddFunctionType(model.FunctionTypeDeviceClassificationManufacturerData, true, false)
 	f.AddFunctionType(model.FunctionTypeDeviceClassificationUserData, true, true)
 	localEntity.AddFeature(f)
+	f = spine.NewFeatureLocal(5, localEntity, model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+	localEntity.AddFeature(f)
 
 	writeHandler := shipmocks.NewShipConnectionDataWriterInterface(t)
 	writeHandler.EXPECT().WriteShipMessageWithPayload(mock.Anything).Return().Maybe()
@@ -160,6 +169,14 @@ func setupDevices(
 				model.FunctionTypeDeviceConfigurationKeyValueListData,
 			},
 		},
+		{model.FeatureTypeTypeSetpoint,
+			model.RoleTypeServer,
+			[]model.FunctionType{
+				model.FunctionTypeSetpointDescriptionListData,
+				model.FunctionTypeSetpointConstraintsListData,
+				model.FunctionTypeSetpointListData,
+			},
+		},
 	}
 
 	remoteDeviceName := "remote"
@@ -285,6 +285,106 @@ func (_c *CsLPCInterface_ConsumptionNominalMax_Call) RunAndReturn(run func() (fl
 	return _c
 }
 
+// CurrentState provides a mock function with given fields:
+func (_m *CsLPCInterface) CurrentState() api.LimitationStateType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentState")
+	}
+
+	var r0 api.LimitationStateType
+	if rf, ok := ret.Get(0).(func() api.LimitationStateType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(api.LimitationStateType)
+	}
+
+	return r0
+}
+
+// CsLPCInterface_CurrentState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentState'
+type CsLPCInterface_CurrentState_Call struct {
+	*mock.Call
+}
+
+// CurrentState is a helper method to define mock.On call
+func (_e *CsLPCInterface_Expecter) CurrentState() *CsLPCInterface_CurrentState_Call {
+	return &CsLPCInterface_CurrentState_Call{Call: _e.mock.On("CurrentState")}
+}
+
+func (_c *CsLPCInterface_CurrentState_Call) Run(run func()) *CsLPCInterface_CurrentState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_CurrentState_Call) Return(_a0 api.LimitationStateType) *CsLPCInterface_CurrentState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CsLPCInterface_CurrentState_Call) RunAndReturn(run func() api.LimitationStateType) *CsLPCInterface_CurrentState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EffectiveConsumptionLimit provides a mock function with given fields:
+func (_m *CsLPCInterface) EffectiveConsumptionLimit() (float64, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EffectiveConsumptionLimit")
+	}
+
+	var r0 float64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (float64, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// CsLPCInterface_EffectiveConsumptionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EffectiveConsumptionLimit'
+type CsLPCInterface_EffectiveConsumptionLimit_Call struct {
+	*mock.Call
+}
+
+// EffectiveConsumptionLimit is a helper method to define mock.On call
+func (_e *CsLPCInterface_Expecter) EffectiveConsumptionLimit() *CsLPCInterface_EffectiveConsumptionLimit_Call {
+	return &CsLPCInterface_EffectiveConsumptionLimit_Call{Call: _e.mock.On("EffectiveConsumptionLimit")}
+}
+
+func (_c *CsLPCInterface_EffectiveConsumptionLimit_Call) Run(run func()) *CsLPCInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_EffectiveConsumptionLimit_Call) Return(value float64, isLimited bool) *CsLPCInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Return(value, isLimited)
+	return _c
+}
+
+func (_c *CsLPCInterface_EffectiveConsumptionLimit_Call) RunAndReturn(run func() (float64, bool)) *CsLPCInterface_EffectiveConsumptionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FailsafeConsumptionActivePowerLimit provides a mock function with given fields:
 func (_m *CsLPCInterface) FailsafeConsumptionActivePowerLimit() (float64, bool, error) {
 	ret := _m.Called()
@@ -673,6 +773,39 @@ func (_c *CsLPCInterface_RemoveUseCase_Call) RunAndReturn(run func()) *CsLPCInte
 	return _c
 }
 
+// SetClock provides a mock function with given fields: clock
+func (_m *CsLPCInterface) SetClock(clock api.ClockInterface) {
+	_m.Called(clock)
+}
+
+// CsLPCInterface_SetClock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClock'
+type CsLPCInterface_SetClock_Call struct {
+	*mock.Call
+}
+
+// SetClock is a helper method to define mock.On call
+//   - clock api.ClockInterface
+func (_e *CsLPCInterface_Expecter) SetClock(clock interface{}) *CsLPCInterface_SetClock_Call {
+	return &CsLPCInterface_SetClock_Call{Call: _e.mock.On("SetClock", clock)}
+}
+
+func (_c *CsLPCInterface_SetClock_Call) Run(run func(clock api.ClockInterface)) *CsLPCInterface_SetClock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ClockInterface))
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_SetClock_Call) Return() *CsLPCInterface_SetClock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPCInterface_SetClock_Call) RunAndReturn(run func(api.ClockInterface)) *CsLPCInterface_SetClock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetConsumptionLimit provides a mock function with given fields: limit
 func (_m *CsLPCInterface) SetConsumptionLimit(limit api.LoadLimit) error {
 	ret := _m.Called(limit)
@@ -859,6 +992,74 @@ func (_c *CsLPCInterface_SetFailsafeDurationMinimum_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SetLimitStore provides a mock function with given fields: store
+func (_m *CsLPCInterface) SetLimitStore(store api.LimitStoreInterface) {
+	_m.Called(store)
+}
+
+// CsLPCInterface_SetLimitStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLimitStore'
+type CsLPCInterface_SetLimitStore_Call struct {
+	*mock.Call
+}
+
+// SetLimitStore is a helper method to define mock.On call
+//   - store api.LimitStoreInterface
+func (_e *CsLPCInterface_Expecter) SetLimitStore(store interface{}) *CsLPCInterface_SetLimitStore_Call {
+	return &CsLPCInterface_SetLimitStore_Call{Call: _e.mock.On("SetLimitStore", store)}
+}
+
+func (_c *CsLPCInterface_SetLimitStore_Call) Run(run func(store api.LimitStoreInterface)) *CsLPCInterface_SetLimitStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.LimitStoreInterface))
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_SetLimitStore_Call) Return() *CsLPCInterface_SetLimitStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPCInterface_SetLimitStore_Call) RunAndReturn(run func(api.LimitStoreInterface)) *CsLPCInterface_SetLimitStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWriteApprovalTimeout provides a mock function with given fields: timeout, policy, reason
+func (_m *CsLPCInterface) SetWriteApprovalTimeout(timeout time.Duration, policy api.WriteApprovalPolicyType, reason string) {
+	_m.Called(timeout, policy, reason)
+}
+
+// CsLPCInterface_SetWriteApprovalTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWriteApprovalTimeout'
+type CsLPCInterface_SetWriteApprovalTimeout_Call struct {
+	*mock.Call
+}
+
+// SetWriteApprovalTimeout is a helper method to define mock.On call
+//   - timeout time.Duration
+//   - policy api.WriteApprovalPolicyType
+//   - reason string
+func (_e *CsLPCInterface_Expecter) SetWriteApprovalTimeout(timeout interface{}, policy interface{}, reason interface{}) *CsLPCInterface_SetWriteApprovalTimeout_Call {
+	return &CsLPCInterface_SetWriteApprovalTimeout_Call{Call: _e.mock.On("SetWriteApprovalTimeout", timeout, policy, reason)}
+}
+
+func (_c *CsLPCInterface_SetWriteApprovalTimeout_Call) Run(run func(timeout time.Duration, policy api.WriteApprovalPolicyType, reason string)) *CsLPCInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(api.WriteApprovalPolicyType), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_SetWriteApprovalTimeout_Call) Return() *CsLPCInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPCInterface_SetWriteApprovalTimeout_Call) RunAndReturn(run func(time.Duration, api.WriteApprovalPolicyType, string)) *CsLPCInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartHeartbeat provides a mock function with given fields:
 func (_m *CsLPCInterface) StartHeartbeat() {
 	_m.Called()
@@ -891,6 +1092,38 @@ func (_c *CsLPCInterface_StartHeartbeat_Call) RunAndReturn(run func()) *CsLPCInt
 	return _c
 }
 
+// StartStateMachine provides a mock function with given fields:
+func (_m *CsLPCInterface) StartStateMachine() {
+	_m.Called()
+}
+
+// CsLPCInterface_StartStateMachine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartStateMachine'
+type CsLPCInterface_StartStateMachine_Call struct {
+	*mock.Call
+}
+
+// StartStateMachine is a helper method to define mock.On call
+func (_e *CsLPCInterface_Expecter) StartStateMachine() *CsLPCInterface_StartStateMachine_Call {
+	return &CsLPCInterface_StartStateMachine_Call{Call: _e.mock.On("StartStateMachine")}
+}
+
+func (_c *CsLPCInterface_StartStateMachine_Call) Run(run func()) *CsLPCInterface_StartStateMachine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_StartStateMachine_Call) Return() *CsLPCInterface_StartStateMachine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPCInterface_StartStateMachine_Call) RunAndReturn(run func()) *CsLPCInterface_StartStateMachine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopHeartbeat provides a mock function with given fields:
 func (_m *CsLPCInterface) StopHeartbeat() {
 	_m.Called()
@@ -923,6 +1156,38 @@ func (_c *CsLPCInterface_StopHeartbeat_Call) RunAndReturn(run func()) *CsLPCInte
 	return _c
 }
 
+// StopStateMachine provides a mock function with given fields:
+func (_m *CsLPCInterface) StopStateMachine() {
+	_m.Called()
+}
+
+// CsLPCInterface_StopStateMachine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopStateMachine'
+type CsLPCInterface_StopStateMachine_Call struct {
+	*mock.Call
+}
+
+// StopStateMachine is a helper method to define mock.On call
+func (_e *CsLPCInterface_Expecter) StopStateMachine() *CsLPCInterface_StopStateMachine_Call {
+	return &CsLPCInterface_StopStateMachine_Call{Call: _e.mock.On("StopStateMachine")}
+}
+
+func (_c *CsLPCInterface_StopStateMachine_Call) Run(run func()) *CsLPCInterface_StopStateMachine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPCInterface_StopStateMachine_Call) Return() *CsLPCInterface_StopStateMachine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPCInterface_StopStateMachine_Call) RunAndReturn(run func()) *CsLPCInterface_StopStateMachine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUseCaseAvailability provides a mock function with given fields: available
 func (_m *CsLPCInterface) UpdateUseCaseAvailability(available bool) {
 	_m.Called(available)
@@ -175,6 +175,106 @@ func (_c *CsLPPInterface_AvailableScenariosForEntity_Call) RunAndReturn(run func
 	return _c
 }
 
+// CurrentState provides a mock function with given fields:
+func (_m *CsLPPInterface) CurrentState() api.LimitationStateType {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentState")
+	}
+
+	var r0 api.LimitationStateType
+	if rf, ok := ret.Get(0).(func() api.LimitationStateType); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(api.LimitationStateType)
+	}
+
+	return r0
+}
+
+// CsLPPInterface_CurrentState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentState'
+type CsLPPInterface_CurrentState_Call struct {
+	*mock.Call
+}
+
+// CurrentState is a helper method to define mock.On call
+func (_e *CsLPPInterface_Expecter) CurrentState() *CsLPPInterface_CurrentState_Call {
+	return &CsLPPInterface_CurrentState_Call{Call: _e.mock.On("CurrentState")}
+}
+
+func (_c *CsLPPInterface_CurrentState_Call) Run(run func()) *CsLPPInterface_CurrentState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_CurrentState_Call) Return(_a0 api.LimitationStateType) *CsLPPInterface_CurrentState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CsLPPInterface_CurrentState_Call) RunAndReturn(run func() api.LimitationStateType) *CsLPPInterface_CurrentState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EffectiveProductionLimit provides a mock function with given fields:
+func (_m *CsLPPInterface) EffectiveProductionLimit() (float64, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for EffectiveProductionLimit")
+	}
+
+	var r0 float64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (float64, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// CsLPPInterface_EffectiveProductionLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EffectiveProductionLimit'
+type CsLPPInterface_EffectiveProductionLimit_Call struct {
+	*mock.Call
+}
+
+// EffectiveProductionLimit is a helper method to define mock.On call
+func (_e *CsLPPInterface_Expecter) EffectiveProductionLimit() *CsLPPInterface_EffectiveProductionLimit_Call {
+	return &CsLPPInterface_EffectiveProductionLimit_Call{Call: _e.mock.On("EffectiveProductionLimit")}
+}
+
+func (_c *CsLPPInterface_EffectiveProductionLimit_Call) Run(run func()) *CsLPPInterface_EffectiveProductionLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_EffectiveProductionLimit_Call) Return(value float64, isLimited bool) *CsLPPInterface_EffectiveProductionLimit_Call {
+	_c.Call.Return(value, isLimited)
+	return _c
+}
+
+func (_c *CsLPPInterface_EffectiveProductionLimit_Call) RunAndReturn(run func() (float64, bool)) *CsLPPInterface_EffectiveProductionLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FailsafeDurationMinimum provides a mock function with given fields:
 func (_m *CsLPPInterface) FailsafeDurationMinimum() (time.Duration, bool, error) {
 	ret := _m.Called()
@@ -673,6 +773,39 @@ func (_c *CsLPPInterface_RemoveUseCase_Call) RunAndReturn(run func()) *CsLPPInte
 	return _c
 }
 
+// SetClock provides a mock function with given fields: clock
+func (_m *CsLPPInterface) SetClock(clock api.ClockInterface) {
+	_m.Called(clock)
+}
+
+// CsLPPInterface_SetClock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetClock'
+type CsLPPInterface_SetClock_Call struct {
+	*mock.Call
+}
+
+// SetClock is a helper method to define mock.On call
+//   - clock api.ClockInterface
+func (_e *CsLPPInterface_Expecter) SetClock(clock interface{}) *CsLPPInterface_SetClock_Call {
+	return &CsLPPInterface_SetClock_Call{Call: _e.mock.On("SetClock", clock)}
+}
+
+func (_c *CsLPPInterface_SetClock_Call) Run(run func(clock api.ClockInterface)) *CsLPPInterface_SetClock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.ClockInterface))
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_SetClock_Call) Return() *CsLPPInterface_SetClock_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPPInterface_SetClock_Call) RunAndReturn(run func(api.ClockInterface)) *CsLPPInterface_SetClock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetFailsafeDurationMinimum provides a mock function with given fields: duration, changeable
 func (_m *CsLPPInterface) SetFailsafeDurationMinimum(duration time.Duration, changeable bool) error {
 	ret := _m.Called(duration, changeable)
@@ -767,6 +900,39 @@ func (_c *CsLPPInterface_SetFailsafeProductionActivePowerLimit_Call) RunAndRetur
 	return _c
 }
 
+// SetLimitStore provides a mock function with given fields: store
+func (_m *CsLPPInterface) SetLimitStore(store api.LimitStoreInterface) {
+	_m.Called(store)
+}
+
+// CsLPPInterface_SetLimitStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLimitStore'
+type CsLPPInterface_SetLimitStore_Call struct {
+	*mock.Call
+}
+
+// SetLimitStore is a helper method to define mock.On call
+//   - store api.LimitStoreInterface
+func (_e *CsLPPInterface_Expecter) SetLimitStore(store interface{}) *CsLPPInterface_SetLimitStore_Call {
+	return &CsLPPInterface_SetLimitStore_Call{Call: _e.mock.On("SetLimitStore", store)}
+}
+
+func (_c *CsLPPInterface_SetLimitStore_Call) Run(run func(store api.LimitStoreInterface)) *CsLPPInterface_SetLimitStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(api.LimitStoreInterface))
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_SetLimitStore_Call) Return() *CsLPPInterface_SetLimitStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPPInterface_SetLimitStore_Call) RunAndReturn(run func(api.LimitStoreInterface)) *CsLPPInterface_SetLimitStore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetProductionLimit provides a mock function with given fields: limit
 func (_m *CsLPPInterface) SetProductionLimit(limit api.LoadLimit) error {
 	ret := _m.Called(limit)
@@ -859,6 +1025,41 @@ func (_c *CsLPPInterface_SetProductionNominalMax_Call) RunAndReturn(run func(flo
 	return _c
 }
 
+// SetWriteApprovalTimeout provides a mock function with given fields: timeout, policy, reason
+func (_m *CsLPPInterface) SetWriteApprovalTimeout(timeout time.Duration, policy api.WriteApprovalPolicyType, reason string) {
+	_m.Called(timeout, policy, reason)
+}
+
+// CsLPPInterface_SetWriteApprovalTimeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWriteApprovalTimeout'
+type CsLPPInterface_SetWriteApprovalTimeout_Call struct {
+	*mock.Call
+}
+
+// SetWriteApprovalTimeout is a helper method to define mock.On call
+//   - timeout time.Duration
+//   - policy api.WriteApprovalPolicyType
+//   - reason string
+func (_e *CsLPPInterface_Expecter) SetWriteApprovalTimeout(timeout interface{}, policy interface{}, reason interface{}) *CsLPPInterface_SetWriteApprovalTimeout_Call {
+	return &CsLPPInterface_SetWriteApprovalTimeout_Call{Call: _e.mock.On("SetWriteApprovalTimeout", timeout, policy, reason)}
+}
+
+func (_c *CsLPPInterface_SetWriteApprovalTimeout_Call) Run(run func(timeout time.Duration, policy api.WriteApprovalPolicyType, reason string)) *CsLPPInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration), args[1].(api.WriteApprovalPolicyType), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_SetWriteApprovalTimeout_Call) Return() *CsLPPInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPPInterface_SetWriteApprovalTimeout_Call) RunAndReturn(run func(time.Duration, api.WriteApprovalPolicyType, string)) *CsLPPInterface_SetWriteApprovalTimeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartHeartbeat provides a mock function with given fields:
 func (_m *CsLPPInterface) StartHeartbeat() {
 	_m.Called()
@@ -891,6 +1092,38 @@ func (_c *CsLPPInterface_StartHeartbeat_Call) RunAndReturn(run func()) *CsLPPInt
 	return _c
 }
 
+// StartStateMachine provides a mock function with given fields:
+func (_m *CsLPPInterface) StartStateMachine() {
+	_m.Called()
+}
+
+// CsLPPInterface_StartStateMachine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartStateMachine'
+type CsLPPInterface_StartStateMachine_Call struct {
+	*mock.Call
+}
+
+// StartStateMachine is a helper method to define mock.On call
+func (_e *CsLPPInterface_Expecter) StartStateMachine() *CsLPPInterface_StartStateMachine_Call {
+	return &CsLPPInterface_StartStateMachine_Call{Call: _e.mock.On("StartStateMachine")}
+}
+
+func (_c *CsLPPInterface_StartStateMachine_Call) Run(run func()) *CsLPPInterface_StartStateMachine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_StartStateMachine_Call) Return() *CsLPPInterface_StartStateMachine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPPInterface_StartStateMachine_Call) RunAndReturn(run func()) *CsLPPInterface_StartStateMachine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StopHeartbeat provides a mock function with given fields:
 func (_m *CsLPPInterface) StopHeartbeat() {
 	_m.Called()
@@ -923,6 +1156,38 @@ func (_c *CsLPPInterface_StopHeartbeat_Call) RunAndReturn(run func()) *CsLPPInte
 	return _c
 }
 
+// StopStateMachine provides a mock function with given fields:
+func (_m *CsLPPInterface) StopStateMachine() {
+	_m.Called()
+}
+
+// CsLPPInterface_StopStateMachine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopStateMachine'
+type CsLPPInterface_StopStateMachine_Call struct {
+	*mock.Call
+}
+
+// StopStateMachine is a helper method to define mock.On call
+func (_e *CsLPPInterface_Expecter) StopStateMachine() *CsLPPInterface_StopStateMachine_Call {
+	return &CsLPPInterface_StopStateMachine_Call{Call: _e.mock.On("StopStateMachine")}
+}
+
+func (_c *CsLPPInterface_StopStateMachine_Call) Run(run func()) *CsLPPInterface_StopStateMachine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CsLPPInterface_StopStateMachine_Call) Return() *CsLPPInterface_StopStateMachine_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CsLPPInterface_StopStateMachine_Call) RunAndReturn(run func()) *CsLPPInterface_StopStateMachine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUseCaseAvailability provides a mock function with given fields: available
 func (_m *CsLPPInterface) UpdateUseCaseAvailability(available bool) {
 	_m.Called(available)
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.42.1. DO NOT EDIT.
+// Code generated by mockery v2.45.0. DO NOT EDIT.
 
 package mocks
 
@@ -134,512 +134,450 @@ func (_c *GcpMGCPInterface_AvailableScenariosForEntity_Call) RunAndReturn(run fu
 	return _c
 }
 
-// CurrentPerPhase provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) CurrentPerPhase(entity spine_goapi.EntityRemoteInterface) ([]float64, error) {
+// IsCompatibleEntityType provides a mock function with given fields: entity
+func (_m *GcpMGCPInterface) IsCompatibleEntityType(entity spine_goapi.EntityRemoteInterface) bool {
 	ret := _m.Called(entity)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CurrentPerPhase")
+		panic("no return value specified for IsCompatibleEntityType")
 	}
 
-	var r0 []float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) ([]float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) []float64); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) bool); ok {
 		r0 = rf(entity)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]float64)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_CurrentPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentPerPhase'
-type GcpMGCPInterface_CurrentPerPhase_Call struct {
+// GcpMGCPInterface_IsCompatibleEntityType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCompatibleEntityType'
+type GcpMGCPInterface_IsCompatibleEntityType_Call struct {
 	*mock.Call
 }
 
-// CurrentPerPhase is a helper method to define mock.On call
+// IsCompatibleEntityType is a helper method to define mock.On call
 //   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) CurrentPerPhase(entity interface{}) *GcpMGCPInterface_CurrentPerPhase_Call {
-	return &GcpMGCPInterface_CurrentPerPhase_Call{Call: _e.mock.On("CurrentPerPhase", entity)}
+func (_e *GcpMGCPInterface_Expecter) IsCompatibleEntityType(entity interface{}) *GcpMGCPInterface_IsCompatibleEntityType_Call {
+	return &GcpMGCPInterface_IsCompatibleEntityType_Call{Call: _e.mock.On("IsCompatibleEntityType", entity)}
 }
 
-func (_c *GcpMGCPInterface_CurrentPerPhase_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_CurrentPerPhase_Call {
+func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_IsCompatibleEntityType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(spine_goapi.EntityRemoteInterface))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_CurrentPerPhase_Call) Return(_a0 []float64, _a1 error) *GcpMGCPInterface_CurrentPerPhase_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) Return(_a0 bool) *GcpMGCPInterface_IsCompatibleEntityType_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_CurrentPerPhase_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) ([]float64, error)) *GcpMGCPInterface_CurrentPerPhase_Call {
+func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) bool) *GcpMGCPInterface_IsCompatibleEntityType_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EnergyConsumed provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) EnergyConsumed(entity spine_goapi.EntityRemoteInterface) (float64, error) {
-	ret := _m.Called(entity)
+// IsScenarioAvailableAtEntity provides a mock function with given fields: entity, scenario
+func (_m *GcpMGCPInterface) IsScenarioAvailableAtEntity(entity spine_goapi.EntityRemoteInterface, scenario uint) bool {
+	ret := _m.Called(entity, scenario)
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnergyConsumed")
-	}
-
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) float64); ok {
-		r0 = rf(entity)
-	} else {
-		r0 = ret.Get(0).(float64)
+		panic("no return value specified for IsScenarioAvailableAtEntity")
 	}
 
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface, uint) bool); ok {
+		r0 = rf(entity, scenario)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_EnergyConsumed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnergyConsumed'
-type GcpMGCPInterface_EnergyConsumed_Call struct {
+// GcpMGCPInterface_IsScenarioAvailableAtEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsScenarioAvailableAtEntity'
+type GcpMGCPInterface_IsScenarioAvailableAtEntity_Call struct {
 	*mock.Call
 }
 
-// EnergyConsumed is a helper method to define mock.On call
+// IsScenarioAvailableAtEntity is a helper method to define mock.On call
 //   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) EnergyConsumed(entity interface{}) *GcpMGCPInterface_EnergyConsumed_Call {
-	return &GcpMGCPInterface_EnergyConsumed_Call{Call: _e.mock.On("EnergyConsumed", entity)}
+//   - scenario uint
+func (_e *GcpMGCPInterface_Expecter) IsScenarioAvailableAtEntity(entity interface{}, scenario interface{}) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
+	return &GcpMGCPInterface_IsScenarioAvailableAtEntity_Call{Call: _e.mock.On("IsScenarioAvailableAtEntity", entity, scenario)}
 }
 
-func (_c *GcpMGCPInterface_EnergyConsumed_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_EnergyConsumed_Call {
+func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) Run(run func(entity spine_goapi.EntityRemoteInterface, scenario uint)) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run(args[0].(spine_goapi.EntityRemoteInterface), args[1].(uint))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_EnergyConsumed_Call) Return(_a0 float64, _a1 error) *GcpMGCPInterface_EnergyConsumed_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) Return(_a0 bool) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_EnergyConsumed_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (float64, error)) *GcpMGCPInterface_EnergyConsumed_Call {
+func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface, uint) bool) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// EnergyFeedIn provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) EnergyFeedIn(entity spine_goapi.EntityRemoteInterface) (float64, error) {
-	ret := _m.Called(entity)
+// RemoteEntitiesScenarios provides a mock function with given fields:
+func (_m *GcpMGCPInterface) RemoteEntitiesScenarios() []eebus_goapi.RemoteEntityScenarios {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for EnergyFeedIn")
-	}
-
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) float64); ok {
-		r0 = rf(entity)
-	} else {
-		r0 = ret.Get(0).(float64)
+		panic("no return value specified for RemoteEntitiesScenarios")
 	}
 
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
+	var r0 []eebus_goapi.RemoteEntityScenarios
+	if rf, ok := ret.Get(0).(func() []eebus_goapi.RemoteEntityScenarios); ok {
+		r0 = rf()
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]eebus_goapi.RemoteEntityScenarios)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_EnergyFeedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnergyFeedIn'
-type GcpMGCPInterface_EnergyFeedIn_Call struct {
+// GcpMGCPInterface_RemoteEntitiesScenarios_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteEntitiesScenarios'
+type GcpMGCPInterface_RemoteEntitiesScenarios_Call struct {
 	*mock.Call
 }
 
-// EnergyFeedIn is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) EnergyFeedIn(entity interface{}) *GcpMGCPInterface_EnergyFeedIn_Call {
-	return &GcpMGCPInterface_EnergyFeedIn_Call{Call: _e.mock.On("EnergyFeedIn", entity)}
+// RemoteEntitiesScenarios is a helper method to define mock.On call
+func (_e *GcpMGCPInterface_Expecter) RemoteEntitiesScenarios() *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
+	return &GcpMGCPInterface_RemoteEntitiesScenarios_Call{Call: _e.mock.On("RemoteEntitiesScenarios")}
 }
 
-func (_c *GcpMGCPInterface_EnergyFeedIn_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_EnergyFeedIn_Call {
+func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) Run(run func()) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run()
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_EnergyFeedIn_Call) Return(_a0 float64, _a1 error) *GcpMGCPInterface_EnergyFeedIn_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) Return(_a0 []eebus_goapi.RemoteEntityScenarios) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_EnergyFeedIn_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (float64, error)) *GcpMGCPInterface_EnergyFeedIn_Call {
+func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) RunAndReturn(run func() []eebus_goapi.RemoteEntityScenarios) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Frequency provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) Frequency(entity spine_goapi.EntityRemoteInterface) (float64, error) {
-	ret := _m.Called(entity)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Frequency")
-	}
-
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) float64); ok {
-		r0 = rf(entity)
-	} else {
-		r0 = ret.Get(0).(float64)
-	}
-
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+// RemoveUseCase provides a mock function with given fields:
+func (_m *GcpMGCPInterface) RemoveUseCase() {
+	_m.Called()
 }
 
-// GcpMGCPInterface_Frequency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Frequency'
-type GcpMGCPInterface_Frequency_Call struct {
+// GcpMGCPInterface_RemoveUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUseCase'
+type GcpMGCPInterface_RemoveUseCase_Call struct {
 	*mock.Call
 }
 
-// Frequency is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) Frequency(entity interface{}) *GcpMGCPInterface_Frequency_Call {
-	return &GcpMGCPInterface_Frequency_Call{Call: _e.mock.On("Frequency", entity)}
+// RemoveUseCase is a helper method to define mock.On call
+func (_e *GcpMGCPInterface_Expecter) RemoveUseCase() *GcpMGCPInterface_RemoveUseCase_Call {
+	return &GcpMGCPInterface_RemoveUseCase_Call{Call: _e.mock.On("RemoveUseCase")}
 }
 
-func (_c *GcpMGCPInterface_Frequency_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_Frequency_Call {
+func (_c *GcpMGCPInterface_RemoveUseCase_Call) Run(run func()) *GcpMGCPInterface_RemoveUseCase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run()
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_Frequency_Call) Return(_a0 float64, _a1 error) *GcpMGCPInterface_Frequency_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_RemoveUseCase_Call) Return() *GcpMGCPInterface_RemoveUseCase_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *GcpMGCPInterface_Frequency_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (float64, error)) *GcpMGCPInterface_Frequency_Call {
+func (_c *GcpMGCPInterface_RemoveUseCase_Call) RunAndReturn(run func()) *GcpMGCPInterface_RemoveUseCase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsCompatibleEntityType provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) IsCompatibleEntityType(entity spine_goapi.EntityRemoteInterface) bool {
-	ret := _m.Called(entity)
+// UpdateCurrentPerPhase provides a mock function with given fields: phaseCurrent
+func (_m *GcpMGCPInterface) UpdateCurrentPerPhase(phaseCurrent []float64) error {
+	ret := _m.Called(phaseCurrent)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsCompatibleEntityType")
+		panic("no return value specified for UpdateCurrentPerPhase")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) bool); ok {
-		r0 = rf(entity)
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(phaseCurrent)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// GcpMGCPInterface_IsCompatibleEntityType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCompatibleEntityType'
-type GcpMGCPInterface_IsCompatibleEntityType_Call struct {
+// GcpMGCPInterface_UpdateCurrentPerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCurrentPerPhase'
+type GcpMGCPInterface_UpdateCurrentPerPhase_Call struct {
 	*mock.Call
 }
 
-// IsCompatibleEntityType is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) IsCompatibleEntityType(entity interface{}) *GcpMGCPInterface_IsCompatibleEntityType_Call {
-	return &GcpMGCPInterface_IsCompatibleEntityType_Call{Call: _e.mock.On("IsCompatibleEntityType", entity)}
+// UpdateCurrentPerPhase is a helper method to define mock.On call
+//   - phaseCurrent []float64
+func (_e *GcpMGCPInterface_Expecter) UpdateCurrentPerPhase(phaseCurrent interface{}) *GcpMGCPInterface_UpdateCurrentPerPhase_Call {
+	return &GcpMGCPInterface_UpdateCurrentPerPhase_Call{Call: _e.mock.On("UpdateCurrentPerPhase", phaseCurrent)}
 }
 
-func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_IsCompatibleEntityType_Call {
+func (_c *GcpMGCPInterface_UpdateCurrentPerPhase_Call) Run(run func(phaseCurrent []float64)) *GcpMGCPInterface_UpdateCurrentPerPhase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run(args[0].([]float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) Return(_a0 bool) *GcpMGCPInterface_IsCompatibleEntityType_Call {
+func (_c *GcpMGCPInterface_UpdateCurrentPerPhase_Call) Return(_a0 error) *GcpMGCPInterface_UpdateCurrentPerPhase_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_IsCompatibleEntityType_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) bool) *GcpMGCPInterface_IsCompatibleEntityType_Call {
+func (_c *GcpMGCPInterface_UpdateCurrentPerPhase_Call) RunAndReturn(run func([]float64) error) *GcpMGCPInterface_UpdateCurrentPerPhase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsScenarioAvailableAtEntity provides a mock function with given fields: entity, scenario
-func (_m *GcpMGCPInterface) IsScenarioAvailableAtEntity(entity spine_goapi.EntityRemoteInterface, scenario uint) bool {
-	ret := _m.Called(entity, scenario)
+// UpdateEnergyConsumed provides a mock function with given fields: energy
+func (_m *GcpMGCPInterface) UpdateEnergyConsumed(energy float64) error {
+	ret := _m.Called(energy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsScenarioAvailableAtEntity")
+		panic("no return value specified for UpdateEnergyConsumed")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface, uint) bool); ok {
-		r0 = rf(entity, scenario)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(energy)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// GcpMGCPInterface_IsScenarioAvailableAtEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsScenarioAvailableAtEntity'
-type GcpMGCPInterface_IsScenarioAvailableAtEntity_Call struct {
+// GcpMGCPInterface_UpdateEnergyConsumed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnergyConsumed'
+type GcpMGCPInterface_UpdateEnergyConsumed_Call struct {
 	*mock.Call
 }
 
-// IsScenarioAvailableAtEntity is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-//   - scenario uint
-func (_e *GcpMGCPInterface_Expecter) IsScenarioAvailableAtEntity(entity interface{}, scenario interface{}) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
-	return &GcpMGCPInterface_IsScenarioAvailableAtEntity_Call{Call: _e.mock.On("IsScenarioAvailableAtEntity", entity, scenario)}
+// UpdateEnergyConsumed is a helper method to define mock.On call
+//   - energy float64
+func (_e *GcpMGCPInterface_Expecter) UpdateEnergyConsumed(energy interface{}) *GcpMGCPInterface_UpdateEnergyConsumed_Call {
+	return &GcpMGCPInterface_UpdateEnergyConsumed_Call{Call: _e.mock.On("UpdateEnergyConsumed", energy)}
 }
 
-func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) Run(run func(entity spine_goapi.EntityRemoteInterface, scenario uint)) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
+func (_c *GcpMGCPInterface_UpdateEnergyConsumed_Call) Run(run func(energy float64)) *GcpMGCPInterface_UpdateEnergyConsumed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface), args[1].(uint))
+		run(args[0].(float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) Return(_a0 bool) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
+func (_c *GcpMGCPInterface_UpdateEnergyConsumed_Call) Return(_a0 error) *GcpMGCPInterface_UpdateEnergyConsumed_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface, uint) bool) *GcpMGCPInterface_IsScenarioAvailableAtEntity_Call {
+func (_c *GcpMGCPInterface_UpdateEnergyConsumed_Call) RunAndReturn(run func(float64) error) *GcpMGCPInterface_UpdateEnergyConsumed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Power provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) Power(entity spine_goapi.EntityRemoteInterface) (float64, error) {
-	ret := _m.Called(entity)
+// UpdateEnergyFeedIn provides a mock function with given fields: energy
+func (_m *GcpMGCPInterface) UpdateEnergyFeedIn(energy float64) error {
+	ret := _m.Called(energy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Power")
-	}
-
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) float64); ok {
-		r0 = rf(entity)
-	} else {
-		r0 = ret.Get(0).(float64)
+		panic("no return value specified for UpdateEnergyFeedIn")
 	}
 
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(energy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_Power_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Power'
-type GcpMGCPInterface_Power_Call struct {
+// GcpMGCPInterface_UpdateEnergyFeedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnergyFeedIn'
+type GcpMGCPInterface_UpdateEnergyFeedIn_Call struct {
 	*mock.Call
 }
 
-// Power is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) Power(entity interface{}) *GcpMGCPInterface_Power_Call {
-	return &GcpMGCPInterface_Power_Call{Call: _e.mock.On("Power", entity)}
+// UpdateEnergyFeedIn is a helper method to define mock.On call
+//   - energy float64
+func (_e *GcpMGCPInterface_Expecter) UpdateEnergyFeedIn(energy interface{}) *GcpMGCPInterface_UpdateEnergyFeedIn_Call {
+	return &GcpMGCPInterface_UpdateEnergyFeedIn_Call{Call: _e.mock.On("UpdateEnergyFeedIn", energy)}
 }
 
-func (_c *GcpMGCPInterface_Power_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_Power_Call {
+func (_c *GcpMGCPInterface_UpdateEnergyFeedIn_Call) Run(run func(energy float64)) *GcpMGCPInterface_UpdateEnergyFeedIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run(args[0].(float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_Power_Call) Return(_a0 float64, _a1 error) *GcpMGCPInterface_Power_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_UpdateEnergyFeedIn_Call) Return(_a0 error) *GcpMGCPInterface_UpdateEnergyFeedIn_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_Power_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (float64, error)) *GcpMGCPInterface_Power_Call {
+func (_c *GcpMGCPInterface_UpdateEnergyFeedIn_Call) RunAndReturn(run func(float64) error) *GcpMGCPInterface_UpdateEnergyFeedIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PowerLimitationFactor provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) PowerLimitationFactor(entity spine_goapi.EntityRemoteInterface) (float64, error) {
-	ret := _m.Called(entity)
+// UpdateFrequency provides a mock function with given fields: frequency
+func (_m *GcpMGCPInterface) UpdateFrequency(frequency float64) error {
+	ret := _m.Called(frequency)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PowerLimitationFactor")
+		panic("no return value specified for UpdateFrequency")
 	}
 
-	var r0 float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) (float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) float64); ok {
-		r0 = rf(entity)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(frequency)
 	} else {
-		r0 = ret.Get(0).(float64)
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_PowerLimitationFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PowerLimitationFactor'
-type GcpMGCPInterface_PowerLimitationFactor_Call struct {
+// GcpMGCPInterface_UpdateFrequency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFrequency'
+type GcpMGCPInterface_UpdateFrequency_Call struct {
 	*mock.Call
 }
 
-// PowerLimitationFactor is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) PowerLimitationFactor(entity interface{}) *GcpMGCPInterface_PowerLimitationFactor_Call {
-	return &GcpMGCPInterface_PowerLimitationFactor_Call{Call: _e.mock.On("PowerLimitationFactor", entity)}
+// UpdateFrequency is a helper method to define mock.On call
+//   - frequency float64
+func (_e *GcpMGCPInterface_Expecter) UpdateFrequency(frequency interface{}) *GcpMGCPInterface_UpdateFrequency_Call {
+	return &GcpMGCPInterface_UpdateFrequency_Call{Call: _e.mock.On("UpdateFrequency", frequency)}
 }
 
-func (_c *GcpMGCPInterface_PowerLimitationFactor_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_PowerLimitationFactor_Call {
+func (_c *GcpMGCPInterface_UpdateFrequency_Call) Run(run func(frequency float64)) *GcpMGCPInterface_UpdateFrequency_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run(args[0].(float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_PowerLimitationFactor_Call) Return(_a0 float64, _a1 error) *GcpMGCPInterface_PowerLimitationFactor_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_UpdateFrequency_Call) Return(_a0 error) *GcpMGCPInterface_UpdateFrequency_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_PowerLimitationFactor_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) (float64, error)) *GcpMGCPInterface_PowerLimitationFactor_Call {
+func (_c *GcpMGCPInterface_UpdateFrequency_Call) RunAndReturn(run func(float64) error) *GcpMGCPInterface_UpdateFrequency_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoteEntitiesScenarios provides a mock function with given fields:
-func (_m *GcpMGCPInterface) RemoteEntitiesScenarios() []eebus_goapi.RemoteEntityScenarios {
-	ret := _m.Called()
+// UpdatePower provides a mock function with given fields: power
+func (_m *GcpMGCPInterface) UpdatePower(power float64) error {
+	ret := _m.Called(power)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoteEntitiesScenarios")
+		panic("no return value specified for UpdatePower")
 	}
 
-	var r0 []eebus_goapi.RemoteEntityScenarios
-	if rf, ok := ret.Get(0).(func() []eebus_goapi.RemoteEntityScenarios); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(power)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]eebus_goapi.RemoteEntityScenarios)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// GcpMGCPInterface_RemoteEntitiesScenarios_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteEntitiesScenarios'
-type GcpMGCPInterface_RemoteEntitiesScenarios_Call struct {
+// GcpMGCPInterface_UpdatePower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePower'
+type GcpMGCPInterface_UpdatePower_Call struct {
 	*mock.Call
 }
 
-// RemoteEntitiesScenarios is a helper method to define mock.On call
-func (_e *GcpMGCPInterface_Expecter) RemoteEntitiesScenarios() *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
-	return &GcpMGCPInterface_RemoteEntitiesScenarios_Call{Call: _e.mock.On("RemoteEntitiesScenarios")}
+// UpdatePower is a helper method to define mock.On call
+//   - power float64
+func (_e *GcpMGCPInterface_Expecter) UpdatePower(power interface{}) *GcpMGCPInterface_UpdatePower_Call {
+	return &GcpMGCPInterface_UpdatePower_Call{Call: _e.mock.On("UpdatePower", power)}
 }
 
-func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) Run(run func()) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
+func (_c *GcpMGCPInterface_UpdatePower_Call) Run(run func(power float64)) *GcpMGCPInterface_UpdatePower_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) Return(_a0 []eebus_goapi.RemoteEntityScenarios) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
+func (_c *GcpMGCPInterface_UpdatePower_Call) Return(_a0 error) *GcpMGCPInterface_UpdatePower_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_RemoteEntitiesScenarios_Call) RunAndReturn(run func() []eebus_goapi.RemoteEntityScenarios) *GcpMGCPInterface_RemoteEntitiesScenarios_Call {
+func (_c *GcpMGCPInterface_UpdatePower_Call) RunAndReturn(run func(float64) error) *GcpMGCPInterface_UpdatePower_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveUseCase provides a mock function with given fields:
-func (_m *GcpMGCPInterface) RemoveUseCase() {
-	_m.Called()
+// UpdatePowerLimitationFactor provides a mock function with given fields: factor
+func (_m *GcpMGCPInterface) UpdatePowerLimitationFactor(factor float64) error {
+	ret := _m.Called(factor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePowerLimitationFactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64) error); ok {
+		r0 = rf(factor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// GcpMGCPInterface_RemoveUseCase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveUseCase'
-type GcpMGCPInterface_RemoveUseCase_Call struct {
+// GcpMGCPInterface_UpdatePowerLimitationFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePowerLimitationFactor'
+type GcpMGCPInterface_UpdatePowerLimitationFactor_Call struct {
 	*mock.Call
 }
 
-// RemoveUseCase is a helper method to define mock.On call
-func (_e *GcpMGCPInterface_Expecter) RemoveUseCase() *GcpMGCPInterface_RemoveUseCase_Call {
-	return &GcpMGCPInterface_RemoveUseCase_Call{Call: _e.mock.On("RemoveUseCase")}
+// UpdatePowerLimitationFactor is a helper method to define mock.On call
+//   - factor float64
+func (_e *GcpMGCPInterface_Expecter) UpdatePowerLimitationFactor(factor interface{}) *GcpMGCPInterface_UpdatePowerLimitationFactor_Call {
+	return &GcpMGCPInterface_UpdatePowerLimitationFactor_Call{Call: _e.mock.On("UpdatePowerLimitationFactor", factor)}
 }
 
-func (_c *GcpMGCPInterface_RemoveUseCase_Call) Run(run func()) *GcpMGCPInterface_RemoveUseCase_Call {
+func (_c *GcpMGCPInterface_UpdatePowerLimitationFactor_Call) Run(run func(factor float64)) *GcpMGCPInterface_UpdatePowerLimitationFactor_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_RemoveUseCase_Call) Return() *GcpMGCPInterface_RemoveUseCase_Call {
-	_c.Call.Return()
+func (_c *GcpMGCPInterface_UpdatePowerLimitationFactor_Call) Return(_a0 error) *GcpMGCPInterface_UpdatePowerLimitationFactor_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_RemoveUseCase_Call) RunAndReturn(run func()) *GcpMGCPInterface_RemoveUseCase_Call {
+func (_c *GcpMGCPInterface_UpdatePowerLimitationFactor_Call) RunAndReturn(run func(float64) error) *GcpMGCPInterface_UpdatePowerLimitationFactor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -677,60 +615,48 @@ func (_c *GcpMGCPInterface_UpdateUseCaseAvailability_Call) RunAndReturn(run func
 	return _c
 }
 
-// VoltagePerPhase provides a mock function with given fields: entity
-func (_m *GcpMGCPInterface) VoltagePerPhase(entity spine_goapi.EntityRemoteInterface) ([]float64, error) {
-	ret := _m.Called(entity)
+// UpdateVoltagePerPhase provides a mock function with given fields: phaseVoltage
+func (_m *GcpMGCPInterface) UpdateVoltagePerPhase(phaseVoltage []float64) error {
+	ret := _m.Called(phaseVoltage)
 
 	if len(ret) == 0 {
-		panic("no return value specified for VoltagePerPhase")
+		panic("no return value specified for UpdateVoltagePerPhase")
 	}
 
-	var r0 []float64
-	var r1 error
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) ([]float64, error)); ok {
-		return rf(entity)
-	}
-	if rf, ok := ret.Get(0).(func(spine_goapi.EntityRemoteInterface) []float64); ok {
-		r0 = rf(entity)
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]float64) error); ok {
+		r0 = rf(phaseVoltage)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]float64)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(spine_goapi.EntityRemoteInterface) error); ok {
-		r1 = rf(entity)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// GcpMGCPInterface_VoltagePerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VoltagePerPhase'
-type GcpMGCPInterface_VoltagePerPhase_Call struct {
+// GcpMGCPInterface_UpdateVoltagePerPhase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateVoltagePerPhase'
+type GcpMGCPInterface_UpdateVoltagePerPhase_Call struct {
 	*mock.Call
 }
 
-// VoltagePerPhase is a helper method to define mock.On call
-//   - entity spine_goapi.EntityRemoteInterface
-func (_e *GcpMGCPInterface_Expecter) VoltagePerPhase(entity interface{}) *GcpMGCPInterface_VoltagePerPhase_Call {
-	return &GcpMGCPInterface_VoltagePerPhase_Call{Call: _e.mock.On("VoltagePerPhase", entity)}
+// UpdateVoltagePerPhase is a helper method to define mock.On call
+//   - phaseVoltage []float64
+func (_e *GcpMGCPInterface_Expecter) UpdateVoltagePerPhase(phaseVoltage interface{}) *GcpMGCPInterface_UpdateVoltagePerPhase_Call {
+	return &GcpMGCPInterface_UpdateVoltagePerPhase_Call{Call: _e.mock.On("UpdateVoltagePerPhase", phaseVoltage)}
 }
 
-func (_c *GcpMGCPInterface_VoltagePerPhase_Call) Run(run func(entity spine_goapi.EntityRemoteInterface)) *GcpMGCPInterface_VoltagePerPhase_Call {
+func (_c *GcpMGCPInterface_UpdateVoltagePerPhase_Call) Run(run func(phaseVoltage []float64)) *GcpMGCPInterface_UpdateVoltagePerPhase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(spine_goapi.EntityRemoteInterface))
+		run(args[0].([]float64))
 	})
 	return _c
 }
 
-func (_c *GcpMGCPInterface_VoltagePerPhase_Call) Return(_a0 []float64, _a1 error) *GcpMGCPInterface_VoltagePerPhase_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *GcpMGCPInterface_UpdateVoltagePerPhase_Call) Return(_a0 error) *GcpMGCPInterface_UpdateVoltagePerPhase_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *GcpMGCPInterface_VoltagePerPhase_Call) RunAndReturn(run func(spine_goapi.EntityRemoteInterface) ([]float64, error)) *GcpMGCPInterface_VoltagePerPhase_Call {
+func (_c *GcpMGCPInterface_UpdateVoltagePerPhase_Call) RunAndReturn(run func([]float64) error) *GcpMGCPInterface_UpdateVoltagePerPhase_Call {
 	_c.Call.Return(run)
 	return _c
 }
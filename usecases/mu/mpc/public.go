@@ -0,0 +1,70 @@
+package mpc
+
+import (
+	"github.com/enbility/spine-go/model"
+)
+
+// Scenario 1
+
+// set the momentary active power consumption or production
+//
+//   - power: the power in W
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *MPC) UpdatePower(power float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACPowerTotal, []float64{power})
+}
+
+// set the momentary active phase specific power consumption or production per phase
+//
+//   - phasePower: the power in W for each connected phase, in the order of phases a, b and c
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *MPC) UpdatePowerPerPhase(phasePower []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACPower, phasePower)
+}
+
+// Scenario 2
+
+// set the total consumption energy
+//
+//   - energy: the energy in Wh
+func (e *MPC) UpdateEnergyConsumed(energy float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACEnergyConsumed, []float64{energy})
+}
+
+// set the total feed in energy
+//
+//   - energy: the energy in Wh
+func (e *MPC) UpdateEnergyProduced(energy float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACEnergyProduced, []float64{energy})
+}
+
+// Scenario 3
+
+// set the momentary phase specific current consumption or production
+//
+//   - phaseCurrent: the current in A for each connected phase, in the order of phases a, b and c
+//   - positive values are used for consumption
+//   - negative values are used for production
+func (e *MPC) UpdateCurrentPerPhase(phaseCurrent []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACCurrent, phaseCurrent)
+}
+
+// Scenario 4
+
+// set the phase specific voltage details
+//
+//   - phaseVoltage: the voltage in V for each connected phase, in the order of phases a, b and c
+func (e *MPC) UpdateVoltagePerPhase(phaseVoltage []float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACVoltage, phaseVoltage)
+}
+
+// Scenario 5
+
+// set the frequency
+//
+//   - frequency: the frequency in Hz
+func (e *MPC) UpdateFrequency(frequency float64) error {
+	return e.updateMeasurements(model.ScopeTypeTypeACFrequency, []float64{frequency})
+}
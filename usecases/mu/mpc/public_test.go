@@ -0,0 +1,74 @@
+package mpc
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *MuMPCSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *MuMPCSuite) Test_Power() {
+	err := s.sut.UpdatePower(1000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{1000}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+
+	err = s.sut.UpdatePowerPerPhase([]float64{100, 200})
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.UpdatePowerPerPhase([]float64{100, 200, 300})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{100, 200, 300}, s.valuesForScope(model.ScopeTypeTypeACPower))
+}
+
+func (s *MuMPCSuite) Test_Energy() {
+	err := s.sut.UpdateEnergyConsumed(500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{500}, s.valuesForScope(model.ScopeTypeTypeACEnergyConsumed))
+
+	err = s.sut.UpdateEnergyProduced(50)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{50}, s.valuesForScope(model.ScopeTypeTypeACEnergyProduced))
+}
+
+func (s *MuMPCSuite) Test_CurrentVoltageFrequency() {
+	err := s.sut.UpdateCurrentPerPhase([]float64{10, 11, 12})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{10, 11, 12}, s.valuesForScope(model.ScopeTypeTypeACCurrent))
+
+	err = s.sut.UpdateVoltagePerPhase([]float64{230, 231, 232})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{230, 231, 232}, s.valuesForScope(model.ScopeTypeTypeACVoltage))
+
+	err = s.sut.UpdateFrequency(50)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{50}, s.valuesForScope(model.ScopeTypeTypeACFrequency))
+}
+
+func (s *MuMPCSuite) Test_NoFeatures() {
+	sut := NewMPC(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeAbc)
+
+	// AddFeatures was not invoked on this instance
+	err := sut.UpdatePower(1000)
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+}
@@ -0,0 +1,10 @@
+package mpc
+
+import "github.com/enbility/eebus-go/api"
+
+const (
+	// Update of the list of remote entities supporting the Use Case
+	//
+	// Use `RemoteEntities` to get the current data
+	UseCaseSupportUpdate api.EventType = "mu-mpc-UseCaseSupportUpdate"
+)
@@ -0,0 +1,209 @@
+package mpc
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection used for all measurements
+//
+// this is identical to the ElectricalConnectionId used for the nominal max
+// characteristics in the CS LPC and LPP implementations
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type MPC struct {
+	*usecase.UseCaseBase
+
+	connectedPhases model.ElectricalConnectionPhaseNameType
+	phases          []model.ElectricalConnectionPhaseNameType // the single connected phases
+
+	measurementIds map[model.ScopeTypeType][]model.MeasurementIdType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.MuMPCInterface = (*MPC)(nil)
+
+// Create a new Monitored Unit MPC use case
+//
+// parameters:
+//   - localEntity: the local entity providing the measurements
+//   - eventCB: the callback for use case events
+//   - connectedPhases: the phases the Monitored Unit is connected to, e.g. "abc" or "a",
+//     defaults to "abc" if not a valid phase set
+func NewMPC(
+	localEntity spineapi.EntityLocalInterface,
+	eventCB api.EntityEventCallback,
+	connectedPhases model.ElectricalConnectionPhaseNameType,
+) *MPC {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeMonitoringAppliance}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(2),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(3),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(4),
+		},
+		{
+			Scenario: model.UseCaseScenarioSupportType(5),
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypeMonitoredUnit,
+		model.UseCaseNameTypeMonitoringOfPowerConsumption,
+		"1.0.0",
+		"release",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	phases := internal.PhasesOfPhaseSet(connectedPhases)
+	if len(phases) == 0 {
+		connectedPhases = model.ElectricalConnectionPhaseNameTypeAbc
+		phases = internal.PhasesOfPhaseSet(connectedPhases)
+	}
+
+	uc := &MPC{
+		UseCaseBase:     usecase,
+		connectedPhases: connectedPhases,
+		phases:          phases,
+		measurementIds:  make(map[model.ScopeTypeType][]model.MeasurementIdType),
+	}
+
+	return uc
+}
+
+// add the measurement and parameter descriptions for a scope
+//
+// one description is added for each provided phase set,
+// a single description without phase information if none is provided
+func (e *MPC) addMeasurements(
+	measurementType model.MeasurementTypeType,
+	unit model.UnitOfMeasurementType,
+	scope model.ScopeTypeType,
+	acMeasurementType *model.ElectricalConnectionAcMeasurementTypeType,
+	phases []model.ElectricalConnectionPhaseNameType,
+) {
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(measurementType),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(unit),
+		ScopeType:       util.Ptr(scope),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(electricalConnectionId),
+		VoltageType:            util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		AcMeasurementType:      acMeasurementType,
+	}
+
+	if len(phases) == 0 {
+		phases = []model.ElectricalConnectionPhaseNameType{""}
+	}
+
+	for _, phase := range phases {
+		param := paramDesc
+		if phase != "" {
+			param.AcMeasuredPhases = util.Ptr(phase)
+			// single phase voltages are measured against neutral
+			if measurementType == model.MeasurementTypeTypeVoltage {
+				param.AcMeasuredInReferenceTo = util.Ptr(model.ElectricalConnectionPhaseNameTypeNeutral)
+			}
+		}
+
+		id, err := internal.AddMeasurementWithParameterDescription(e.LocalEntity, measurementDesc, param)
+		if err != nil {
+			logging.Log().Debug("MPC addMeasurements: error adding description", scope, err)
+			continue
+		}
+
+		e.measurementIds[scope] = append(e.measurementIds[scope], *id)
+	}
+}
+
+// set the values of all measurements of a scope
+func (e *MPC) updateMeasurements(scope model.ScopeTypeType, values []float64) error {
+	e.mux.Lock()
+	ids := e.measurementIds[scope]
+	e.mux.Unlock()
+
+	if len(ids) == 0 {
+		return api.ErrMetadataNotAvailable
+	}
+
+	return internal.UpdateMeasurementValues(e.LocalEntity, ids, values)
+}
+
+func (e *MPC) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	if ec, err := server.NewElectricalConnection(e.LocalEntity); err == nil {
+		_ = ec.AddDescription(model.ElectricalConnectionDescriptionDataType{
+			ElectricalConnectionId:  util.Ptr(electricalConnectionId),
+			PowerSupplyType:         util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			AcConnectedPhases:       util.Ptr(uint(len(e.phases))),
+			PositiveEnergyDirection: util.Ptr(model.EnergyDirectionTypeConsume),
+		})
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are only added once
+	if len(e.measurementIds) > 0 {
+		return
+	}
+
+	acReal := util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal)
+	connectedPhases := []model.ElectricalConnectionPhaseNameType{e.connectedPhases}
+
+	// Scenario 1
+	// the total power is added first, so it uses the ParameterId 0
+	// which is also used for the nominal max characteristics in the CS LPC and LPP implementations
+	e.addMeasurements(model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPowerTotal, acReal, connectedPhases)
+	e.addMeasurements(model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPower, acReal, e.phases)
+
+	// Scenario 2
+	e.addMeasurements(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeACEnergyConsumed, acReal, connectedPhases)
+	e.addMeasurements(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeACEnergyProduced, acReal, connectedPhases)
+
+	// Scenario 3
+	e.addMeasurements(model.MeasurementTypeTypeCurrent, model.UnitOfMeasurementTypeA, model.ScopeTypeTypeACCurrent, acReal, e.phases)
+
+	// Scenario 4
+	acApparent := util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeApparent)
+	e.addMeasurements(model.MeasurementTypeTypeVoltage, model.UnitOfMeasurementTypeV, model.ScopeTypeTypeACVoltage, acApparent, e.phases)
+
+	// Scenario 5
+	e.addMeasurements(model.MeasurementTypeTypeFrequency, model.UnitOfMeasurementTypeHz, model.ScopeTypeTypeACFrequency, nil, nil)
+}
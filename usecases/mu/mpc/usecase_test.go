@@ -0,0 +1,61 @@
+package mpc
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *MuMPCSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *MuMPCSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// power total, 3x power, energy consumed and produced, 3x current, 3x voltage, frequency
+	assert.Equal(s.T(), 13, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 13, len(descs))
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	desc, err := ec.GetDescriptionForParameterDescriptionFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		ParameterId: util.Ptr(model.ElectricalConnectionParameterIdType(0)),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), uint(3), *desc.AcConnectedPhases)
+	assert.Equal(s.T(), model.EnergyDirectionTypeConsume, *desc.PositiveEnergyDirection)
+
+	// the total power uses the ParameterId 0
+	params, err := ec.GetParameterDescriptionsForFilter(model.ElectricalConnectionParameterDescriptionDataType{
+		ParameterId: util.Ptr(model.ElectricalConnectionParameterIdType(0)),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(params))
+	totalDescs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{
+		MeasurementId: params[0].MeasurementId,
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.ScopeTypeTypeACPowerTotal, *totalDescs[0].ScopeType)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeAbc, *params[0].AcMeasuredPhases)
+}
+
+func (s *MuMPCSuite) Test_ConnectedPhases() {
+	sut := NewMPC(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeNeutral)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeAbc, sut.connectedPhases)
+	assert.Equal(s.T(), 3, len(sut.phases))
+
+	sut = NewMPC(s.localEntity, s.Event, model.ElectricalConnectionPhaseNameTypeA)
+	assert.Equal(s.T(), model.ElectricalConnectionPhaseNameTypeA, sut.connectedPhases)
+	assert.Equal(s.T(), []model.ElectricalConnectionPhaseNameType{model.ElectricalConnectionPhaseNameTypeA}, sut.phases)
+}
@@ -0,0 +1,99 @@
+package vapd
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// set the values of the measurements of the provided scopes
+func (e *VAPD) updateMeasurements(scopes []model.ScopeTypeType, values []float64) error {
+	e.mux.Lock()
+	var ids []model.MeasurementIdType
+	for _, scope := range scopes {
+		id, ok := e.measurementIds[scope]
+		if !ok {
+			e.mux.Unlock()
+			return api.ErrMetadataNotAvailable
+		}
+		ids = append(ids, id)
+	}
+	e.mux.Unlock()
+
+	return internal.UpdateMeasurementValues(e.LocalEntity, ids, values)
+}
+
+// Scenario 1
+
+// set the nominal peak power of the PV system
+//
+//   - power: the power in W
+func (e *VAPD) UpdatePowerNominalPeak(power float64) error {
+	dcs, err := server.NewDeviceConfiguration(e.LocalEntity)
+	if err != nil {
+		return err
+	}
+
+	data := model.DeviceConfigurationKeyValueDataType{
+		Value: &model.DeviceConfigurationKeyValueValueType{
+			ScaledNumber: model.NewScaledNumberType(power),
+		},
+		IsValueChangeable: util.Ptr(false),
+	}
+	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+	}
+	return dcs.UpdateKeyValueDataForFilter(data, nil, filter)
+}
+
+// Scenario 2
+
+// set the momentary production power of the PV system
+//
+//   - power: the power in W
+//   - positive values are used for production
+func (e *VAPD) UpdatePower(power float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeACPowerTotal}, []float64{power})
+}
+
+// Scenario 3
+
+// set the total PV yield of the PV system
+//
+//   - yield: the energy in Wh
+func (e *VAPD) UpdatePVYieldTotal(yield float64) error {
+	return e.updateMeasurements([]model.ScopeTypeType{model.ScopeTypeTypeACYieldTotal}, []float64{yield})
+}
+
+// Scenario 1 - 3
+
+// set the data of all scenarios aggregated from several inverters
+//
+// all values are summed up
+//
+//   - inverters: the data of each inverter
+func (e *VAPD) UpdateInverters(inverters []ucapi.PVInverterData) error {
+	if len(inverters) == 0 {
+		return api.ErrMissingData
+	}
+
+	var power, yield, peak float64
+	for _, inverter := range inverters {
+		power += inverter.Power
+		yield += inverter.PVYieldTotal
+		peak += inverter.PowerNominalPeak
+	}
+
+	if err := e.UpdatePowerNominalPeak(peak); err != nil {
+		return err
+	}
+
+	scopes := []model.ScopeTypeType{
+		model.ScopeTypeTypeACPowerTotal,
+		model.ScopeTypeTypeACYieldTotal,
+	}
+	return e.updateMeasurements(scopes, []float64{power, yield})
+}
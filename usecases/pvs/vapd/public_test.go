@@ -0,0 +1,79 @@
+package vapd
+
+import (
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *PvsVAPDSuite) valuesForScope(scope model.ScopeTypeType) []float64 {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.MeasurementDescriptionDataType{
+		ScopeType: util.Ptr(scope),
+	}
+	data, err := measurement.GetDataForFilter(filter)
+	if err != nil {
+		return nil
+	}
+
+	var result []float64
+	for _, item := range data {
+		result = append(result, item.Value.GetValue())
+	}
+
+	return result
+}
+
+func (s *PvsVAPDSuite) powerNominalPeak() float64 {
+	dcs, err := server.NewDeviceConfiguration(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+	}
+	data, err := dcs.GetKeyValueDataForFilter(filter)
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), data)
+
+	return data.Value.ScaledNumber.GetValue()
+}
+
+func (s *PvsVAPDSuite) Test_Values() {
+	err := s.sut.UpdatePowerNominalPeak(10000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10000.0, s.powerNominalPeak())
+
+	err = s.sut.UpdatePower(4500)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{4500}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+
+	err = s.sut.UpdatePVYieldTotal(123000)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []float64{123000}, s.valuesForScope(model.ScopeTypeTypeACYieldTotal))
+}
+
+func (s *PvsVAPDSuite) Test_MissingDescriptions() {
+	sut := NewVAPD(s.localEntity, s.Event)
+
+	err := sut.UpdatePower(1000)
+	assert.Equal(s.T(), api.ErrMetadataNotAvailable, err)
+}
+
+func (s *PvsVAPDSuite) Test_UpdateInverters() {
+	err := s.sut.UpdateInverters(nil)
+	assert.Equal(s.T(), api.ErrMissingData, err)
+
+	err = s.sut.UpdateInverters([]ucapi.PVInverterData{
+		{Power: 3000, PVYieldTotal: 50000, PowerNominalPeak: 6000},
+		{Power: 1500, PVYieldTotal: 20000, PowerNominalPeak: 4000},
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 10000.0, s.powerNominalPeak())
+	assert.Equal(s.T(), []float64{4500}, s.valuesForScope(model.ScopeTypeTypeACPowerTotal))
+	assert.Equal(s.T(), []float64{70000}, s.valuesForScope(model.ScopeTypeTypeACYieldTotal))
+}
@@ -0,0 +1,157 @@
+package vapd
+
+import (
+	"sync"
+
+	"github.com/enbility/eebus-go/api"
+	"github.com/enbility/eebus-go/features/server"
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/enbility/eebus-go/usecases/internal"
+	"github.com/enbility/eebus-go/usecases/usecase"
+	"github.com/enbility/ship-go/logging"
+	spineapi "github.com/enbility/spine-go/api"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+)
+
+// the electrical connection used for all measurements
+const electricalConnectionId = model.ElectricalConnectionIdType(0)
+
+type VAPD struct {
+	*usecase.UseCaseBase
+
+	measurementIds map[model.ScopeTypeType]model.MeasurementIdType
+
+	mux sync.Mutex
+}
+
+var _ ucapi.PvsVAPDInterface = (*VAPD)(nil)
+
+// Create a new PV System VAPD use case
+//
+// Several inverters can be provided as one PV system
+// via UpdateInverters.
+//
+// parameters:
+//   - localEntity: the local PV system entity providing the photovoltaic data
+//   - eventCB: the callback for use case events
+func NewVAPD(localEntity spineapi.EntityLocalInterface, eventCB api.EntityEventCallback) *VAPD {
+	validActorTypes := []model.UseCaseActorType{model.UseCaseActorTypeCEM}
+	validEntityTypes := []model.EntityTypeType{
+		model.EntityTypeTypeCEM,
+	}
+	useCaseScenarios := []api.UseCaseScenario{
+		{
+			Scenario:  model.UseCaseScenarioSupportType(1),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(2),
+			Mandatory: true,
+		},
+		{
+			Scenario:  model.UseCaseScenarioSupportType(3),
+			Mandatory: true,
+		},
+	}
+
+	usecase := usecase.NewUseCaseBase(
+		localEntity,
+		model.UseCaseActorTypePVSystem,
+		model.UseCaseNameTypeVisualizationOfAggregatedPhotovoltaicData,
+		"1.0.1",
+		"RC1",
+		useCaseScenarios,
+		eventCB,
+		UseCaseSupportUpdate,
+		validActorTypes,
+		validEntityTypes,
+	)
+
+	uc := &VAPD{
+		UseCaseBase:    usecase,
+		measurementIds: make(map[model.ScopeTypeType]model.MeasurementIdType),
+	}
+
+	return uc
+}
+
+// add the measurement and parameter description for a scope
+func (e *VAPD) addMeasurement(
+	measurementType model.MeasurementTypeType,
+	unit model.UnitOfMeasurementType,
+	scope model.ScopeTypeType,
+) {
+	measurementDesc := model.MeasurementDescriptionDataType{
+		MeasurementType: util.Ptr(measurementType),
+		CommodityType:   util.Ptr(model.CommodityTypeTypeElectricity),
+		Unit:            util.Ptr(unit),
+		ScopeType:       util.Ptr(scope),
+	}
+	paramDesc := model.ElectricalConnectionParameterDescriptionDataType{
+		ElectricalConnectionId: util.Ptr(electricalConnectionId),
+		VoltageType:            util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+		AcMeasurementType:      util.Ptr(model.ElectricalConnectionAcMeasurementTypeTypeReal),
+	}
+
+	id, err := internal.AddMeasurementWithParameterDescription(e.LocalEntity, measurementDesc, paramDesc)
+	if err != nil {
+		logging.Log().Debug("VAPD addMeasurement: error adding description", scope, err)
+		return
+	}
+
+	e.measurementIds[scope] = *id
+}
+
+func (e *VAPD) AddFeatures() {
+	// server features
+	f := e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeDeviceConfiguration, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeDeviceConfigurationKeyValueListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeElectricalConnection, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeElectricalConnectionParameterDescriptionListData, true, false)
+
+	f = e.LocalEntity.GetOrAddFeature(model.FeatureTypeTypeMeasurement, model.RoleTypeServer)
+	f.AddFunctionType(model.FunctionTypeMeasurementDescriptionListData, true, false)
+	f.AddFunctionType(model.FunctionTypeMeasurementListData, true, false)
+
+	if dcs, err := server.NewDeviceConfiguration(e.LocalEntity); err == nil {
+		filter := model.DeviceConfigurationKeyValueDescriptionDataType{
+			KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+		}
+		if data, err := dcs.GetKeyValueDescriptionsForFilter(filter); err != nil || len(data) == 0 {
+			dcs.AddKeyValueDescription(
+				model.DeviceConfigurationKeyValueDescriptionDataType{
+					KeyName:   util.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+					ValueType: util.Ptr(model.DeviceConfigurationKeyValueTypeTypeScaledNumber),
+					Unit:      util.Ptr(model.UnitOfMeasurementTypeW),
+				},
+			)
+		}
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	// the descriptions are only added once
+	if len(e.measurementIds) > 0 {
+		return
+	}
+
+	// a PV system only produces energy, so the power is positive while producing
+	if ec, err := server.NewElectricalConnection(e.LocalEntity); err == nil {
+		_ = ec.AddDescription(model.ElectricalConnectionDescriptionDataType{
+			ElectricalConnectionId:  util.Ptr(electricalConnectionId),
+			PowerSupplyType:         util.Ptr(model.ElectricalConnectionVoltageTypeTypeAc),
+			PositiveEnergyDirection: util.Ptr(model.EnergyDirectionTypeProduce),
+		})
+	}
+
+	// Scenario 2
+	e.addMeasurement(model.MeasurementTypeTypePower, model.UnitOfMeasurementTypeW, model.ScopeTypeTypeACPowerTotal)
+
+	// Scenario 3
+	e.addMeasurement(model.MeasurementTypeTypeEnergy, model.UnitOfMeasurementTypeWh, model.ScopeTypeTypeACYieldTotal)
+}
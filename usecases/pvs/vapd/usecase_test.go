@@ -0,0 +1,45 @@
+package vapd
+
+import (
+	"github.com/enbility/eebus-go/features/server"
+	"github.com/enbility/spine-go/model"
+	"github.com/enbility/spine-go/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *PvsVAPDSuite) Test_UpdateUseCaseAvailability() {
+	s.sut.UpdateUseCaseAvailability(true)
+}
+
+func (s *PvsVAPDSuite) Test_AddFeatures() {
+	measurement, err := server.NewMeasurement(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descs, err := measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	// power, yield
+	assert.Equal(s.T(), 2, len(descs))
+
+	// adding the features again does not add the descriptions again
+	s.sut.AddFeatures()
+	descs, err = measurement.GetDescriptionsForFilter(model.MeasurementDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(descs))
+
+	ec, err := server.NewElectricalConnection(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	descriptions, err := ec.GetDescriptionsForFilter(model.ElectricalConnectionDescriptionDataType{})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(descriptions))
+	assert.Equal(s.T(), model.EnergyDirectionTypeProduce, *descriptions[0].PositiveEnergyDirection)
+
+	dcs, err := server.NewDeviceConfiguration(s.localEntity)
+	assert.Nil(s.T(), err)
+
+	keys, err := dcs.GetKeyValueDescriptionsForFilter(model.DeviceConfigurationKeyValueDescriptionDataType{
+		KeyName: util.Ptr(model.DeviceConfigurationKeyNameTypePeakPowerOfPVSystem),
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(keys))
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+)
+
+// LimitStoreInterface implementation persisting the data in a JSON file
+type JSONFileLimitStore struct {
+	path string
+
+	mux sync.Mutex
+}
+
+var _ ucapi.LimitStoreInterface = (*JSONFileLimitStore)(nil)
+
+// Get a new JSON file store
+//
+// parameters:
+//   - path: the path of the file, which is created on the first save
+func NewJSONFileLimitStore(path string) *JSONFileLimitStore {
+	return &JSONFileLimitStore{
+		path: path,
+	}
+}
+
+// return the stored data
+//
+// returns nil without an error if the file does not exist yet
+func (s *JSONFileLimitStore) Load() (*ucapi.LimitStoreData, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data ucapi.LimitStoreData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// store the data, replacing the previously stored data
+//
+// the data is written to a temporary file first, which then replaces the
+// existing file, so an interrupted write does not corrupt the stored data
+func (s *JSONFileLimitStore) Save(data ucapi.LimitStoreData) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	content, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
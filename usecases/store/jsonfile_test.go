@@ -0,0 +1,73 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ucapi "github.com/enbility/eebus-go/usecases/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_JSONFileLimitStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limits.json")
+	sut := NewJSONFileLimitStore(path)
+
+	data, err := sut.Load()
+	assert.Nil(t, err)
+	assert.Nil(t, data)
+
+	endTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	newData := ucapi.LimitStoreData{
+		LimitValue:       4200,
+		LimitActive:      true,
+		LimitEndTime:     &endTime,
+		FailsafeLimit:    3000,
+		FailsafeDuration: time.Hour * 2,
+		NominalMax:       11000,
+	}
+	err = sut.Save(newData)
+	assert.Nil(t, err)
+
+	data, err = sut.Load()
+	assert.Nil(t, err)
+	assert.NotNil(t, data)
+	assert.Equal(t, 4200.0, data.LimitValue)
+	assert.True(t, data.LimitActive)
+	assert.NotNil(t, data.LimitEndTime)
+	assert.True(t, endTime.Equal(*data.LimitEndTime))
+	assert.Equal(t, 3000.0, data.FailsafeLimit)
+	assert.Equal(t, time.Hour*2, data.FailsafeDuration)
+	assert.Equal(t, 11000.0, data.NominalMax)
+
+	// a second store instance reads the same data
+	data, err = NewJSONFileLimitStore(path).Load()
+	assert.Nil(t, err)
+	assert.Equal(t, 4200.0, data.LimitValue)
+
+	newData.LimitEndTime = nil
+	newData.LimitActive = false
+	err = sut.Save(newData)
+	assert.Nil(t, err)
+
+	data, err = sut.Load()
+	assert.Nil(t, err)
+	assert.False(t, data.LimitActive)
+	assert.Nil(t, data.LimitEndTime)
+
+	// only the store file is left
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+
+	err = os.WriteFile(path, []byte("invalid"), 0600)
+	assert.Nil(t, err)
+	data, err = sut.Load()
+	assert.NotNil(t, err)
+	assert.Nil(t, data)
+
+	sut = NewJSONFileLimitStore(filepath.Join(t.TempDir(), "missing", "limits.json"))
+	err = sut.Save(newData)
+	assert.NotNil(t, err)
+}
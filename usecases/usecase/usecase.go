@@ -99,7 +99,8 @@ func (u *UseCaseBase) RemoteEntitiesScenarios() []api.RemoteEntityScenarios {
 	u.mux.Lock()
 	defer u.mux.Unlock()
 
-	return u.availableEntityScenarios
+	// return a copy, the scenarios of an entity are updated while events are handled
+	return slices.Clone(u.availableEntityScenarios)
 }
 
 // return the currently available scenarios for the use case for a remote entity
@@ -127,6 +128,14 @@ func (u *UseCaseBase) indexAndScenariosOfEntity(entity spineapi.EntityRemoteInte
 	u.mux.Lock()
 	defer u.mux.Unlock()
 
+	return u.indexAndScenariosOfEntityLocked(entity)
+}
+
+// return the index and the scenarios of the entity in the available entity scenarios
+// and return -1 and nil if not found
+//
+// the mutex has to be locked by the caller
+func (u *UseCaseBase) indexAndScenariosOfEntityLocked(entity spineapi.EntityRemoteInterface) (int, []uint) {
 	for i, remoteEntity := range u.availableEntityScenarios {
 		if entity != nil && entity.Address() != nil && remoteEntity.Entity.Address() != nil &&
 			reflect.DeepEqual(entity.Address().Device, remoteEntity.Entity.Address().Device) &&
@@ -150,25 +159,24 @@ func (u *UseCaseBase) updateRemoteEntityScenarios(
 		scenarioValues = append(scenarioValues, uint(scenario))
 	}
 
-	i, _ := u.indexAndScenariosOfEntity(entity)
+	// the lookup and the update have to be atomic, as events are handled concurrently
+	u.mux.Lock()
+	i, _ := u.indexAndScenariosOfEntityLocked(entity)
 	if i == -1 {
 		newItem := api.RemoteEntityScenarios{
 			Entity:    entity,
 			Scenarios: scenarioValues,
 		}
 
-		u.mux.Lock()
 		u.availableEntityScenarios = append(u.availableEntityScenarios, newItem)
-		u.mux.Unlock()
 
 		updateEvent = true
-	} else if i >= 0 && slices.Compare(u.availableEntityScenarios[i].Scenarios, scenarioValues) != 0 {
-		u.mux.Lock()
+	} else if slices.Compare(u.availableEntityScenarios[i].Scenarios, scenarioValues) != 0 {
 		u.availableEntityScenarios[i].Scenarios = scenarioValues
-		u.mux.Unlock()
 
 		updateEvent = true
 	}
+	u.mux.Unlock()
 
 	if updateEvent && u.EventCB != nil {
 		u.EventCB(entity.Device().Ski(), entity.Device(), entity, u.useCaseUpdateEvent)
@@ -177,22 +185,25 @@ func (u *UseCaseBase) updateRemoteEntityScenarios(
 
 // remove a remote entity from the use case
 func (u *UseCaseBase) removeEntityFromAvailableEntityScenarios(entity spineapi.EntityRemoteInterface) {
-	if i, _ := u.indexAndScenariosOfEntity(entity); i >= 0 {
-		u.mux.Lock()
-		u.availableEntityScenarios = append(u.availableEntityScenarios[:i], u.availableEntityScenarios[i+1:]...)
-		u.mux.Unlock()
+	u.mux.Lock()
+	i, _ := u.indexAndScenariosOfEntityLocked(entity)
+	if i >= 0 {
+		u.availableEntityScenarios = slices.Delete(u.availableEntityScenarios, i, i+1)
+	}
+	u.mux.Unlock()
 
-		if u.EventCB != nil {
-			u.EventCB(entity.Device().Ski(), entity.Device(), entity, u.useCaseUpdateEvent)
-		}
+	if i >= 0 && u.EventCB != nil {
+		u.EventCB(entity.Device().Ski(), entity.Device(), entity, u.useCaseUpdateEvent)
 	}
 }
 
-// return the required server features for a use case scenario
+// return a copy of the required server features for a use case scenario
+//
+// the result is sorted by the caller, which may run concurrently for multiple events
 func (u *UseCaseBase) requiredServerFeaturesForScenario(scenario model.UseCaseScenarioSupportType) []model.FeatureTypeType {
 	for _, serverFeatures := range u.useCaseScenarios {
 		if serverFeatures.Scenario == scenario {
-			return serverFeatures.ServerFeatures
+			return slices.Clone(serverFeatures.ServerFeatures)
 		}
 	}
 
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"sync"
+
 	spineapi "github.com/enbility/spine-go/api"
 	"github.com/enbility/spine-go/model"
 	"github.com/stretchr/testify/assert"
@@ -84,3 +86,59 @@ func (s *UseCaseSuite) Test_RequiredServerFeatures() {
 	required = s.uc.requiredServerFeaturesForScenario(model.UseCaseScenarioSupportType(4))
 	assert.Equal(s.T(), 0, len(required))
 }
+
+func (s *UseCaseSuite) Test_AvailableScenarios_Copy() {
+	s.uc.updateRemoteEntityScenarios(s.monitoredEntity, []model.UseCaseScenarioSupportType{1, 2, 3})
+
+	result := s.uc.RemoteEntitiesScenarios()
+	assert.Equal(s.T(), 1, len(result))
+
+	// modifying the result does not change the available scenarios
+	result[0].Scenarios = nil
+	scenarios := s.uc.AvailableScenariosForEntity(s.monitoredEntity)
+	assert.Equal(s.T(), []uint{1, 2, 3}, scenarios)
+
+	// removing the entity does not change a previously returned result
+	s.uc.removeEntityFromAvailableEntityScenarios(s.monitoredEntity)
+	assert.Equal(s.T(), 1, len(result))
+	assert.Equal(s.T(), s.monitoredEntity, result[0].Entity)
+
+	required := s.uc.requiredServerFeaturesForScenario(model.UseCaseScenarioSupportType(1))
+	required[0] = model.FeatureTypeTypeMeasurement
+	required = s.uc.requiredServerFeaturesForScenario(model.UseCaseScenarioSupportType(1))
+	assert.Equal(s.T(), []model.FeatureTypeType{model.FeatureTypeTypeLoadControl}, required)
+}
+
+func (s *UseCaseSuite) Test_AvailableScenarios_Concurrent() {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			s.uc.updateRemoteEntityScenarios(s.monitoredEntity, []model.UseCaseScenarioSupportType{1, 2})
+			_ = s.uc.RemoteEntitiesScenarios()
+			_ = s.uc.AvailableScenariosForEntity(s.monitoredEntity)
+		}()
+	}
+	wg.Wait()
+
+	// the entity is only added once
+	result := s.uc.RemoteEntitiesScenarios()
+	assert.Equal(s.T(), 1, len(result))
+	assert.Equal(s.T(), []uint{1, 2}, result[0].Scenarios)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			s.uc.removeEntityFromAvailableEntityScenarios(s.monitoredEntity)
+		}()
+	}
+	wg.Wait()
+
+	result = s.uc.RemoteEntitiesScenarios()
+	assert.Equal(s.T(), 0, len(result))
+}